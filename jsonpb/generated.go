@@ -0,0 +1,712 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// The jsonpb plugin of protoc-gen-go generates MarshalJSONPB and
+// UnmarshalJSONPB methods, which Marshaler and Unmarshaler call as they
+// call any JSONPBMarshaler and JSONPBUnmarshaler. When called that way
+// for the message being written or read, the generated methods work in
+// place on the output or input of the Marshaler or Unmarshaler, instead
+// of walking the message with reflection. When called directly, they
+// marshal or unmarshal the message as a whole.
+
+// A hookCall is the message whose MarshalJSONPB method a Marshaler is
+// calling, and where it is written.
+type hookCall struct {
+	pb      proto.Message
+	out     *errWriter
+	indent  string
+	typeURL string
+	written bool // whether a generated method wrote the message in place
+}
+
+// A GeneratedEncoder writes the fields of a message for its generated
+// MarshalJSONPB method. It is used by generated code and is not subject
+// to any compatibility guarantee.
+//
+// A field is written by a call to Field followed by a call to a value
+// method, or by BeginList or BeginMap, calls to Elem or one of the Key
+// methods before each value, and EndList or EndMap. End finishes the
+// message.
+type GeneratedEncoder interface {
+	// End writes the extensions and unknown fields of the message and
+	// ends it. It returns the JSON of the message if it was not written
+	// in place.
+	End() ([]byte, error)
+
+	// Field starts the field with the given original and JSON names,
+	// and reports whether it is written. A field holding its zero value,
+	// as reported by zero, is only written if EmitDefaults is set and
+	// the field's options don't omit it.
+	Field(name, jsonName string, zero bool) bool
+	// SensitiveField is like Field, for a string or bytes field marked
+	// as sensitive, whose values are redacted if RedactSensitive is set.
+	SensitiveField(name, jsonName string, zero bool) bool
+	// Redact reports whether RedactSensitive is set, in which case
+	// sensitive fields that are not strings or bytes are left out.
+	Redact() bool
+
+	// The value methods write a value of the corresponding type.
+	// Enum is given the _name map of the enum type, and Message
+	// a message that must not be nil.
+	Null()
+	Bool(v bool)
+	Int32(v int32)
+	Uint32(v uint32)
+	Int64(v int64)
+	Uint64(v uint64)
+	Float32(v float32)
+	Float64(v float64)
+	String(v string)
+	Bytes(v []byte)
+	Enum(v int32, names map[int32]string)
+	Message(pb proto.Message) error
+
+	// The methods that write lists and maps.
+	BeginList()
+	Elem()
+	EndList()
+	BeginMap()
+	Key(k string)
+	IntKey(k int64)
+	UintKey(k uint64)
+	BoolKey(k bool)
+	EndMap()
+}
+
+// XXX_Encoder returns the encoder with which the generated MarshalJSONPB
+// method of pb writes its fields. It is used by generated code and is not
+// subject to any compatibility guarantee.
+func (m *Marshaler) XXX_Encoder(pb proto.Message) GeneratedEncoder {
+	h := m.hooked
+	if h == nil || h.written || h.pb != pb {
+		// Called directly: marshal pb, which calls the method again.
+		var buf bytes.Buffer
+		err := m.Marshal(&buf, pb)
+		return &encoder{done: true, b: buf.Bytes(), err: err}
+	}
+	h.written = true
+	first, err := m.beginObject(h.out, h.indent, h.typeURL)
+	if err != nil {
+		return &encoder{done: true, err: err}
+	}
+	return &encoder{
+		m:       m,
+		out:     h.out,
+		pb:      pb,
+		indent:  h.indent,
+		first:   first,
+		options: jsonFieldOptions(reflect.TypeOf(pb)),
+	}
+}
+
+// An encoder is the GeneratedEncoder of a Marshaler.
+type encoder struct {
+	m      *Marshaler
+	out    *errWriter
+	pb     proto.Message
+	indent string // the indentation of the message being written
+	first  bool   // whether no field has been written yet
+	nested bool   // whether a list or a map is being written
+	n      int    // the number of elements of the list or map
+
+	options   map[string]fieldOptions // the options of the fields of the message
+	field     fieldOptions            // the options of the field being written
+	redacting bool                    // whether the strings and bytes of the field are redacted
+
+	// Set if the message has been marshaled as a whole, with the result.
+	done bool
+	b    []byte
+	err  error
+}
+
+// End writes the extensions and unknown fields of the message and ends it.
+// It returns the JSON of the message if it was not written in place.
+func (e *encoder) End() ([]byte, error) {
+	if e.done {
+		return e.b, e.err
+	}
+	return nil, e.m.endObject(e.out, e.pb, e.indent, e.first)
+}
+
+// Field starts the field with the given original and JSON names,
+// and reports whether it is written. A field holding its zero value,
+// as reported by zero, is only written if EmitDefaults is set and the
+// field's options don't omit it.
+func (e *encoder) Field(name, jsonName string, zero bool) bool {
+	if e.done {
+		return false
+	}
+	e.field = e.options[name]
+	e.redacting = false
+	if zero && (!e.m.EmitDefaults || e.field.omitDefault) {
+		return false
+	}
+	if !e.first {
+		e.m.writeSep(e.out)
+	}
+	e.first = false
+	e.m.marshalFieldName(e.out, e.m.jsonName(name, jsonName), e.indent)
+	return true
+}
+
+// SensitiveField is like Field, for a string or bytes field marked as
+// sensitive. If RedactSensitive is set, the values of the field that
+// aren't empty are written as proto.RedactedValue.
+func (e *encoder) SensitiveField(name, jsonName string, zero bool) bool {
+	ok := e.Field(name, jsonName, zero)
+	e.redacting = ok && e.m.RedactSensitive
+	return ok
+}
+
+// Redact reports whether RedactSensitive is set, in which case sensitive
+// fields that are not strings or bytes are left out.
+func (e *encoder) Redact() bool {
+	return !e.done && e.m.RedactSensitive
+}
+
+// Null writes null.
+func (e *encoder) Null() {
+	e.out.write("null")
+}
+
+// Bool writes a bool value.
+func (e *encoder) Bool(v bool) {
+	e.out.write(strconv.FormatBool(v))
+}
+
+// Int32 writes an int32 value.
+func (e *encoder) Int32(v int32) {
+	e.out.write(strconv.FormatInt(int64(v), 10))
+}
+
+// Uint32 writes a uint32 value.
+func (e *encoder) Uint32(v uint32) {
+	e.out.write(strconv.FormatUint(uint64(v), 10))
+}
+
+// Int64 writes an int64 value, which is quoted unless Int64sAsNumbers
+// or the field's options say otherwise.
+func (e *encoder) Int64(v int64) {
+	if e.m.Int64sAsNumbers || e.field.int64AsNumber {
+		e.out.write(strconv.FormatInt(v, 10))
+		return
+	}
+	e.out.write(`"` + strconv.FormatInt(v, 10) + `"`)
+}
+
+// Uint64 writes a uint64 value, which is quoted unless Int64sAsNumbers
+// or the field's options say otherwise.
+func (e *encoder) Uint64(v uint64) {
+	if e.m.Int64sAsNumbers || e.field.int64AsNumber {
+		e.out.write(strconv.FormatUint(v, 10))
+		return
+	}
+	e.out.write(`"` + strconv.FormatUint(v, 10) + `"`)
+}
+
+// Float32 writes a float value.
+func (e *encoder) Float32(v float32) {
+	e.float(float64(v), 32)
+}
+
+// Float64 writes a double value.
+func (e *encoder) Float64(v float64) {
+	e.float(v, 64)
+}
+
+// float writes f as the "encoding/json" package does, except for
+// the non-finite values, which are written as strings.
+func (e *encoder) float(f float64, bits int) {
+	switch {
+	case math.IsInf(f, 1):
+		e.out.write(`"Infinity"`)
+		return
+	case math.IsInf(f, -1):
+		e.out.write(`"-Infinity"`)
+		return
+	case math.IsNaN(f):
+		e.out.write(`"NaN"`)
+		return
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b := strconv.AppendFloat(nil, f, format, -1, bits)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	e.out.write(string(b))
+}
+
+// String writes a string value.
+func (e *encoder) String(v string) {
+	if e.redacting && v != "" {
+		v = proto.RedactedValue
+	}
+	for i := 0; i < len(v); i++ {
+		if c := v[i]; c < 0x20 || c >= 0x7f || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			// Leave the escaping to the "encoding/json" package.
+			b, err := json.Marshal(v)
+			if err != nil {
+				e.setErr(err)
+				return
+			}
+			e.out.write(string(b))
+			return
+		}
+	}
+	e.out.write(`"` + v + `"`)
+}
+
+// Bytes writes a bytes value in base64, or null if v is nil.
+func (e *encoder) Bytes(v []byte) {
+	if v == nil {
+		e.Null()
+		return
+	}
+	if e.redacting && len(v) > 0 {
+		v = []byte(proto.RedactedValue)
+	}
+	e.out.write(`"` + base64.StdEncoding.EncodeToString(v) + `"`)
+}
+
+// Enum writes an enum value, whose names are given by the _name map
+// of the enum type. It is written as a number if EnumsAsInts is set,
+// if the field's options say so or if the value has no name.
+func (e *encoder) Enum(v int32, names map[int32]string) {
+	if name, ok := names[v]; ok && !e.m.EnumsAsInts && !e.field.enumAsInt {
+		e.out.write(`"` + name + `"`)
+		return
+	}
+	e.Int32(v)
+}
+
+// Message writes a message value, which must not be nil.
+func (e *encoder) Message(pb proto.Message) error {
+	indent := e.indent + e.m.Indent
+	if e.nested {
+		indent += e.m.Indent
+	}
+	return e.m.marshalObject(e.out, pb, indent, "")
+}
+
+// BeginList starts a list value.
+func (e *encoder) BeginList() {
+	e.out.write("[")
+	e.nested, e.n = true, 0
+}
+
+// Elem starts an element of a list or a map.
+func (e *encoder) Elem() {
+	if e.n > 0 {
+		e.out.write(",")
+	}
+	e.n++
+	if e.m.Indent != "" {
+		e.out.write("\n")
+		e.out.write(e.indent)
+		e.out.write(e.m.Indent)
+		e.out.write(e.m.Indent)
+	}
+}
+
+// EndList ends a list value.
+func (e *encoder) EndList() {
+	e.end("]")
+}
+
+// BeginMap starts a map value.
+func (e *encoder) BeginMap() {
+	e.out.write("{")
+	e.nested, e.n = true, 0
+}
+
+// Key starts an element of a map, with a string key.
+func (e *encoder) Key(k string) {
+	e.Elem()
+	e.String(k)
+	e.out.write(":")
+	if e.m.Indent != "" {
+		e.out.write(" ")
+	}
+}
+
+// IntKey starts an element of a map, with a signed integer key.
+func (e *encoder) IntKey(k int64) {
+	e.Key(strconv.FormatInt(k, 10))
+}
+
+// UintKey starts an element of a map, with an unsigned integer key.
+func (e *encoder) UintKey(k uint64) {
+	e.Key(strconv.FormatUint(k, 10))
+}
+
+// BoolKey starts an element of a map, with a bool key.
+func (e *encoder) BoolKey(k bool) {
+	e.Key(strconv.FormatBool(k))
+}
+
+// EndMap ends a map value.
+func (e *encoder) EndMap() {
+	e.end("}")
+}
+
+func (e *encoder) end(delim string) {
+	if e.m.Indent != "" {
+		e.out.write("\n")
+		e.out.write(e.indent)
+		e.out.write(e.m.Indent)
+	}
+	e.out.write(delim)
+	e.nested = false
+}
+
+func (e *encoder) setErr(err error) {
+	if e.out.err == nil {
+		e.out.err = err
+	}
+}
+
+// A GeneratedDecoder reads the fields of a message for its generated
+// UnmarshalJSONPB method. It is used by generated code and is not subject
+// to any compatibility guarantee.
+//
+// The value methods accept the same inputs as Unmarshaler does for
+// fields of the corresponding types. They leave the value unchanged
+// if the input is null, except for Bytes, which sets it to nil.
+// End finishes the message.
+type GeneratedDecoder interface {
+	// End reads the extensions and unknown fields of the message from
+	// the fields that are left.
+	End() error
+
+	// Field consumes the field with the given original and JSON names,
+	// or the name given by the Naming of the Unmarshaler, and returns
+	// its value.
+	Field(name, jsonName string) (json.RawMessage, bool)
+	// Null reports whether raw is null.
+	Null(raw json.RawMessage) bool
+
+	// The value methods read a value of the corresponding type.
+	// Enum is given the _value map of the enum type and its name,
+	// and Message a message that must not be nil and the original
+	// name of the field, which is used in errors.
+	Bool(raw json.RawMessage, v *bool) error
+	Int32(raw json.RawMessage, v *int32) error
+	Int64(raw json.RawMessage, v *int64) error
+	Uint32(raw json.RawMessage, v *uint32) error
+	Uint64(raw json.RawMessage, v *uint64) error
+	Float32(raw json.RawMessage, v *float32) error
+	Float64(raw json.RawMessage, v *float64) error
+	String(raw json.RawMessage, v *string) error
+	Bytes(raw json.RawMessage, v *[]byte) error
+	Enum(raw json.RawMessage, v *int32, values map[string]int32, enum string) error
+	Message(raw json.RawMessage, pb proto.Message, name string) error
+
+	// List and Map read the elements of a list or map value of the
+	// field with the given original name. They return nil if the input
+	// is null. Map keys that are not strings are read with the value
+	// methods, as in d.Int32([]byte(k), &key).
+	List(raw json.RawMessage, name string) ([]json.RawMessage, error)
+	Map(raw json.RawMessage, name string) (map[string]json.RawMessage, error)
+}
+
+// XXX_Decoder returns the decoder with which the generated UnmarshalJSONPB
+// method of pb reads its fields from b, or nil if there is nothing left to
+// read, in which case the error is that of unmarshaling pb. It is used by
+// generated code and is not subject to any compatibility guarantee.
+func (u *Unmarshaler) XXX_Decoder(pb proto.Message, b []byte) (GeneratedDecoder, error) {
+	s := u.state
+	if s == nil || s.hooked != pb {
+		// Called directly: unmarshal pb, which calls the method again.
+		return nil, u.Unmarshal(bytes.NewReader(b), pb)
+	}
+	s.hooked = nil
+	if string(b) == "null" {
+		// A null message is left unset, as it is without the method.
+		s.null = true
+		return nil, nil
+	}
+	if s.tracksPaths() {
+		// Generated methods don't track the JSON path, so the message
+		// is decoded with reflection.
+		s.plain = pb
+		return nil, s.unmarshalValue(reflect.ValueOf(pb).Elem(), b, nil)
+	}
+	var jsonFields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonFields); err != nil {
+		return nil, err
+	}
+	return &decoder{u: s, pb: pb, fields: jsonFields}, nil
+}
+
+// A decoder is the GeneratedDecoder of an Unmarshaler.
+type decoder struct {
+	u      *unmarshalState
+	pb     proto.Message
+	fields map[string]json.RawMessage
+}
+
+// End reads the extensions and unknown fields of the message from the
+// fields that are left.
+func (d *decoder) End() error {
+	return d.u.unmarshalRemaining(d.pb, d.fields)
+}
+
+// Field consumes the field with the given original and JSON names,
+// or the name given by the Naming of the Unmarshaler, and returns its
+// value. If several names are present, the JSON name wins, then the
+// name given by Naming.
+func (d *decoder) Field(name, jsonName string) (json.RawMessage, bool) {
+	if len(d.fields) == 0 {
+		return nil, false
+	}
+	raw, ok := d.fields[name]
+	if ok {
+		delete(d.fields, name)
+	}
+	if d.u.Naming != nil && !customJSONName(name, jsonName) {
+		named := d.u.Naming(name)
+		if v, okNamed := d.fields[named]; okNamed {
+			raw, ok = v, true
+			delete(d.fields, named)
+		}
+	}
+	if v, okJSON := d.fields[jsonName]; okJSON {
+		raw, ok = v, true
+		delete(d.fields, jsonName)
+	}
+	return raw, ok
+}
+
+// Null reports whether raw is null.
+func (d *decoder) Null(raw json.RawMessage) bool {
+	return string(raw) == "null"
+}
+
+// Bool reads a bool value.
+func (d *decoder) Bool(raw json.RawMessage, v *bool) error {
+	switch string(raw) {
+	case "true":
+		*v = true
+		return nil
+	case "false":
+		*v = false
+		return nil
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Int32 reads an int32 value, which may be quoted.
+func (d *decoder) Int32(raw json.RawMessage, v *int32) error {
+	raw = unquoteNumber(raw)
+	if isJSONInt(raw) {
+		if n, err := strconv.ParseInt(string(raw), 10, 32); err == nil {
+			*v = int32(n)
+			return nil
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Int64 reads an int64 value, which may be quoted.
+func (d *decoder) Int64(raw json.RawMessage, v *int64) error {
+	raw = unquoteNumber(raw)
+	if isJSONInt(raw) {
+		if n, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+			*v = n
+			return nil
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Uint32 reads a uint32 value, which may be quoted.
+func (d *decoder) Uint32(raw json.RawMessage, v *uint32) error {
+	raw = unquoteNumber(raw)
+	if isJSONInt(raw) {
+		if n, err := strconv.ParseUint(string(raw), 10, 32); err == nil {
+			*v = uint32(n)
+			return nil
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Uint64 reads a uint64 value, which may be quoted.
+func (d *decoder) Uint64(raw json.RawMessage, v *uint64) error {
+	raw = unquoteNumber(raw)
+	if isJSONInt(raw) {
+		if n, err := strconv.ParseUint(string(raw), 10, 64); err == nil {
+			*v = n
+			return nil
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Float32 reads a float value, which may be quoted.
+func (d *decoder) Float32(raw json.RawMessage, v *float32) error {
+	if f, ok := nonFinite[string(raw)]; ok {
+		*v = float32(f)
+		return nil
+	}
+	return json.Unmarshal(unquoteNumber(raw), v)
+}
+
+// Float64 reads a double value, which may be quoted.
+func (d *decoder) Float64(raw json.RawMessage, v *float64) error {
+	if f, ok := nonFinite[string(raw)]; ok {
+		*v = f
+		return nil
+	}
+	return json.Unmarshal(unquoteNumber(raw), v)
+}
+
+// String reads a string value.
+func (d *decoder) String(raw json.RawMessage, v *string) error {
+	if n := len(raw); n >= 2 && raw[0] == '"' && raw[n-1] == '"' {
+		plain := true
+		for _, c := range raw[1 : n-1] {
+			if c < 0x20 || c >= 0x80 || c == '"' || c == '\\' {
+				plain = false
+				break
+			}
+		}
+		if plain {
+			*v = string(raw[1 : n-1])
+			return nil
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Bytes reads a bytes value in base64. Unlike the other value methods,
+// it sets *v to nil if the input is null.
+func (d *decoder) Bytes(raw json.RawMessage, v *[]byte) error {
+	return json.Unmarshal(raw, v)
+}
+
+// Enum reads an enum value, given either by its number or by one of
+// the names of the values map of the enum type, whose name is enum.
+func (d *decoder) Enum(raw json.RawMessage, v *int32, values map[string]int32, enum string) error {
+	if len(raw) > 0 && raw[0] == '"' {
+		// Valid enum names are from a limited character set,
+		// so there is no need to unquote them.
+		s := raw[1 : len(raw)-1]
+		n, ok := values[string(s)]
+		if !ok {
+			return fmt.Errorf("unknown value %q for enum %s", s, enum)
+		}
+		*v = n
+		return nil
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Message reads a message value into pb, which must not be nil.
+// The original name of the field is used in errors.
+func (d *decoder) Message(raw json.RawMessage, pb proto.Message, name string) error {
+	return d.u.unmarshalValue(reflect.ValueOf(pb).Elem(), raw, &proto.Properties{OrigName: name})
+}
+
+// List reads the elements of a list value. It returns nil if
+// the input is null.
+func (d *decoder) List(raw json.RawMessage, name string) ([]json.RawMessage, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		return nil, err
+	}
+	if err := checkElements(&d.u.Options, len(elems), name); err != nil {
+		return nil, err
+	}
+	return elems, nil
+}
+
+// Map reads the elements of a map value. It returns nil if the input
+// is null. Keys that are not strings are read with the value methods,
+// as in d.Int32([]byte(k), &key).
+func (d *decoder) Map(raw json.RawMessage, name string) (map[string]json.RawMessage, error) {
+	var elems map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		return nil, err
+	}
+	if err := checkElements(&d.u.Options, len(elems), name); err != nil {
+		return nil, err
+	}
+	return elems, nil
+}
+
+// unquoteNumber drops the quotes of a number given as a string.
+func unquoteNumber(raw json.RawMessage) json.RawMessage {
+	if len(raw) > 0 && raw[0] == '"' {
+		return raw[1 : len(raw)-1]
+	}
+	return raw
+}
+
+// isJSONInt reports whether raw is an integer in JSON syntax.
+func isJSONInt(raw json.RawMessage) bool {
+	if len(raw) > 0 && raw[0] == '-' {
+		raw = raw[1:]
+	}
+	if len(raw) == 0 || raw[0] == '0' && len(raw) > 1 {
+		return false
+	}
+	for _, c := range raw {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
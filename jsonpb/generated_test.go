@@ -0,0 +1,495 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb_test
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	"github.com/golang/protobuf/ptypes"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	stpb "github.com/golang/protobuf/ptypes/struct"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+
+	// A copy of the jsonpb test protos, in the jsonpb_generated proto
+	// package, with JSON methods generated by the jsonpb plugin of
+	// protoc-gen-go. Its types are mostly looked up by name.
+	genpb "github.com/golang/protobuf/jsonpb/jsonpb_generated_test_proto"
+)
+
+// generatedName returns the name of the generated copy of a type,
+// extension or enum named name in the jsonpb proto package.
+func generatedName(name string) string {
+	if strings.HasPrefix(name, "jsonpb.") {
+		return "jsonpb_generated." + strings.TrimPrefix(name, "jsonpb.")
+	}
+	return name
+}
+
+// generatedResolver resolves the types of the jsonpb test protos
+// to their generated copies.
+type generatedResolver struct{}
+
+func (generatedResolver) Resolve(typeURL string) (proto.Message, error) {
+	name := generatedName(typeURL[strings.LastIndex(typeURL, "/")+1:])
+	mt := proto.MessageType(name)
+	if mt == nil {
+		return nil, fmt.Errorf("unknown message type %q", name)
+	}
+	return reflect.New(mt.Elem()).Interface().(proto.Message), nil
+}
+
+// convert returns a copy of the message m, of the type named name,
+// made through the wire format.
+func convert(t *testing.T, m proto.Message, name string) proto.Message {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("proto.Marshal(%v): %v", m, err)
+	}
+	mt := proto.MessageType(name)
+	if mt == nil {
+		t.Fatalf("unknown message type %q", name)
+	}
+	c := reflect.New(mt.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(b, c); err != nil {
+		t.Fatalf("proto.Unmarshal(%v): %v", m, err)
+	}
+	return c
+}
+
+func mustMarshalAny(t *testing.T, m proto.Message) *anypb.Any {
+	a, err := ptypes.MarshalAny(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func generatedMessages(t *testing.T) []proto.Message {
+	real := &pb.Real{Value: proto.Float64(3.5)}
+	if err := proto.SetExtension(real, pb.E_Name, proto.String("Cat")); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(real, pb.E_Complex_RealExtension, &pb.Complex{Imaginary: proto.Float64(0.5)}); err != nil {
+		t.Fatal(err)
+	}
+	set := &pb.MsgSet{}
+	if err := proto.SetExtension(set, pb.E_MsgSetItem_MessageSetExtension, &pb.MsgSetItem{Name: proto.String("item")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(set, pb.E_RealItem, real); err != nil {
+		t.Fatal(err)
+	}
+	extended := &pb.Real{}
+	if err := proto.SetExtension(extended, pb.E_Reals, []*pb.Real{real, {}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(extended, pb.E_Color, pb.Widget_BLUE.Enum()); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(extended, pb.E_Counts, []int64{1 << 60, -1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(extended, pb.E_Extgroup, &pb.ExtGroup{Label: proto.String("l")}); err != nil {
+		t.Fatal(err)
+	}
+	return []proto.Message{
+		set,
+		&pb.Extended{
+			Real:          extended,
+			Reals:         []*pb.Real{real, extended},
+			RealMap:       map[string]*pb.Real{"a": real, "b": extended},
+			Choice:        &pb.Extended_Choicegroup{Choicegroup: &pb.Extended_ChoiceGroup{Real: extended}},
+			Optionalgroup: &pb.Extended_OptionalGroup{Real: real, Tags: []string{"x", "y"}},
+			Repeatedgroup: []*pb.Extended_RepeatedGroup{{N: proto.Int32(1)}, {}},
+			MsgSet:        set,
+		},
+		&pb.Extended{Choice: &pb.Extended_RealChoice{RealChoice: extended}},
+		&pb.Simple{},
+		&pb.Simple{
+			OBool:      proto.Bool(true),
+			OInt32:     proto.Int32(-32),
+			OInt32Str:  proto.Int32(32),
+			OInt64:     proto.Int64(-1 << 62),
+			OInt64Str:  proto.Int64(1 << 62),
+			OUint32:    proto.Uint32(math.MaxUint32),
+			OUint32Str: proto.Uint32(0),
+			OUint64:    proto.Uint64(math.MaxUint64),
+			OUint64Str: proto.Uint64(64),
+			OSint32:    proto.Int32(math.MinInt32),
+			OSint32Str: proto.Int32(-13),
+			OSint64:    proto.Int64(math.MinInt64),
+			OSint64Str: proto.Int64(-1),
+			OFloat:     proto.Float32(3.4028235e38),
+			OFloatStr:  proto.Float32(1e-7),
+			ODouble:    proto.Float64(1e21),
+			ODoubleStr: proto.Float64(-0.000001),
+			OString:    proto.String("<a & b>\t\"c\"\\ \u2028 é \x01 \xff"),
+			OBytes:     []byte("\x00\xffbytes"),
+		},
+		&pb.Simple{OFloat: proto.Float32(0), ODouble: proto.Float64(123456789.25), OString: proto.String(""), OBytes: []byte{}},
+		&pb.NonFinites{
+			FNan:  proto.Float32(float32(math.NaN())),
+			FPinf: proto.Float32(float32(math.Inf(1))),
+			FNinf: proto.Float32(float32(math.Inf(-1))),
+			DNan:  proto.Float64(math.NaN()),
+			DPinf: proto.Float64(math.Inf(1)),
+			DNinf: proto.Float64(math.Inf(-1)),
+		},
+		&pb.Repeats{},
+		&pb.Repeats{
+			RBool:   []bool{true, false},
+			RInt32:  []int32{-1, 0, 1},
+			RInt64:  []int64{math.MaxInt64},
+			RUint32: []uint32{7},
+			RUint64: []uint64{8, 9},
+			RSint32: []int32{-10},
+			RSint64: []int64{-11},
+			RFloat:  []float32{1.5, float32(math.Inf(1))},
+			RDouble: []float64{2.25, math.NaN(), 1e-7},
+			RString: []string{"x", "", "<y>"},
+			RBytes:  [][]byte{[]byte("z"), {}},
+		},
+		&pb.Widget{},
+		&pb.Widget{
+			Color:    pb.Widget_BLUE.Enum(),
+			RColor:   []pb.Widget_Color{pb.Widget_RED, pb.Widget_GREEN, 7},
+			Simple:   &pb.Simple{OInt32: proto.Int32(1)},
+			RSimple:  []*pb.Simple{{}, {OString: proto.String("s")}},
+			Repeats:  &pb.Repeats{RString: []string{"a"}},
+			RRepeats: []*pb.Repeats{{RInt32: []int32{1, 2}}, {}},
+		},
+		&pb.Widget{Color: pb.Widget_Color(42).Enum()},
+		&pb.Maps{
+			MInt64Str:   map[int64]string{-2: "neg", 10: "ten", 9: "nine"},
+			MBoolSimple: map[bool]*pb.Simple{true: {OBool: proto.Bool(true)}, false: {}},
+		},
+		&pb.MsgWithOneof{},
+		&pb.MsgWithOneof{Union: &pb.MsgWithOneof_Title{Title: ""}},
+		&pb.MsgWithOneof{Union: &pb.MsgWithOneof_Salary{Salary: 31000}},
+		&pb.MsgWithOneof{Union: &pb.MsgWithOneof_Country{Country: "Australia"}},
+		&pb.MsgWithOneof{Union: &pb.MsgWithOneof_HomeAddress{HomeAddress: "Sydney"}},
+		&pb.MsgWithOneof{Union: &pb.MsgWithOneof_MsgWithRequired{MsgWithRequired: &pb.MsgWithRequired{Str: proto.String("req")}}},
+		real,
+		&pb.Complex{Imaginary: proto.Float64(-1)},
+		&pb.KnownTypes{},
+		&pb.KnownTypes{
+			An:  mustMarshalAny(t, &pb.Widget{Color: pb.Widget_GREEN.Enum(), RSimple: []*pb.Simple{{OInt64: proto.Int64(1)}}}),
+			Dur: &durpb.Duration{Seconds: 3, Nanos: 500},
+			St: &stpb.Struct{Fields: map[string]*stpb.Value{
+				"one":  {Kind: &stpb.Value_NumberValue{NumberValue: 1}},
+				"list": {Kind: &stpb.Value_ListValue{ListValue: &stpb.ListValue{Values: []*stpb.Value{{Kind: &stpb.Value_StringValue{StringValue: "x"}}}}}},
+			}},
+			Ts:    &tspb.Timestamp{Seconds: 14e8, Nanos: 1e6},
+			Lv:    &stpb.ListValue{Values: []*stpb.Value{{Kind: &stpb.Value_BoolValue{BoolValue: true}}, {Kind: &stpb.Value_NullValue{}}}},
+			Val:   &stpb.Value{Kind: &stpb.Value_StringValue{StringValue: "v"}},
+			Dbl:   &wpb.DoubleValue{Value: 1.5},
+			Flt:   &wpb.FloatValue{Value: 2.5},
+			I64:   &wpb.Int64Value{Value: -64},
+			U64:   &wpb.UInt64Value{Value: 64},
+			I32:   &wpb.Int32Value{Value: -32},
+			U32:   &wpb.UInt32Value{Value: 32},
+			Bool:  &wpb.BoolValue{Value: true},
+			Str:   &wpb.StringValue{Value: "str"},
+			Bytes: &wpb.BytesValue{Value: []byte("bytes")},
+		},
+		&pb.KnownTypes{An: mustMarshalAny(t, &durpb.Duration{Seconds: 1})},
+		&pb.MsgWithIndirectRequired{
+			Subm:       &pb.MsgWithRequired{Str: proto.String("a")},
+			MapField:   map[string]*pb.MsgWithRequired{"b": {Str: proto.String("b")}, "a\"": {Str: proto.String("")}},
+			SliceField: []*pb.MsgWithRequired{{Str: proto.String("c")}},
+		},
+		&pb.Simple3{},
+		&pb.Simple3{Dub: -2.5},
+		&pb.SimpleSlice3{Slices: []string{"foo", "bar"}},
+		&pb.SimpleMap3{Stringy: map[string]string{"b": "2", "a": "1", "": ""}},
+		&pb.SimpleNull3{},
+		&pb.SimpleNull3{Simple: &pb.Simple3{}},
+		&pb.Mappy{
+			Nummy:    map[int64]int32{1: 2, -3: 4},
+			Strry:    map[string]string{`"one"`: "two", "three": "four"},
+			Objjy:    map[int32]*pb.Simple3{1: {Dub: 1}, 0: {}},
+			Buggy:    map[int64]string{1234: "yup"},
+			Booly:    map[bool]bool{true: false, false: true},
+			Enumy:    map[string]pb.Numeral{"XIV": pb.Numeral_ROMAN, "x": 9},
+			S32Booly: map[int32]bool{1: true, 3: false, 10: true, 12: false},
+			S64Booly: map[int64]bool{1: true, 3: false, 10: true, 12: false},
+			U32Booly: map[uint32]bool{1: true, 3: false, 10: true, 12: false},
+			U64Booly: map[uint64]bool{1: true, 3: false, 10: true, 12: false},
+		},
+		&pb.FieldOptions3{},
+		&pb.FieldOptions3{
+			Numeral:    pb.Numeral_ROMAN,
+			Name:       pb.Numeral_ARABIC,
+			Numerals:   []pb.Numeral{pb.Numeral_ARABIC, 7},
+			NumeralMap: map[string]pb.Numeral{"x": pb.Numeral_ROMAN},
+			Count:      -1 << 60,
+			Total:      1 << 63,
+			Counts:     []uint64{0, 1 << 60},
+			Note:       "note",
+			Simple:     &pb.Simple3{Dub: 1},
+		},
+		&pb.Secrets3{
+			User:     "gopher",
+			Password: "hunter2",
+			Key:      []byte("key"),
+			Pin:      1234,
+			Numeral:  pb.Numeral_ROMAN,
+			Tokens:   []string{"t1", ""},
+			Keys:     [][]byte{[]byte("k1"), {}},
+			Labels:   map[string]string{"secret": "label"},
+			Inner:    &pb.Simple3{Dub: 1},
+			Choice:   &pb.Secrets3_Code{Code: "code"},
+		},
+		&pb.Secrets3{Password: "", Key: []byte{}, Tokens: []string{"", "t"}, Choice: &pb.Secrets3_Serial{Serial: 7}},
+	}
+}
+
+func TestGeneratedMarshal(t *testing.T) {
+	var marshalers []*jsonpb.Marshaler
+	for _, indent := range []string{"", "  ", "\t"} {
+		for opts := 0; opts < 64; opts++ {
+			m := &jsonpb.Marshaler{
+				Indent:          indent,
+				OrigName:        opts&1 != 0,
+				EnumsAsInts:     opts&2 != 0,
+				EmitDefaults:    opts&4 != 0,
+				Int64sAsNumbers: opts&16 != 0,
+				RedactSensitive: opts&32 != 0,
+			}
+			if opts&8 != 0 {
+				m.Naming = jsonpb.UpperCamelCase
+			}
+			marshalers = append(marshalers, m)
+		}
+	}
+	for _, m := range generatedMessages(t) {
+		name := proto.MessageName(m)
+		want := convert(t, m, name)
+		got := convert(t, m, generatedName(name))
+		if _, ok := got.(jsonpb.JSONPBMarshaler); !ok {
+			t.Fatalf("%T has no generated JSON methods", got)
+		}
+		for _, jm := range marshalers {
+			wantJSON, err := jm.MarshalToString(want)
+			if err != nil {
+				t.Errorf("marshaling %v with %+v: %v", want, *jm, err)
+				continue
+			}
+			wantJSON = strings.Replace(wantJSON, "[jsonpb.", "[jsonpb_generated.", -1)
+			gm := *jm
+			gm.AnyResolver = generatedResolver{}
+			gotJSON, err := gm.MarshalToString(got)
+			if err != nil {
+				t.Errorf("marshaling generated %v with %+v: %v", got, *jm, err)
+				continue
+			}
+			if gotJSON != wantJSON {
+				t.Errorf("marshaling generated %s with %+v:\n got %q\nwant %q", name, *jm, gotJSON, wantJSON)
+			}
+			b, err := got.(jsonpb.JSONPBMarshaler).MarshalJSONPB(&gm)
+			if err != nil || string(b) != gotJSON {
+				t.Errorf("MarshalJSONPB of generated %s with %+v = %q, %v; want %q", name, *jm, b, err, gotJSON)
+			}
+		}
+	}
+}
+
+func TestGeneratedUnmarshal(t *testing.T) {
+	tests := []struct {
+		name, json string
+	}{
+		{"jsonpb.Simple", `{"oBool":true,"oInt32":-32,"oInt32Str":"32","oInt64":"-7","oInt64Str":7,"oUint32":"4294967295",` +
+			`"oUint64":18446744073709551615,"oSint32":-0,"oSint64Str":"-1","oFloat":"1.5","oFloatStr":"NaN","oDouble":1e21,` +
+			`"oDoubleStr":"-Infinity","oString":"\u003cé\\\"\u2028","oBytes":"AP9ieXRlcw=="}`},
+		{"jsonpb.Simple", `{"o_bool":false,"o_int32":null,"o_string":"","o_bytes":null,"oString":"camel wins","o_double":0}`},
+		{"jsonpb.Simple", `{"oInt32":1.5}`},
+		{"jsonpb.Simple", `{"oInt32":2147483648}`},
+		{"jsonpb.Simple", `{"oInt32":01}`},
+		{"jsonpb.Simple", `{"oBool":"true"}`},
+		{"jsonpb.Simple", `{"oString":3}`},
+		{"jsonpb.Simple", `{"unknown":3}`},
+		{"jsonpb.Simple", `[]`},
+		{"jsonpb.Simple", `null`},
+		{"jsonpb.NonFinites", `{"fNan":"NaN","fPinf":"Infinity","fNinf":"-Infinity","dNan":"NaN","dPinf":"Infinity","dNinf":"-Infinity"}`},
+		{"jsonpb.Repeats", `{"rBool":[true,false],"rInt32":[1,"-2"],"rInt64":["3",4],"rUint32":[5],"rUint64":["6"],"rSint32":[-7],` +
+			`"rSint64":[-8],"rFloat":[9.5,"Infinity"],"rDouble":[10,"NaN"],"rString":["a",""],"rBytes":["YQ==",null]}`},
+		{"jsonpb.Repeats", `{"rBool":null,"r_int32":[]}`},
+		{"jsonpb.Repeats", `{"rBool":true}`},
+		{"jsonpb.Widget", `{"color":"BLUE","rColor":["RED",1,7],"simple":{"oInt32":1},"rSimple":[{},{"oString":"s"}],` +
+			`"repeats":{"rString":["a"]},"rRepeats":[{"rInt32":[1,2]}]}`},
+		{"jsonpb.Widget", `{"color":2,"simple":null}`},
+		{"jsonpb.Widget", `{"color":"PURPLE"}`},
+		{"jsonpb.Widget", `{"simple":{"oInt32":"x"}}`},
+		{"jsonpb.Maps", `{"mInt64Str":{"-2":"neg","10":"ten"},"mBoolSimple":{"true":{"oBool":true},"false":null}}`},
+		{"jsonpb.Maps", `{"mInt64Str":{"x":"neg"}}`},
+		{"jsonpb.MsgWithOneof", `{"title":"t"}`},
+		{"jsonpb.MsgWithOneof", `{"salary":"31000"}`},
+		{"jsonpb.MsgWithOneof", `{"Country":"Australia"}`},
+		{"jsonpb.MsgWithOneof", `{"home_address":"Sydney"}`},
+		{"jsonpb.MsgWithOneof", `{"msgWithRequired":{"str":"req"}}`},
+		{"jsonpb.MsgWithOneof", `{"msgWithRequired":null}`},
+		{"jsonpb.MsgWithOneof", `{"msgWithRequired":{}}`},
+		{"jsonpb.Real", `{"value":3.5,"[jsonpb.name]":"Cat","[jsonpb.Complex.real_extension]":{"imaginary":0.5}}`},
+		{"jsonpb.Real", `{"[jsonpb.nope]":1}`},
+		{"jsonpb.KnownTypes", `{"an":{"@type":"type.googleapis.com/jsonpb.Widget","color":"GREEN"},"dur":"3.000000500s",` +
+			`"st":{"one":1,"list":["x",null]},"ts":"2014-05-13T16:53:20.021Z","lv":[true,null],"val":null,"dbl":1.5,"flt":"2.5",` +
+			`"i64":"-64","u64":64,"i32":-32,"u32":"32","bool":true,"str":"str","bytes":"Ynl0ZXM="}`},
+		{"jsonpb.KnownTypes", `{"an":{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1s"},"dbl":null}`},
+		{"jsonpb.MsgWithIndirectRequired", `{"subm":{"str":"a"},"mapField":{"b":{"str":"b"}},"sliceField":[{"str":"c"}]}`},
+		{"jsonpb.MsgWithIndirectRequired", `{"subm":{}}`},
+		{"jsonpb.MsgWithRequired", `{}`},
+		{"jsonpb.Simple3", `{"dub":"-2.5"}`},
+		{"jsonpb.SimpleSlice3", `{"slices":["foo","bar"]}`},
+		{"jsonpb.SimpleMap3", `{"stringy":{"a":"1","":""}}`},
+		{"jsonpb.SimpleNull3", `{"simple":null}`},
+		{"jsonpb.SimpleNull3", `{"simple":{}}`},
+		{"jsonpb.Mappy", `{"nummy":{"1":2,"-3":"4"},"strry":{"\"one\"":"two"},"objjy":{"1":{"dub":1},"0":null},"buggy":{"1234":"yup"},` +
+			`"booly":{"true":false,"false":true},"enumy":{"XIV":2,"x":"9"},"s32booly":{"1":true},"s64booly":{"3":false},` +
+			`"u32booly":{"10":true},"u64booly":{"12":false}}`},
+		{"jsonpb.Mappy", `{"booly":{"yes":true}}`},
+		{"jsonpb.Mappy", `{"enumy":{"XIV":"ROMAN"}}`},
+		{"jsonpb.Extended", `{"real":{"[jsonpb.reals]":[{"value":1},{}],"[jsonpb.color]":"BLUE","[jsonpb.counts]":["1",-2],` +
+			`"[jsonpb.extgroup]":{"label":"l"}},"realMap":{"a":{"[jsonpb.name]":"n"}},"choicegroup":{"real":{"[jsonpb.color]":1}},` +
+			`"OptionalGroup":{"tags":["x"]},"repeatedgroup":[{"n":1},{}],"msgSet":{"[jsonpb.MsgSetItem]":{"name":"i"},"[jsonpb.real_item]":{}}}`},
+		{"jsonpb.Extended", `{"ChoiceGroup":{},"realChoice":{}}`},
+		{"jsonpb.Extended", `{"real":{"[jsonpb.color]":"PURPLE"}}`},
+		{"jsonpb.MsgSet", `{"[jsonpb.MsgSetItem]":{"name":"i"}}`},
+		{"jsonpb.MsgSet", `{"[jsonpb.MsgSetItem.message_set_extension]":{}}`},
+	}
+	for _, tt := range tests {
+		want := reflect.New(proto.MessageType(tt.name).Elem()).Interface().(proto.Message)
+		wantErr := jsonpb.UnmarshalString(tt.json, want)
+
+		got := reflect.New(proto.MessageType(generatedName(tt.name)).Elem()).Interface().(proto.Message)
+		u := &jsonpb.Unmarshaler{AnyResolver: generatedResolver{}}
+		gotErr := u.Unmarshal(strings.NewReader(strings.Replace(tt.json, "[jsonpb.", "[jsonpb_generated.", -1)), got)
+
+		if (gotErr != nil) != (wantErr != nil) {
+			t.Errorf("unmarshaling %s into generated %s: got error %v, want %v", tt.json, tt.name, gotErr, wantErr)
+			continue
+		}
+		if wantErr != nil {
+			continue
+		}
+		// The text format shows oneofs holding nil messages,
+		// which the wire format cannot hold.
+		wantText := strings.Replace(proto.MarshalTextString(want), "[jsonpb.", "[jsonpb_generated.", -1)
+		if gotText := proto.MarshalTextString(got); gotText != wantText {
+			t.Errorf("unmarshaling %s into generated %s:\n got %s\nwant %s", tt.json, tt.name, gotText, wantText)
+		}
+	}
+}
+
+func TestGeneratedUnmarshalStrict(t *testing.T) {
+	got := reflect.New(proto.MessageType("jsonpb_generated.Widget").Elem()).Interface().(proto.Message)
+	u := &jsonpb.Unmarshaler{Strict: true}
+	err := u.Unmarshal(strings.NewReader(`{"rSimple":[{},{"oBool":true,"o_bool":false}]}`), got)
+	if pe, ok := err.(*jsonpb.PathError); !ok || pe.Path != "$.rSimple[1]" {
+		t.Errorf("strict unmarshaling into generated Widget: got error %v, want PathError at $.rSimple[1]", err)
+	}
+}
+
+// hookedWidget has JSON hooks of its own, which the jsonpb package calls
+// in place of those generated for the message it embeds.
+type hookedWidget struct {
+	*genpb.Widget
+}
+
+func (h *hookedWidget) MarshalJSONPB(m *jsonpb.Marshaler) ([]byte, error) {
+	b, err := h.Widget.MarshalJSONPB(m)
+	return []byte(`{"hooked":` + string(b) + `}`), err
+}
+
+func (h *hookedWidget) UnmarshalJSONPB(u *jsonpb.Unmarshaler, b []byte) error {
+	h.Widget = new(genpb.Widget)
+	return h.Widget.UnmarshalJSONPB(u, b[len(`{"hooked":`):len(b)-1])
+}
+
+func TestGeneratedHooks(t *testing.T) {
+	w := &genpb.Widget{
+		Color:  genpb.Widget_BLUE.Enum(),
+		Simple: &genpb.Simple{OInt64: proto.Int64(-1)},
+	}
+	m := &jsonpb.Marshaler{Indent: "  "}
+	want, err := m.MarshalToString(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Called directly, the generated methods handle the whole message.
+	b, err := w.MarshalJSONPB(m)
+	if err != nil || string(b) != want {
+		t.Errorf("MarshalJSONPB = %s, %v; want %s", b, err, want)
+	}
+	got := new(genpb.Widget)
+	if err := got.UnmarshalJSONPB(new(jsonpb.Unmarshaler), []byte(want)); err != nil || !proto.Equal(got, w) {
+		t.Errorf("UnmarshalJSONPB(%s) = %v, %v; want %v", want, got, err, w)
+	}
+
+	// The hooks of a type embedding a generated message are not bypassed.
+	s, err := new(jsonpb.Marshaler).MarshalToString(&hookedWidget{w})
+	if wantHooked := `{"hooked":{"color":"BLUE","simple":{"oInt64":"-1"}}}`; err != nil || s != wantHooked {
+		t.Errorf("MarshalToString(hooked) = %s, %v; want %s", s, err, wantHooked)
+	}
+	h := new(hookedWidget)
+	if err := jsonpb.UnmarshalString(s, h); err != nil || !proto.Equal(h.Widget, w) {
+		t.Errorf("UnmarshalString(%s) = %v, %v; want %v", s, h.Widget, err, w)
+	}
+
+	// A null message is left unset, as it is without the generated methods.
+	got = new(genpb.Widget)
+	if err := jsonpb.UnmarshalString(`{"simple":null,"rSimple":[{}]}`, got); err != nil || got.Simple != nil || len(got.RSimple) != 1 {
+		t.Errorf("UnmarshalString with a null message = %v, %v; want an unset simple field", got, err)
+	}
+}
+
+func TestGeneratedFileNames(t *testing.T) {
+	// The generated copies must not replace the descriptors of the
+	// jsonpb test protos.
+	for _, name := range []string{"test_objects.proto", "more_test_objects.proto"} {
+		orig, gen := proto.FileDescriptor(name), proto.FileDescriptor("jsonpb_generated/"+name)
+		if orig == nil || gen == nil || bytes.Equal(orig, gen) {
+			t.Errorf("descriptors of %s and its generated copy are not both registered", name)
+		}
+	}
+}
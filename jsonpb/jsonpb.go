@@ -35,6 +35,11 @@ It follows the specification at https://developers.google.com/protocol-buffers/d
 
 This package produces a different output than the standard "encoding/json" package,
 which does not operate correctly on protocol buffers.
+
+Proto2 extensions are written as fields named by the full names of the
+extensions in brackets, such as "[pkg.ext]", and the items of a MessageSet
+by the full names of their messages. Groups are written as messages, and
+are also read under the names of their types, as the text format writes them.
 */
 package jsonpb
 
@@ -62,9 +67,12 @@ const secondInNanos = int64(time.Second / time.Nanosecond)
 // protocol buffer objects and a JSON representation for them.
 type Marshaler struct {
 	// Whether to render enum values as integers, as opposed to string values.
+	// Fields whose network.api.json option sets enum_as_int are rendered
+	// as integers regardless.
 	EnumsAsInts bool
 
-	// Whether to render fields with zero values.
+	// Whether to render fields with zero values, except for those whose
+	// network.api.json option sets omit_default.
 	EmitDefaults bool
 
 	// A string to indent each level by. The presence of this field will
@@ -76,11 +84,35 @@ type Marshaler struct {
 	// Whether to use the original (.proto) name for fields.
 	OrigName bool
 
+	// A function that names fields in place of their JSON names, unless
+	// their json_name option sets a name. It is not used if OrigName is set.
+	Naming NamingStrategy
+
+	// Whether to render 64-bit integers as numbers, as opposed to strings.
+	// JavaScript loses the precision of those beyond 2^53. Fields whose
+	// network.api.json option sets int64_as_number are rendered as numbers
+	// regardless.
+	Int64sAsNumbers bool
+
 	// A custom URL resolver to use when marshaling Any messages to JSON.
 	// If unset, the default resolution strategy is to extract the
 	// fully-qualified type name from the type URL and pass that to
 	// proto.MessageType(string).
 	AnyResolver AnyResolver
+
+	// Whether to write fields marked with the network.api.sensitive
+	// option as proto.CloneRedacted leaves them, including those of
+	// messages held in Any fields: string and bytes fields, and repeated
+	// ones, hold proto.RedactedValue, and other sensitive fields are
+	// left out. The output still unmarshals into the message.
+	RedactSensitive bool
+
+	// Where to find the unknown fields that an Unmarshaler kept for the
+	// messages, which are written back after their other fields, unless
+	// their keys have since become names of fields of the message.
+	UnknownFields *UnknownFields
+
+	hooked *hookCall // the message whose MarshalJSONPB method is called
 }
 
 // AnyResolver takes a type URL, present in an Any message, and resolves it into
@@ -161,10 +193,17 @@ type wkt interface {
 // marshalObject writes a struct to the Writer.
 func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeURL string) error {
 	if jsm, ok := v.(JSONPBMarshaler); ok {
-		b, err := jsm.MarshalJSONPB(m)
+		// Generated methods write the message in place, given where
+		// it goes; see XXX_Encoder.
+		hm := *m
+		hm.hooked = &hookCall{pb: v, out: out, indent: indent, typeURL: typeURL}
+		b, err := jsm.MarshalJSONPB(&hm)
 		if err != nil {
 			return err
 		}
+		if hm.hooked.written {
+			return out.err
+		}
 		if typeURL != "" {
 			// we are marshaling this object to an Any type
 			var js map[string]*json.RawMessage
@@ -255,21 +294,100 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 		}
 	}
 
+	firstField, err := m.beginObject(out, indent, typeURL)
+	if err != nil {
+		return err
+	}
+	if firstField, err = m.marshalFields(out, s, 0, s.NumField(), indent, firstField); err != nil {
+		return err
+	}
+	return m.endObject(out, v, indent, firstField)
+}
+
+// beginObject starts writing a message, with the type URL of the Any
+// holding it, if any. It reports whether no field has been written.
+func (m *Marshaler) beginObject(out *errWriter, indent, typeURL string) (bool, error) {
 	out.write("{")
 	if m.Indent != "" {
 		out.write("\n")
 	}
-
-	firstField := true
-
 	if typeURL != "" {
 		if err := m.marshalTypeURL(out, indent, typeURL); err != nil {
-			return err
+			return false, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// endObject writes the extensions and unknown fields of the message v,
+// which are not preceded by a field if firstField is set, and ends it.
+func (m *Marshaler) endObject(out *errWriter, v proto.Message, indent string, firstField bool) error {
+	firstField, err := m.marshalExtensions(out, v, indent, firstField)
+	if err != nil {
+		return err
+	}
+	if _, err := m.marshalUnknown(out, v, indent, firstField); err != nil {
+		return err
+	}
+
+	if m.Indent != "" {
+		out.write("\n")
+		out.write(indent)
+	}
+	out.write("}")
+	return out.err
+}
+
+// marshalExtensions writes the proto2 extensions of v, which are not
+// preceded by a field if firstField is set. It reports whether no field
+// has been written after all.
+func (m *Marshaler) marshalExtensions(out *errWriter, v proto.Message, indent string, firstField bool) (bool, error) {
+	descs, _ := proto.ExtensionDescs(v)
+	extensions := make(map[int32]*proto.ExtensionDesc, len(descs))
+	// Sort extensions for stable output.
+	ids := make([]int32, 0, len(descs))
+	for _, desc := range descs {
+		if desc.ExtensionType == nil {
+			// unknown extension
+			continue
+		}
+		extensions[desc.Field] = desc
+		ids = append(ids, desc.Field)
+	}
+	sort.Sort(int32Slice(ids))
+	for _, id := range ids {
+		desc := extensions[id]
+		ext, extErr := proto.GetExtension(v, desc)
+		if extErr != nil {
+			return false, extErr
+		}
+		value := reflect.ValueOf(ext)
+		var prop proto.Properties
+		prop.Parse(desc.Tag)
+		prop.JSONName = fmt.Sprintf("[%s]", desc.Name)
+		if m.RedactSensitive && prop.Sensitive {
+			if value = redactedValue(value); !value.IsValid() {
+				continue
+			}
+		}
+		if !firstField {
+			m.writeSep(out)
+		}
+		if err := m.marshalField(out, &prop, value, indent); err != nil {
+			return false, err
 		}
 		firstField = false
 	}
+	return firstField, nil
+}
 
-	for i := 0; i < s.NumField(); i++ {
+// marshalFields writes the fields of the struct s with indices in
+// [from, to), which are not preceded by a field if firstField is set.
+// It reports whether no field has been written after all.
+func (m *Marshaler) marshalFields(out *errWriter, s reflect.Value, from, to int, indent string, firstField bool) (bool, error) {
+	options := jsonFieldOptions(s.Addr().Type())
+	for i := from; i < to; i++ {
 		value := s.Field(i)
 		valueField := s.Type().Field(i)
 		if strings.HasPrefix(valueField.Name, "XXX_") {
@@ -284,33 +402,9 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 			}
 		}
 
-		if !m.EmitDefaults {
-			switch value.Kind() {
-			case reflect.Bool:
-				if !value.Bool() {
-					continue
-				}
-			case reflect.Int32, reflect.Int64:
-				if value.Int() == 0 {
-					continue
-				}
-			case reflect.Uint32, reflect.Uint64:
-				if value.Uint() == 0 {
-					continue
-				}
-			case reflect.Float32, reflect.Float64:
-				if value.Float() == 0 {
-					continue
-				}
-			case reflect.String:
-				if value.Len() == 0 {
-					continue
-				}
-			case reflect.Map, reflect.Ptr, reflect.Slice:
-				if value.IsNil() {
-					continue
-				}
-			}
+		zero := isZeroValue(value)
+		if zero && !m.EmitDefaults {
+			continue
 		}
 
 		// Oneof fields need special handling.
@@ -320,59 +414,45 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 			value = sv.Field(0)
 			valueField = sv.Type().Field(0)
 		}
-		prop := jsonProperties(valueField, m.OrigName)
-		if !firstField {
-			m.writeSep(out)
-		}
-		if err := m.marshalField(out, prop, value, indent); err != nil {
-			return err
+		prop := m.jsonProperties(valueField)
+		opts := options[prop.OrigName]
+		if zero && opts.omitDefault {
+			continue
 		}
-		firstField = false
-	}
-
-	// Handle proto2 extensions.
-	if ep, ok := v.(proto.Message); ok {
-		extensions := proto.RegisteredExtensions(v)
-		// Sort extensions for stable output.
-		ids := make([]int32, 0, len(extensions))
-		for id, desc := range extensions {
-			if !proto.HasExtension(ep, desc) {
+		if m.RedactSensitive && prop.Sensitive {
+			if value = redactedValue(value); !value.IsValid() {
 				continue
 			}
-			ids = append(ids, id)
 		}
-		sort.Sort(int32Slice(ids))
-		for _, id := range ids {
-			desc := extensions[id]
-			if desc == nil {
-				// unknown extension
-				continue
-			}
-			ext, extErr := proto.GetExtension(ep, desc)
-			if extErr != nil {
-				return extErr
-			}
-			value := reflect.ValueOf(ext)
-			var prop proto.Properties
-			prop.Parse(desc.Tag)
-			prop.JSONName = fmt.Sprintf("[%s]", desc.Name)
-			if !firstField {
-				m.writeSep(out)
-			}
-			if err := m.marshalField(out, &prop, value, indent); err != nil {
-				return err
-			}
-			firstField = false
+		if !firstField {
+			m.writeSep(out)
 		}
-
+		if err := m.withFieldOptions(opts).marshalField(out, prop, value, indent); err != nil {
+			return false, err
+		}
+		firstField = false
 	}
+	return firstField, nil
+}
 
-	if m.Indent != "" {
-		out.write("\n")
-		out.write(indent)
+// isZeroValue reports whether v, the value of a field, is not written
+// unless EmitDefaults is set.
+func isZeroValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.String:
+		return v.Len() == 0
+	case reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
 	}
-	out.write("}")
-	return out.err
+	return false
 }
 
 func (m *Marshaler) writeSep(out *errWriter) {
@@ -456,20 +536,61 @@ func (m *Marshaler) marshalTypeURL(out *errWriter, indent, typeURL string) error
 
 // marshalField writes field description and value to the Writer.
 func (m *Marshaler) marshalField(out *errWriter, prop *proto.Properties, v reflect.Value, indent string) error {
+	m.marshalFieldName(out, prop.JSONName, indent)
+	if err := m.marshalValue(out, prop, v, indent); err != nil {
+		return err
+	}
+	return nil
+}
+
+// redactedValue returns the value written for the sensitive field v when
+// RedactSensitive is set, which is the value proto.CloneRedacted leaves in
+// it: strings and bytes that aren't empty, and the elements of repeated
+// ones, are replaced with proto.RedactedValue. It returns the zero Value
+// for fields of other types, which are left out.
+func redactedValue(v reflect.Value) reflect.Value {
+	t := v.Type()
+	switch {
+	case t.Kind() == reflect.String:
+		if v.Len() > 0 {
+			return reflect.ValueOf(proto.RedactedValue).Convert(t)
+		}
+		return v
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.String:
+		if v.IsNil() {
+			return v
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(redactedValue(v.Elem()))
+		return p
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		if v.Len() > 0 {
+			return reflect.ValueOf([]byte(proto.RedactedValue)).Convert(t)
+		}
+		return v
+	case t.Kind() == reflect.Slice && (t.Elem().Kind() == reflect.String ||
+		t.Elem().Kind() == reflect.Slice && t.Elem().Elem().Kind() == reflect.Uint8):
+		s := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s.Index(i).Set(redactedValue(v.Index(i)))
+		}
+		return s
+	}
+	return reflect.Value{}
+}
+
+// marshalFieldName writes the name of a field, followed by a colon.
+func (m *Marshaler) marshalFieldName(out *errWriter, name, indent string) {
 	if m.Indent != "" {
 		out.write(indent)
 		out.write(m.Indent)
 	}
 	out.write(`"`)
-	out.write(prop.JSONName)
+	out.write(name)
 	out.write(`":`)
 	if m.Indent != "" {
 		out.write(" ")
 	}
-	if err := m.marshalValue(out, prop, v, indent); err != nil {
-		return err
-	}
-	return nil
 }
 
 // marshalValue writes the value to the Writer.
@@ -622,7 +743,7 @@ func (m *Marshaler) marshalValue(out *errWriter, prop *proto.Properties, v refle
 	if err != nil {
 		return err
 	}
-	needToQuote := string(b[0]) != `"` && (v.Kind() == reflect.Int64 || v.Kind() == reflect.Uint64)
+	needToQuote := string(b[0]) != `"` && (v.Kind() == reflect.Int64 || v.Kind() == reflect.Uint64) && !m.Int64sAsNumbers
 	if needToQuote {
 		out.write(`"`)
 	}
@@ -640,11 +761,152 @@ type Unmarshaler struct {
 	// failing to unmarshal.
 	AllowUnknownFields bool
 
+	// Where to keep the unknown fields of the messages, as opposed to
+	// failing to unmarshal, so that a Marshaler with the same
+	// UnknownFields writes them back and a proxy passes on fields it
+	// does not know about. Options.DiscardUnknown drops them instead.
+	UnknownFields *UnknownFields
+
+	// A function that names fields, whose names are accepted in addition
+	// to their original and JSON names, unless their json_name option
+	// sets a name.
+	Naming NamingStrategy
+
 	// A custom URL resolver to use when unmarshaling Any messages from JSON.
 	// If unset, the default resolution strategy is to extract the
 	// fully-qualified type name from the type URL and pass that to
 	// proto.MessageType(string).
 	AnyResolver AnyResolver
+
+	// Limits on the depth, size and number of elements of the input,
+	// for decoding untrusted data. Options.DiscardUnknown has the same
+	// effect as AllowUnknownFields. Exceeding a limit results in a
+	// *proto.LimitError.
+	Options proto.UnmarshalOptions
+
+	// Whether to reject input that the default lenient mode accepts:
+	// objects with the same key twice, fields given under both their
+	// original and JSON names, null elements of repeated fields and maps
+	// (except for google.protobuf.Value elements), and keys that only
+	// match the name of a field if case is ignored, even if unknown fields
+	// are allowed. Errors are reported as a *PathError holding the JSON
+	// path of the offending value. Generated JSON methods are not used
+	// in strict mode.
+	Strict bool
+
+	// The call that a copy of the Unmarshaler is passed to a generated
+	// UnmarshalJSONPB method for; see XXX_Decoder.
+	state *unmarshalState
+}
+
+// An unmarshalState is the state of a call decoding JSON with an
+// Unmarshaler, which is not shared between calls.
+type unmarshalState struct {
+	*Unmarshaler
+
+	depth int  // nesting depth of the message being decoded
+	paths bool // whether errors record the JSON path even if not strict
+
+	// The message whose UnmarshalJSONPB method is called, one that is
+	// decoded without calling its method, and whether a generated method
+	// found null and leaves its message unset; see XXX_Decoder.
+	hooked interface{}
+	plain  interface{}
+	null   bool
+}
+
+// A PathError is an error of a strict Unmarshaler, with the JSON path
+// of the value it is about, such as $.widget.rSimple[2].
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// tracksPaths reports whether errors of u record the JSON path of the
+// value they are about.
+func (u *unmarshalState) tracksPaths() bool {
+	return u.Strict || u.paths
+}
+
+// atPath returns err, if any, as an error about the value at elem
+// relative to the value being decoded, if u tracks paths. elem is a
+// path element, such as one returned by memberPath. Limit errors
+// are returned as they are, since they name the field at fault.
+func (u *unmarshalState) atPath(err error, elem string) error {
+	if err == nil || !u.tracksPaths() {
+		return err
+	}
+	switch e := err.(type) {
+	case *proto.LimitError:
+		return err
+	case *PathError:
+		e.Path = elem + e.Path
+		return e
+	}
+	return &PathError{Path: elem, Err: err}
+}
+
+// memberPath returns the path element of the member of an object with
+// the given key.
+func memberPath(key string) string {
+	for i, c := range key {
+		if !(c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || i > 0 && '0' <= c && c <= '9') {
+			return "[" + strconv.Quote(key) + "]"
+		}
+	}
+	if key == "" {
+		return `[""]`
+	}
+	return "." + key
+}
+
+// indexPath returns the path element of the element of an array
+// with index i.
+func indexPath(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+// checkObject returns an error if the JSON object obj has the same
+// key more than once, if u is strict.
+func (u *Unmarshaler) checkObject(obj json.RawMessage) error {
+	if !u.Strict {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(obj))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		// Leave the error to the decoding of the object.
+		return nil
+	}
+	seen := make(map[string]bool)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil
+		}
+		key, _ := tok.(string)
+		if seen[key] {
+			return fmt.Errorf("duplicate key %q", key)
+		}
+		seen[key] = true
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// checkElement returns an error if raw is a null element of a repeated
+// field or a map whose values are of type t, if u is strict.
+func (u *Unmarshaler) checkElement(raw json.RawMessage, t reflect.Type) error {
+	if u.Strict && string(raw) == "null" && t != reflect.TypeOf(&stpb.Value{}) {
+		return errors.New("null element")
+	}
+	return nil
 }
 
 // UnmarshalNext unmarshals the next protocol buffer from a JSON object stream.
@@ -655,9 +917,17 @@ func (u *Unmarshaler) UnmarshalNext(dec *json.Decoder, pb proto.Message) error {
 	if err := dec.Decode(&inputValue); err != nil {
 		return err
 	}
-	if err := u.unmarshalValue(reflect.ValueOf(pb).Elem(), inputValue, nil); err != nil {
+	if err := checkBytes(&u.Options, len(inputValue)); err != nil {
 		return err
 	}
+	return (&unmarshalState{Unmarshaler: u}).unmarshalNext(inputValue, pb)
+}
+
+// unmarshalNext unmarshals the JSON value inputValue into pb.
+func (u *unmarshalState) unmarshalNext(inputValue json.RawMessage, pb proto.Message) error {
+	if err := u.unmarshalValue(reflect.ValueOf(pb).Elem(), inputValue, nil); err != nil {
+		return u.atPath(err, "$")
+	}
 	return checkRequiredFields(pb)
 }
 
@@ -665,10 +935,60 @@ func (u *Unmarshaler) UnmarshalNext(dec *json.Decoder, pb proto.Message) error {
 // buffer. This function is lenient and will decode any options
 // permutations of the related Marshaler.
 func (u *Unmarshaler) Unmarshal(r io.Reader, pb proto.Message) error {
+	if u.Options.MaxBytes > 0 {
+		// Stop reading as soon as the input is known to be too large.
+		r = &limitReader{r: r, opts: &u.Options}
+	}
 	dec := json.NewDecoder(r)
 	return u.UnmarshalNext(dec, pb)
 }
 
+// limitReader reads from r until more than opts.MaxBytes bytes
+// have been read, and fails with a *proto.LimitError after that.
+type limitReader struct {
+	r    io.Reader
+	opts *proto.UnmarshalOptions
+	n    int
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if err := checkBytes(l.opts, l.n); err != nil {
+		return 0, err
+	}
+	n, err := l.r.Read(p)
+	l.n += n
+	return n, err
+}
+
+// checkBytes reports a *proto.LimitError if an input of n bytes
+// exceeds o.MaxBytes.
+func checkBytes(o *proto.UnmarshalOptions, n int) error {
+	if o.MaxBytes > 0 && n > o.MaxBytes {
+		return &proto.LimitError{Limit: proto.LimitBytes, Max: o.MaxBytes}
+	}
+	return nil
+}
+
+// checkDepth reports a *proto.LimitError if a message at the given
+// nesting depth exceeds o.MaxDepth. field names the message field,
+// if known.
+func checkDepth(o *proto.UnmarshalOptions, depth int, field string) error {
+	if o.MaxDepth > 0 && depth > o.MaxDepth {
+		return &proto.LimitError{Limit: proto.LimitDepth, Max: o.MaxDepth, Field: field}
+	}
+	return nil
+}
+
+// checkElements reports a *proto.LimitError if a repeated field or map
+// holding n elements exceeds o.MaxElements. field names the field,
+// if known.
+func checkElements(o *proto.UnmarshalOptions, n int, field string) error {
+	if o.MaxElements > 0 && n > o.MaxElements {
+		return &proto.LimitError{Limit: proto.LimitElements, Max: o.MaxElements, Field: field}
+	}
+	return nil
+}
+
 // UnmarshalNext unmarshals the next protocol buffer from a JSON object stream.
 // This function is lenient and will decode any options permutations of the
 // related Marshaler.
@@ -690,9 +1010,64 @@ func UnmarshalString(str string, pb proto.Message) error {
 	return new(Unmarshaler).Unmarshal(strings.NewReader(str), pb)
 }
 
+// A LineReader reads a stream of JSON-encoded messages, such as a
+// JSON Lines file, using UnmarshalNext.
+type LineReader struct {
+	dec *json.Decoder
+	u   *Unmarshaler
+}
+
+// NewLineReader returns a LineReader that reads from r and decodes
+// messages with u. If u is nil, the default Unmarshaler is used.
+func NewLineReader(r io.Reader, u *Unmarshaler) *LineReader {
+	if u == nil {
+		u = new(Unmarshaler)
+	}
+	return &LineReader{dec: json.NewDecoder(r), u: u}
+}
+
+// ReadMessage reads the next message from the stream into pb,
+// which is reset first. It returns io.EOF at the end of the stream.
+func (r *LineReader) ReadMessage(pb proto.Message) error {
+	pb.Reset()
+	return r.u.UnmarshalNext(r.dec, pb)
+}
+
+// A LineWriter writes a stream of messages as JSON Lines:
+// one compact JSON object per line.
+type LineWriter struct {
+	w   io.Writer
+	m   Marshaler
+	buf bytes.Buffer
+}
+
+// NewLineWriter returns a LineWriter that writes to w and encodes
+// messages with m. The Indent setting of m is ignored, since each
+// message must fit on a single line. If m is nil, the default
+// Marshaler is used.
+func NewLineWriter(w io.Writer, m *Marshaler) *LineWriter {
+	lw := &LineWriter{w: w}
+	if m != nil {
+		lw.m = *m
+	}
+	lw.m.Indent = ""
+	return lw
+}
+
+// WriteMessage encodes pb and writes it to the stream, followed by a newline.
+func (w *LineWriter) WriteMessage(pb proto.Message) error {
+	w.buf.Reset()
+	if err := w.m.Marshal(&w.buf, pb); err != nil {
+		return err
+	}
+	w.buf.WriteByte('\n')
+	_, err := w.w.Write(w.buf.Bytes())
+	return err
+}
+
 // unmarshalValue converts/copies a value into the target.
 // prop may be nil.
-func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMessage, prop *proto.Properties) error {
+func (u *unmarshalState) unmarshalValue(target reflect.Value, inputValue json.RawMessage, prop *proto.Properties) error {
 	targetType := target.Type()
 
 	// Allocate memory for pointer fields.
@@ -705,11 +1080,35 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 		}
 		target.Set(reflect.New(targetType.Elem()))
 
-		return u.unmarshalValue(target.Elem(), inputValue, prop)
+		err := u.unmarshalValue(target.Elem(), inputValue, prop)
+		if u.null {
+			u.null = false
+			target.Set(reflect.Zero(targetType))
+		}
+		return err
 	}
 
-	if jsu, ok := target.Addr().Interface().(JSONPBUnmarshaler); ok {
-		return jsu.UnmarshalJSONPB(u, []byte(inputValue))
+	// A message decoded in place of its generated method has been counted
+	// in the depth, and isn't passed to the method again.
+	plain := u.plain != nil && u.plain == target.Addr().Interface()
+	u.plain = nil
+
+	if targetType.Kind() == reflect.Struct && !plain {
+		u.depth++
+		defer func() { u.depth-- }()
+		if err := checkDepth(&u.Options, u.depth, propName(prop)); err != nil {
+			return err
+		}
+	}
+
+	if jsu, ok := target.Addr().Interface().(JSONPBUnmarshaler); ok && !plain {
+		// Generated methods read the message in place; see XXX_Decoder.
+		u.hooked = jsu
+		hu := *u.Unmarshaler
+		hu.state = u
+		err := jsu.UnmarshalJSONPB(&hu, []byte(inputValue))
+		u.hooked = nil
+		return err
 	}
 
 	// Handle well-known types that are not pointers.
@@ -722,6 +1121,9 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 			// Use json.RawMessage pointer type instead of value to support pre-1.8 version.
 			// 1.8 changed RawMessage.MarshalJSON from pointer type to value type, see
 			// https://github.com/golang/go/issues/14493
+			if err := u.checkObject(inputValue); err != nil {
+				return err
+			}
 			var jsonFields map[string]*json.RawMessage
 			if err := json.Unmarshal(inputValue, &jsonFields); err != nil {
 				return err
@@ -756,6 +1158,9 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 				}
 
 				if err := u.unmarshalValue(reflect.ValueOf(m).Elem(), *val, nil); err != nil {
+					if _, ok := err.(*proto.LimitError); ok || u.tracksPaths() {
+						return u.atPath(err, ".value")
+					}
 					return fmt.Errorf("can't unmarshal Any nested proto %T: %v", m, err)
 				}
 			} else {
@@ -766,6 +1171,9 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 				}
 
 				if err = u.unmarshalValue(reflect.ValueOf(m).Elem(), nestedProto, nil); err != nil {
+					if _, ok := err.(*proto.LimitError); ok || u.tracksPaths() {
+						return err
+					}
 					return fmt.Errorf("can't unmarshal Any nested proto %T: %v", m, err)
 				}
 			}
@@ -809,15 +1217,24 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 			target.Field(1).SetInt(int64(t.Nanosecond()))
 			return nil
 		case "Struct":
+			if err := u.checkObject(inputValue); err != nil {
+				return err
+			}
 			var m map[string]json.RawMessage
 			if err := json.Unmarshal(inputValue, &m); err != nil {
 				return fmt.Errorf("bad StructValue: %v", err)
 			}
+			if err := checkElements(&u.Options, len(m), propName(prop)); err != nil {
+				return err
+			}
 
 			target.Field(0).Set(reflect.ValueOf(map[string]*stpb.Value{}))
 			for k, jv := range m {
 				pv := &stpb.Value{}
 				if err := u.unmarshalValue(reflect.ValueOf(pv).Elem(), jv, prop); err != nil {
+					if _, ok := err.(*proto.LimitError); ok || u.tracksPaths() {
+						return u.atPath(err, memberPath(k))
+					}
 					return fmt.Errorf("bad value in StructValue for key %q: %v", k, err)
 				}
 				target.Field(0).SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(pv))
@@ -828,11 +1245,14 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 			if err := json.Unmarshal(inputValue, &s); err != nil {
 				return fmt.Errorf("bad ListValue: %v", err)
 			}
+			if err := checkElements(&u.Options, len(s), propName(prop)); err != nil {
+				return err
+			}
 
 			target.Field(0).Set(reflect.ValueOf(make([]*stpb.Value, len(s))))
 			for i, sv := range s {
 				if err := u.unmarshalValue(target.Field(0).Index(i), sv, prop); err != nil {
-					return err
+					return u.atPath(err, indexPath(i))
 				}
 			}
 			return nil
@@ -887,33 +1307,14 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 
 	// Handle nested messages.
 	if targetType.Kind() == reflect.Struct {
+		if err := u.checkObject(inputValue); err != nil {
+			return err
+		}
 		var jsonFields map[string]json.RawMessage
 		if err := json.Unmarshal(inputValue, &jsonFields); err != nil {
 			return err
 		}
 
-		consumeField := func(prop *proto.Properties) (json.RawMessage, bool) {
-			// Be liberal in what names we accept; both orig_name and camelName are okay.
-			fieldNames := acceptedJSONFieldNames(prop)
-
-			vOrig, okOrig := jsonFields[fieldNames.orig]
-			vCamel, okCamel := jsonFields[fieldNames.camel]
-			if !okOrig && !okCamel {
-				return nil, false
-			}
-			// If, for some reason, both are present in the data, favour the camelName.
-			var raw json.RawMessage
-			if okOrig {
-				raw = vOrig
-				delete(jsonFields, fieldNames.orig)
-			}
-			if okCamel {
-				raw = vCamel
-				delete(jsonFields, fieldNames.camel)
-			}
-			return raw, true
-		}
-
 		sprops := proto.GetProperties(targetType)
 		for i := 0; i < target.NumField(); i++ {
 			ft := target.Type().Field(i)
@@ -921,57 +1322,47 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 				continue
 			}
 
-			valueForField, ok := consumeField(sprops.Prop[i])
+			valueForField, key, ok, err := u.consumeJSONField(jsonFields, sprops.Prop[i])
+			if err != nil {
+				return err
+			}
 			if !ok {
 				continue
 			}
 
 			if err := u.unmarshalValue(target.Field(i), valueForField, sprops.Prop[i]); err != nil {
-				return err
+				return u.atPath(err, memberPath(key))
 			}
 		}
-		// Check for any oneof fields.
+		// Check for any oneof fields, in field number order so that the
+		// member with the highest number wins if several are set, as it
+		// does in generated code and the wire format marshaled from JSON.
 		if len(jsonFields) > 0 {
+			oneofs := make(map[int32]*proto.OneofProperties, len(sprops.OneofTypes))
+			tags := make([]int32, 0, len(sprops.OneofTypes))
 			for _, oop := range sprops.OneofTypes {
-				raw, ok := consumeField(oop.Prop)
+				oneofs[int32(oop.Prop.Tag)] = oop
+				tags = append(tags, int32(oop.Prop.Tag))
+			}
+			sort.Sort(int32Slice(tags))
+			for _, tag := range tags {
+				oop := oneofs[tag]
+				raw, key, ok, err := u.consumeJSONField(jsonFields, oop.Prop)
+				if err != nil {
+					return err
+				}
 				if !ok {
 					continue
 				}
 				nv := reflect.New(oop.Type.Elem())
 				target.Field(oop.Field).Set(nv)
 				if err := u.unmarshalValue(nv.Elem().Field(0), raw, oop.Prop); err != nil {
-					return err
+					return u.atPath(err, memberPath(key))
 				}
 			}
 		}
-		// Handle proto2 extensions.
-		if len(jsonFields) > 0 {
-			if ep, ok := target.Addr().Interface().(proto.Message); ok {
-				for _, ext := range proto.RegisteredExtensions(ep) {
-					name := fmt.Sprintf("[%s]", ext.Name)
-					raw, ok := jsonFields[name]
-					if !ok {
-						continue
-					}
-					delete(jsonFields, name)
-					nv := reflect.New(reflect.TypeOf(ext.ExtensionType).Elem())
-					if err := u.unmarshalValue(nv.Elem(), raw, nil); err != nil {
-						return err
-					}
-					if err := proto.SetExtension(ep, ext, nv.Interface()); err != nil {
-						return err
-					}
-				}
-			}
-		}
-		if !u.AllowUnknownFields && len(jsonFields) > 0 {
-			// Pick any field to be the scapegoat.
-			var f string
-			for fname := range jsonFields {
-				f = fname
-				break
-			}
-			return fmt.Errorf("unknown field %q in %v", f, targetType)
+		if ep, ok := target.Addr().Interface().(proto.Message); ok {
+			return u.unmarshalRemaining(ep, jsonFields)
 		}
 		return nil
 	}
@@ -982,12 +1373,18 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 		if err := json.Unmarshal(inputValue, &slc); err != nil {
 			return err
 		}
+		if err := checkElements(&u.Options, len(slc), propName(prop)); err != nil {
+			return err
+		}
 		if slc != nil {
 			l := len(slc)
 			target.Set(reflect.MakeSlice(targetType, l, l))
 			for i := 0; i < l; i++ {
+				if err := u.checkElement(slc[i], targetType.Elem()); err != nil {
+					return u.atPath(err, indexPath(i))
+				}
 				if err := u.unmarshalValue(target.Index(i), slc[i], prop); err != nil {
-					return err
+					return u.atPath(err, indexPath(i))
 				}
 			}
 		}
@@ -996,10 +1393,16 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 
 	// Handle maps (whose keys are always strings)
 	if targetType.Kind() == reflect.Map {
+		if err := u.checkObject(inputValue); err != nil {
+			return err
+		}
 		var mp map[string]json.RawMessage
 		if err := json.Unmarshal(inputValue, &mp); err != nil {
 			return err
 		}
+		if err := checkElements(&u.Options, len(mp), propName(prop)); err != nil {
+			return err
+		}
 		if mp != nil {
 			target.Set(reflect.MakeMap(targetType))
 			for ks, raw := range mp {
@@ -1012,15 +1415,18 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 					k = reflect.New(targetType.Key()).Elem()
 					// TODO: pass the correct Properties if needed.
 					if err := u.unmarshalValue(k, json.RawMessage(ks), nil); err != nil {
-						return err
+						return u.atPath(err, memberPath(ks))
 					}
 				}
 
 				// Unmarshal map value.
+				if err := u.checkElement(raw, targetType.Elem()); err != nil {
+					return u.atPath(err, memberPath(ks))
+				}
 				v := reflect.New(targetType.Elem()).Elem()
 				// TODO: pass the correct Properties if needed.
 				if err := u.unmarshalValue(v, raw, nil); err != nil {
-					return err
+					return u.atPath(err, memberPath(ks))
 				}
 				target.SetMapIndex(k, v)
 			}
@@ -1050,6 +1456,125 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 	return json.Unmarshal(inputValue, target.Addr().Interface())
 }
 
+// consumeJSONField consumes the field described by prop among jsonFields,
+// and returns its value and the key it has.
+func (u *Unmarshaler) consumeJSONField(jsonFields map[string]json.RawMessage, prop *proto.Properties) (json.RawMessage, string, bool, error) {
+	// Be liberal in what names we accept; orig_name, camelName
+	// and the name given by u.Naming are all okay.
+	fieldNames := acceptedJSONFieldNames(prop, u.Naming)
+
+	// If, for some reason, several are present in the data, favour
+	// the camelName, then the name given by u.Naming.
+	var raw json.RawMessage
+	var key string
+	found := false
+	for _, name := range []string{fieldNames.orig, fieldNames.named, fieldNames.camel} {
+		v, ok := jsonFields[name]
+		if !ok {
+			continue
+		}
+		if found && u.Strict {
+			return nil, "", false, fmt.Errorf("field %s is given as both %q and %q", prop.OrigName, key, name)
+		}
+		raw, key, found = v, name, true
+		delete(jsonFields, name)
+	}
+	return raw, key, found, nil
+}
+
+// unmarshalRemaining unmarshals the extensions of ep among the fields of
+// jsonFields that have not been consumed, and fails if any other field
+// is left, unless unknown fields are allowed.
+func (u *unmarshalState) unmarshalRemaining(ep proto.Message, jsonFields map[string]json.RawMessage) error {
+	// Handle proto2 extensions.
+	if len(jsonFields) > 0 {
+		for name, raw := range jsonFields {
+			if !strings.HasPrefix(name, "[") || !strings.HasSuffix(name, "]") {
+				continue
+			}
+			ext := proto.FindExtensionByName(name[1 : len(name)-1])
+			if ext == nil || reflect.TypeOf(ext.ExtendedType) != reflect.TypeOf(ep) {
+				continue
+			}
+			delete(jsonFields, name)
+			var prop proto.Properties
+			prop.Parse(ext.Tag)
+			// Repeated extensions are slices, the others pointers.
+			t := reflect.TypeOf(ext.ExtensionType)
+			nv := reflect.New(t)
+			if t.Kind() == reflect.Ptr {
+				nv = reflect.New(t.Elem())
+			}
+			if err := u.unmarshalValue(nv.Elem(), raw, &prop); err != nil {
+				return u.atPath(err, memberPath(name))
+			}
+			if t.Kind() == reflect.Slice {
+				nv = nv.Elem()
+			}
+			if err := proto.SetExtension(ep, ext, nv.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	if u.Strict && len(jsonFields) > 0 {
+		if err := u.checkFieldCase(ep, jsonFields); err != nil {
+			return err
+		}
+	}
+	if u.UnknownFields != nil && !u.Options.DiscardUnknown && len(jsonFields) > 0 {
+		return u.UnknownFields.keep(ep, jsonFields)
+	}
+	if !u.AllowUnknownFields && !u.Options.DiscardUnknown && len(jsonFields) > 0 {
+		// Pick any field to be the scapegoat.
+		var f string
+		for fname := range jsonFields {
+			f = fname
+			break
+		}
+		return u.atPath(fmt.Errorf("unknown field %q in %v", f, reflect.TypeOf(ep).Elem()), memberPath(f))
+	}
+	return nil
+}
+
+// checkFieldCase returns an error if one of the unknown fields of
+// jsonFields is one of the fields of ep with a different case.
+func (u *Unmarshaler) checkFieldCase(ep proto.Message, jsonFields map[string]json.RawMessage) error {
+	sprops := proto.GetProperties(reflect.TypeOf(ep).Elem())
+	props := sprops.Prop
+	for _, oop := range sprops.OneofTypes {
+		props = append(props[:len(props):len(props)], oop.Prop)
+	}
+	return u.checkFieldNameCase(props, jsonFields)
+}
+
+// checkFieldNameCase returns an error if one of the unknown fields of
+// jsonFields is one of the fields described by props with a different case.
+func (u *Unmarshaler) checkFieldNameCase(props []*proto.Properties, jsonFields map[string]json.RawMessage) error {
+	for key := range jsonFields {
+		for _, prop := range props {
+			if prop.OrigName == "" {
+				continue
+			}
+			names := acceptedJSONFieldNames(prop, u.Naming)
+			for _, name := range []string{names.orig, names.named, names.camel} {
+				if strings.EqualFold(key, name) {
+					return &PathError{Path: memberPath(key), Err: fmt.Errorf("field %q does not match the case of %q", key, name)}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// propName returns the original name of the field described by prop,
+// or "" if prop is nil.
+func propName(prop *proto.Properties) string {
+	if prop == nil {
+		return ""
+	}
+	return prop.OrigName
+}
+
 func unquote(s string) (string, error) {
 	var ret string
 	err := json.Unmarshal([]byte(s), &ret)
@@ -1057,27 +1582,35 @@ func unquote(s string) (string, error) {
 }
 
 // jsonProperties returns parsed proto.Properties for the field and corrects JSONName attribute.
-func jsonProperties(f reflect.StructField, origName bool) *proto.Properties {
+func (m *Marshaler) jsonProperties(f reflect.StructField) *proto.Properties {
 	var prop proto.Properties
 	prop.Init(f.Type, f.Name, f.Tag.Get("protobuf"), &f)
-	if origName || prop.JSONName == "" {
-		prop.JSONName = prop.OrigName
-	}
+	prop.JSONName = m.jsonName(prop.OrigName, prop.JSONName)
 	return &prop
 }
 
 type fieldNames struct {
-	orig, camel string
+	orig, named, camel string
 }
 
-func acceptedJSONFieldNames(prop *proto.Properties) fieldNames {
-	opts := fieldNames{orig: prop.OrigName, camel: prop.OrigName}
+func acceptedJSONFieldNames(prop *proto.Properties, naming NamingStrategy) fieldNames {
+	opts := fieldNames{orig: prop.OrigName, named: prop.OrigName, camel: prop.OrigName}
 	if prop.JSONName != "" {
 		opts.camel = prop.JSONName
 	}
+	if naming != nil && !customJSONName(prop.OrigName, prop.JSONName) {
+		opts.named = naming(prop.OrigName)
+	}
 	return opts
 }
 
+// hasJSONFieldName reports whether key is one of the names accepted
+// for the field described by prop.
+func hasJSONFieldName(prop *proto.Properties, naming NamingStrategy, key string) bool {
+	names := acceptedJSONFieldNames(prop, naming)
+	return key == names.orig || key == names.named || key == names.camel
+}
+
 // Writer wrapper inspired by https://blog.golang.org/errors-are-values
 type errWriter struct {
 	writer io.Writer
@@ -0,0 +1,1526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: jsonpb_generated/more_test_objects.proto
+
+package jsonpb_generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/golang/protobuf/ptypes/network/api"
+
+import (
+	jsonpb "github.com/golang/protobuf/jsonpb"
+	sort "sort"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Numeral int32
+
+const (
+	Numeral_UNKNOWN Numeral = 0
+	Numeral_ARABIC  Numeral = 1
+	Numeral_ROMAN   Numeral = 2
+)
+
+var Numeral_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ARABIC",
+	2: "ROMAN",
+}
+var Numeral_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ARABIC":  1,
+	"ROMAN":   2,
+}
+
+func (x Numeral) String() string {
+	return proto.EnumName(Numeral_name, int32(x))
+}
+func (Numeral) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{0}
+}
+
+type Simple3 struct {
+	Dub                  float64  `protobuf:"fixed64,1,opt,name=dub,proto3" json:"dub,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Simple3) Reset()         { *m = Simple3{} }
+func (m *Simple3) String() string { return proto.CompactTextString(m) }
+func (*Simple3) ProtoMessage()    {}
+func (*Simple3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{0}
+}
+func (m *Simple3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Simple3.Unmarshal(m, b)
+}
+func (m *Simple3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Simple3.Marshal(b, m, deterministic)
+}
+func (dst *Simple3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Simple3.Merge(dst, src)
+}
+func (m *Simple3) XXX_Size() int {
+	return xxx_messageInfo_Simple3.Size(m)
+}
+func (m *Simple3) XXX_DiscardUnknown() {
+	xxx_messageInfo_Simple3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Simple3 proto.InternalMessageInfo
+
+func (m *Simple3) GetDub() float64 {
+	if m != nil {
+		return m.Dub
+	}
+	return 0
+}
+
+type SimpleSlice3 struct {
+	Slices               []string `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimpleSlice3) Reset()         { *m = SimpleSlice3{} }
+func (m *SimpleSlice3) String() string { return proto.CompactTextString(m) }
+func (*SimpleSlice3) ProtoMessage()    {}
+func (*SimpleSlice3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{1}
+}
+func (m *SimpleSlice3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimpleSlice3.Unmarshal(m, b)
+}
+func (m *SimpleSlice3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimpleSlice3.Marshal(b, m, deterministic)
+}
+func (dst *SimpleSlice3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimpleSlice3.Merge(dst, src)
+}
+func (m *SimpleSlice3) XXX_Size() int {
+	return xxx_messageInfo_SimpleSlice3.Size(m)
+}
+func (m *SimpleSlice3) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimpleSlice3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimpleSlice3 proto.InternalMessageInfo
+
+func (m *SimpleSlice3) GetSlices() []string {
+	if m != nil {
+		return m.Slices
+	}
+	return nil
+}
+
+type SimpleMap3 struct {
+	Stringy              map[string]string `protobuf:"bytes,1,rep,name=stringy,proto3" json:"stringy,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SimpleMap3) Reset()         { *m = SimpleMap3{} }
+func (m *SimpleMap3) String() string { return proto.CompactTextString(m) }
+func (*SimpleMap3) ProtoMessage()    {}
+func (*SimpleMap3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{2}
+}
+func (m *SimpleMap3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimpleMap3.Unmarshal(m, b)
+}
+func (m *SimpleMap3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimpleMap3.Marshal(b, m, deterministic)
+}
+func (dst *SimpleMap3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimpleMap3.Merge(dst, src)
+}
+func (m *SimpleMap3) XXX_Size() int {
+	return xxx_messageInfo_SimpleMap3.Size(m)
+}
+func (m *SimpleMap3) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimpleMap3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimpleMap3 proto.InternalMessageInfo
+
+func (m *SimpleMap3) GetStringy() map[string]string {
+	if m != nil {
+		return m.Stringy
+	}
+	return nil
+}
+
+type SimpleNull3 struct {
+	Simple               *Simple3 `protobuf:"bytes,1,opt,name=simple,proto3" json:"simple,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimpleNull3) Reset()         { *m = SimpleNull3{} }
+func (m *SimpleNull3) String() string { return proto.CompactTextString(m) }
+func (*SimpleNull3) ProtoMessage()    {}
+func (*SimpleNull3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{3}
+}
+func (m *SimpleNull3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimpleNull3.Unmarshal(m, b)
+}
+func (m *SimpleNull3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimpleNull3.Marshal(b, m, deterministic)
+}
+func (dst *SimpleNull3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimpleNull3.Merge(dst, src)
+}
+func (m *SimpleNull3) XXX_Size() int {
+	return xxx_messageInfo_SimpleNull3.Size(m)
+}
+func (m *SimpleNull3) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimpleNull3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimpleNull3 proto.InternalMessageInfo
+
+func (m *SimpleNull3) GetSimple() *Simple3 {
+	if m != nil {
+		return m.Simple
+	}
+	return nil
+}
+
+type Mappy struct {
+	Nummy                map[int64]int32    `protobuf:"bytes,1,rep,name=nummy,proto3" json:"nummy,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Strry                map[string]string  `protobuf:"bytes,2,rep,name=strry,proto3" json:"strry,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Objjy                map[int32]*Simple3 `protobuf:"bytes,3,rep,name=objjy,proto3" json:"objjy,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Buggy                map[int64]string   `protobuf:"bytes,4,rep,name=buggy,proto3" json:"buggy,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Booly                map[bool]bool      `protobuf:"bytes,5,rep,name=booly,proto3" json:"booly,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Enumy                map[string]Numeral `protobuf:"bytes,6,rep,name=enumy,proto3" json:"enumy,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jsonpb_generated.Numeral"`
+	S32Booly             map[int32]bool     `protobuf:"bytes,7,rep,name=s32booly,proto3" json:"s32booly,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	S64Booly             map[int64]bool     `protobuf:"bytes,8,rep,name=s64booly,proto3" json:"s64booly,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	U32Booly             map[uint32]bool    `protobuf:"bytes,9,rep,name=u32booly,proto3" json:"u32booly,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	U64Booly             map[uint64]bool    `protobuf:"bytes,10,rep,name=u64booly,proto3" json:"u64booly,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Mappy) Reset()         { *m = Mappy{} }
+func (m *Mappy) String() string { return proto.CompactTextString(m) }
+func (*Mappy) ProtoMessage()    {}
+func (*Mappy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{4}
+}
+func (m *Mappy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mappy.Unmarshal(m, b)
+}
+func (m *Mappy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mappy.Marshal(b, m, deterministic)
+}
+func (dst *Mappy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mappy.Merge(dst, src)
+}
+func (m *Mappy) XXX_Size() int {
+	return xxx_messageInfo_Mappy.Size(m)
+}
+func (m *Mappy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mappy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mappy proto.InternalMessageInfo
+
+func (m *Mappy) GetNummy() map[int64]int32 {
+	if m != nil {
+		return m.Nummy
+	}
+	return nil
+}
+
+func (m *Mappy) GetStrry() map[string]string {
+	if m != nil {
+		return m.Strry
+	}
+	return nil
+}
+
+func (m *Mappy) GetObjjy() map[int32]*Simple3 {
+	if m != nil {
+		return m.Objjy
+	}
+	return nil
+}
+
+func (m *Mappy) GetBuggy() map[int64]string {
+	if m != nil {
+		return m.Buggy
+	}
+	return nil
+}
+
+func (m *Mappy) GetBooly() map[bool]bool {
+	if m != nil {
+		return m.Booly
+	}
+	return nil
+}
+
+func (m *Mappy) GetEnumy() map[string]Numeral {
+	if m != nil {
+		return m.Enumy
+	}
+	return nil
+}
+
+func (m *Mappy) GetS32Booly() map[int32]bool {
+	if m != nil {
+		return m.S32Booly
+	}
+	return nil
+}
+
+func (m *Mappy) GetS64Booly() map[int64]bool {
+	if m != nil {
+		return m.S64Booly
+	}
+	return nil
+}
+
+func (m *Mappy) GetU32Booly() map[uint32]bool {
+	if m != nil {
+		return m.U32Booly
+	}
+	return nil
+}
+
+func (m *Mappy) GetU64Booly() map[uint64]bool {
+	if m != nil {
+		return m.U64Booly
+	}
+	return nil
+}
+
+type FieldOptions3 struct {
+	Numeral              Numeral            `protobuf:"varint,1,opt,name=numeral,proto3,enum=jsonpb_generated.Numeral" json:"numeral,omitempty"`
+	Name                 Numeral            `protobuf:"varint,2,opt,name=name,proto3,enum=jsonpb_generated.Numeral" json:"name,omitempty"`
+	Numerals             []Numeral          `protobuf:"varint,3,rep,packed,name=numerals,proto3,enum=jsonpb_generated.Numeral" json:"numerals,omitempty"`
+	NumeralMap           map[string]Numeral `protobuf:"bytes,4,rep,name=numeral_map,json=numeralMap,proto3" json:"numeral_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jsonpb_generated.Numeral"`
+	Count                int64              `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Total                uint64             `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Counts               []uint64           `protobuf:"fixed64,7,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	Note                 string             `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	Simple               *Simple3           `protobuf:"bytes,9,opt,name=simple,proto3" json:"simple,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *FieldOptions3) Reset()         { *m = FieldOptions3{} }
+func (m *FieldOptions3) String() string { return proto.CompactTextString(m) }
+func (*FieldOptions3) ProtoMessage()    {}
+func (*FieldOptions3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{5}
+}
+func (m *FieldOptions3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldOptions3.Unmarshal(m, b)
+}
+func (m *FieldOptions3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldOptions3.Marshal(b, m, deterministic)
+}
+func (dst *FieldOptions3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldOptions3.Merge(dst, src)
+}
+func (m *FieldOptions3) XXX_Size() int {
+	return xxx_messageInfo_FieldOptions3.Size(m)
+}
+func (m *FieldOptions3) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldOptions3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldOptions3 proto.InternalMessageInfo
+
+func (m *FieldOptions3) GetNumeral() Numeral {
+	if m != nil {
+		return m.Numeral
+	}
+	return Numeral_UNKNOWN
+}
+
+func (m *FieldOptions3) GetName() Numeral {
+	if m != nil {
+		return m.Name
+	}
+	return Numeral_UNKNOWN
+}
+
+func (m *FieldOptions3) GetNumerals() []Numeral {
+	if m != nil {
+		return m.Numerals
+	}
+	return nil
+}
+
+func (m *FieldOptions3) GetNumeralMap() map[string]Numeral {
+	if m != nil {
+		return m.NumeralMap
+	}
+	return nil
+}
+
+func (m *FieldOptions3) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FieldOptions3) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *FieldOptions3) GetCounts() []uint64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *FieldOptions3) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *FieldOptions3) GetSimple() *Simple3 {
+	if m != nil {
+		return m.Simple
+	}
+	return nil
+}
+
+type Secrets3 struct {
+	User     string            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Password string            `protobuf:"bytes,2,opt,name=password,proto3,sensitive" json:"password,omitempty"`
+	Key      []byte            `protobuf:"bytes,3,opt,name=key,proto3,sensitive" json:"key,omitempty"`
+	Pin      int32             `protobuf:"varint,4,opt,name=pin,proto3,sensitive" json:"pin,omitempty"`
+	Numeral  Numeral           `protobuf:"varint,5,opt,name=numeral,proto3,enum=jsonpb_generated.Numeral,sensitive" json:"numeral,omitempty"`
+	Tokens   []string          `protobuf:"bytes,6,rep,name=tokens,proto3,sensitive" json:"tokens,omitempty"`
+	Keys     [][]byte          `protobuf:"bytes,7,rep,name=keys,proto3,sensitive" json:"keys,omitempty"`
+	Labels   map[string]string `protobuf:"bytes,8,rep,name=labels,proto3,sensitive" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Inner    *Simple3          `protobuf:"bytes,9,opt,name=inner,proto3,sensitive" json:"inner,omitempty"`
+	// Types that are valid to be assigned to Choice:
+	//	*Secrets3_Code
+	//	*Secrets3_Serial
+	Choice               isSecrets3_Choice `protobuf_oneof:"choice"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Secrets3) Reset()         { *m = Secrets3{} }
+func (m *Secrets3) String() string { return proto.CompactTextString(m) }
+func (*Secrets3) ProtoMessage()    {}
+func (*Secrets3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_6e2b88b063d3a65d, []int{6}
+}
+func (m *Secrets3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Secrets3.Unmarshal(m, b)
+}
+func (m *Secrets3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Secrets3.Marshal(b, m, deterministic)
+}
+func (dst *Secrets3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secrets3.Merge(dst, src)
+}
+func (m *Secrets3) XXX_Size() int {
+	return xxx_messageInfo_Secrets3.Size(m)
+}
+func (m *Secrets3) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secrets3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Secrets3 proto.InternalMessageInfo
+
+type isSecrets3_Choice interface {
+	isSecrets3_Choice()
+}
+
+type Secrets3_Code struct {
+	Code string `protobuf:"bytes,10,opt,name=code,proto3,oneof,sensitive"`
+}
+type Secrets3_Serial struct {
+	Serial int64 `protobuf:"varint,11,opt,name=serial,proto3,oneof,sensitive"`
+}
+
+func (*Secrets3_Code) isSecrets3_Choice()   {}
+func (*Secrets3_Serial) isSecrets3_Choice() {}
+
+func (m *Secrets3) GetChoice() isSecrets3_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Secrets3) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Secrets3) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Secrets3) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Secrets3) GetPin() int32 {
+	if m != nil {
+		return m.Pin
+	}
+	return 0
+}
+
+func (m *Secrets3) GetNumeral() Numeral {
+	if m != nil {
+		return m.Numeral
+	}
+	return Numeral_UNKNOWN
+}
+
+func (m *Secrets3) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Secrets3) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Secrets3) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Secrets3) GetInner() *Simple3 {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *Secrets3) GetCode() string {
+	if x, ok := m.GetChoice().(*Secrets3_Code); ok {
+		return x.Code
+	}
+	return ""
+}
+
+func (m *Secrets3) GetSerial() int64 {
+	if x, ok := m.GetChoice().(*Secrets3_Serial); ok {
+		return x.Serial
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Secrets3) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Secrets3_OneofMarshaler, _Secrets3_OneofUnmarshaler, _Secrets3_OneofSizer, []interface{}{
+		(*Secrets3_Code)(nil),
+		(*Secrets3_Serial)(nil),
+	}
+}
+
+func _Secrets3_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Secrets3)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Secrets3_Code:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Code)
+	case *Secrets3_Serial:
+		b.EncodeVarint(11<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Serial))
+	case nil:
+	default:
+		return fmt.Errorf("Secrets3.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Secrets3_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Secrets3)
+	switch tag {
+	case 10: // choice.code
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Choice = &Secrets3_Code{x}
+		return true, err
+	case 11: // choice.serial
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Choice = &Secrets3_Serial{int64(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Secrets3_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Secrets3)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Secrets3_Code:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Code)))
+		n += len(x.Code)
+	case *Secrets3_Serial:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Serial))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Simple3)(nil), "jsonpb_generated.Simple3")
+	proto.RegisterType((*SimpleSlice3)(nil), "jsonpb_generated.SimpleSlice3")
+	proto.RegisterType((*SimpleMap3)(nil), "jsonpb_generated.SimpleMap3")
+	proto.RegisterMapType((map[string]string)(nil), "jsonpb_generated.SimpleMap3.StringyEntry")
+	proto.RegisterType((*SimpleNull3)(nil), "jsonpb_generated.SimpleNull3")
+	proto.RegisterType((*Mappy)(nil), "jsonpb_generated.Mappy")
+	proto.RegisterMapType((map[bool]bool)(nil), "jsonpb_generated.Mappy.BoolyEntry")
+	proto.RegisterMapType((map[int64]string)(nil), "jsonpb_generated.Mappy.BuggyEntry")
+	proto.RegisterMapType((map[string]Numeral)(nil), "jsonpb_generated.Mappy.EnumyEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "jsonpb_generated.Mappy.NummyEntry")
+	proto.RegisterMapType((map[int32]*Simple3)(nil), "jsonpb_generated.Mappy.ObjjyEntry")
+	proto.RegisterMapType((map[int32]bool)(nil), "jsonpb_generated.Mappy.S32boolyEntry")
+	proto.RegisterMapType((map[int64]bool)(nil), "jsonpb_generated.Mappy.S64boolyEntry")
+	proto.RegisterMapType((map[string]string)(nil), "jsonpb_generated.Mappy.StrryEntry")
+	proto.RegisterMapType((map[uint32]bool)(nil), "jsonpb_generated.Mappy.U32boolyEntry")
+	proto.RegisterMapType((map[uint64]bool)(nil), "jsonpb_generated.Mappy.U64boolyEntry")
+	proto.RegisterType((*FieldOptions3)(nil), "jsonpb_generated.FieldOptions3")
+	proto.RegisterMapType((map[string]Numeral)(nil), "jsonpb_generated.FieldOptions3.NumeralMapEntry")
+	proto.RegisterType((*Secrets3)(nil), "jsonpb_generated.Secrets3")
+	proto.RegisterMapType((map[string]string)(nil), "jsonpb_generated.Secrets3.LabelsEntry")
+	proto.RegisterEnum("jsonpb_generated.Numeral", Numeral_name, Numeral_value)
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Simple3) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("dub", "dub", m.Dub == 0) {
+		e.Float64(m.Dub)
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Simple3) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("dub", "dub"); ok {
+		if err := d.Float64(raw, &m.Dub); err != nil {
+			return err
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *SimpleSlice3) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("slices", "slices", m.Slices == nil) {
+		e.BeginList()
+		for _, x := range m.Slices {
+			e.Elem()
+			e.String(x)
+		}
+		e.EndList()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *SimpleSlice3) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("slices", "slices"); ok {
+		elems, err := d.List(raw, "slices")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Slices = make([]string, len(elems))
+			for i, r := range elems {
+				if err := d.String(r, &m.Slices[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *SimpleMap3) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("stringy", "stringy", m.Stringy == nil) {
+		keys := make([]string, 0, len(m.Stringy))
+		for k := range m.Stringy {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.Stringy[k]
+			e.String(v)
+		}
+		e.EndMap()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *SimpleMap3) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("stringy", "stringy"); ok {
+		elems, err := d.Map(raw, "stringy")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Stringy = make(map[string]string, len(elems))
+			for k, r := range elems {
+				var v string
+				if err := d.String(r, &v); err != nil {
+					return err
+				}
+				m.Stringy[k] = v
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *SimpleNull3) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("simple", "simple", m.Simple == nil) {
+		if m.Simple == nil {
+			e.Null()
+		} else if err := e.Message(m.Simple); err != nil {
+			return nil, err
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *SimpleNull3) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("simple", "simple"); ok {
+		if !d.Null(raw) {
+			m.Simple = new(Simple3)
+			if err := d.Message(raw, m.Simple, "simple"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Mappy) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("nummy", "nummy", m.Nummy == nil) {
+		keys := make([]int64, 0, len(m.Nummy))
+		for k := range m.Nummy {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.IntKey(int64(k))
+			v := m.Nummy[k]
+			e.Int32(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("strry", "strry", m.Strry == nil) {
+		keys := make([]string, 0, len(m.Strry))
+		for k := range m.Strry {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.Strry[k]
+			e.String(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("objjy", "objjy", m.Objjy == nil) {
+		keys := make([]int32, 0, len(m.Objjy))
+		for k := range m.Objjy {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.IntKey(int64(k))
+			v := m.Objjy[k]
+			if v == nil {
+				e.Null()
+			} else if err := e.Message(v); err != nil {
+				return nil, err
+			}
+		}
+		e.EndMap()
+	}
+	if e.Field("buggy", "buggy", m.Buggy == nil) {
+		keys := make([]int64, 0, len(m.Buggy))
+		for k := range m.Buggy {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.IntKey(int64(k))
+			v := m.Buggy[k]
+			e.String(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("booly", "booly", m.Booly == nil) {
+		keys := make([]bool, 0, len(m.Booly))
+		for k := range m.Booly {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.BoolKey(k)
+			v := m.Booly[k]
+			e.Bool(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("enumy", "enumy", m.Enumy == nil) {
+		keys := make([]string, 0, len(m.Enumy))
+		for k := range m.Enumy {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.Enumy[k]
+			e.Int32(int32(v))
+		}
+		e.EndMap()
+	}
+	if e.Field("s32booly", "s32booly", m.S32Booly == nil) {
+		keys := make([]int32, 0, len(m.S32Booly))
+		for k := range m.S32Booly {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.IntKey(int64(k))
+			v := m.S32Booly[k]
+			e.Bool(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("s64booly", "s64booly", m.S64Booly == nil) {
+		keys := make([]int64, 0, len(m.S64Booly))
+		for k := range m.S64Booly {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.IntKey(int64(k))
+			v := m.S64Booly[k]
+			e.Bool(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("u32booly", "u32booly", m.U32Booly == nil) {
+		keys := make([]uint32, 0, len(m.U32Booly))
+		for k := range m.U32Booly {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.UintKey(uint64(k))
+			v := m.U32Booly[k]
+			e.Bool(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("u64booly", "u64booly", m.U64Booly == nil) {
+		keys := make([]uint64, 0, len(m.U64Booly))
+		for k := range m.U64Booly {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.UintKey(uint64(k))
+			v := m.U64Booly[k]
+			e.Bool(v)
+		}
+		e.EndMap()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Mappy) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("nummy", "nummy"); ok {
+		elems, err := d.Map(raw, "nummy")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Nummy = make(map[int64]int32, len(elems))
+			for s, r := range elems {
+				var k int64
+				if err := d.Int64([]byte(s), &k); err != nil {
+					return err
+				}
+				var v int32
+				if err := d.Int32(r, &v); err != nil {
+					return err
+				}
+				m.Nummy[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("strry", "strry"); ok {
+		elems, err := d.Map(raw, "strry")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Strry = make(map[string]string, len(elems))
+			for k, r := range elems {
+				var v string
+				if err := d.String(r, &v); err != nil {
+					return err
+				}
+				m.Strry[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("objjy", "objjy"); ok {
+		elems, err := d.Map(raw, "objjy")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Objjy = make(map[int32]*Simple3, len(elems))
+			for s, r := range elems {
+				var k int32
+				if err := d.Int32([]byte(s), &k); err != nil {
+					return err
+				}
+				var v *Simple3
+				if !d.Null(r) {
+					v = new(Simple3)
+					if err := d.Message(r, v, ""); err != nil {
+						return err
+					}
+				}
+				m.Objjy[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("buggy", "buggy"); ok {
+		elems, err := d.Map(raw, "buggy")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Buggy = make(map[int64]string, len(elems))
+			for s, r := range elems {
+				var k int64
+				if err := d.Int64([]byte(s), &k); err != nil {
+					return err
+				}
+				var v string
+				if err := d.String(r, &v); err != nil {
+					return err
+				}
+				m.Buggy[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("booly", "booly"); ok {
+		elems, err := d.Map(raw, "booly")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Booly = make(map[bool]bool, len(elems))
+			for s, r := range elems {
+				var k bool
+				if err := d.Bool([]byte(s), &k); err != nil {
+					return err
+				}
+				var v bool
+				if err := d.Bool(r, &v); err != nil {
+					return err
+				}
+				m.Booly[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("enumy", "enumy"); ok {
+		elems, err := d.Map(raw, "enumy")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Enumy = make(map[string]Numeral, len(elems))
+			for k, r := range elems {
+				var v Numeral
+				if err := d.Int32(r, (*int32)(&v)); err != nil {
+					return err
+				}
+				m.Enumy[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("s32booly", "s32booly"); ok {
+		elems, err := d.Map(raw, "s32booly")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.S32Booly = make(map[int32]bool, len(elems))
+			for s, r := range elems {
+				var k int32
+				if err := d.Int32([]byte(s), &k); err != nil {
+					return err
+				}
+				var v bool
+				if err := d.Bool(r, &v); err != nil {
+					return err
+				}
+				m.S32Booly[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("s64booly", "s64booly"); ok {
+		elems, err := d.Map(raw, "s64booly")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.S64Booly = make(map[int64]bool, len(elems))
+			for s, r := range elems {
+				var k int64
+				if err := d.Int64([]byte(s), &k); err != nil {
+					return err
+				}
+				var v bool
+				if err := d.Bool(r, &v); err != nil {
+					return err
+				}
+				m.S64Booly[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("u32booly", "u32booly"); ok {
+		elems, err := d.Map(raw, "u32booly")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.U32Booly = make(map[uint32]bool, len(elems))
+			for s, r := range elems {
+				var k uint32
+				if err := d.Uint32([]byte(s), &k); err != nil {
+					return err
+				}
+				var v bool
+				if err := d.Bool(r, &v); err != nil {
+					return err
+				}
+				m.U32Booly[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("u64booly", "u64booly"); ok {
+		elems, err := d.Map(raw, "u64booly")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.U64Booly = make(map[uint64]bool, len(elems))
+			for s, r := range elems {
+				var k uint64
+				if err := d.Uint64([]byte(s), &k); err != nil {
+					return err
+				}
+				var v bool
+				if err := d.Bool(r, &v); err != nil {
+					return err
+				}
+				m.U64Booly[k] = v
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *FieldOptions3) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("numeral", "numeral", m.Numeral == 0) {
+		e.Enum(int32(m.Numeral), Numeral_name)
+	}
+	if e.Field("name", "name", m.Name == 0) {
+		e.Enum(int32(m.Name), Numeral_name)
+	}
+	if e.Field("numerals", "numerals", m.Numerals == nil) {
+		e.BeginList()
+		for _, x := range m.Numerals {
+			e.Elem()
+			e.Enum(int32(x), Numeral_name)
+		}
+		e.EndList()
+	}
+	if e.Field("numeral_map", "numeralMap", m.NumeralMap == nil) {
+		keys := make([]string, 0, len(m.NumeralMap))
+		for k := range m.NumeralMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.NumeralMap[k]
+			e.Int32(int32(v))
+		}
+		e.EndMap()
+	}
+	if e.Field("count", "count", m.Count == 0) {
+		e.Int64(m.Count)
+	}
+	if e.Field("total", "total", m.Total == 0) {
+		e.Uint64(m.Total)
+	}
+	if e.Field("counts", "counts", m.Counts == nil) {
+		e.BeginList()
+		for _, x := range m.Counts {
+			e.Elem()
+			e.Uint64(x)
+		}
+		e.EndList()
+	}
+	if e.Field("note", "note", m.Note == "") {
+		e.String(m.Note)
+	}
+	if e.Field("simple", "simple", m.Simple == nil) {
+		if m.Simple == nil {
+			e.Null()
+		} else if err := e.Message(m.Simple); err != nil {
+			return nil, err
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *FieldOptions3) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("numeral", "numeral"); ok {
+		if err := d.Enum(raw, (*int32)(&m.Numeral), Numeral_value, "jsonpb_generated.Numeral"); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("name", "name"); ok {
+		if err := d.Enum(raw, (*int32)(&m.Name), Numeral_value, "jsonpb_generated.Numeral"); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("numerals", "numerals"); ok {
+		elems, err := d.List(raw, "numerals")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Numerals = make([]Numeral, len(elems))
+			for i, r := range elems {
+				if err := d.Enum(r, (*int32)(&m.Numerals[i]), Numeral_value, "jsonpb_generated.Numeral"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("numeral_map", "numeralMap"); ok {
+		elems, err := d.Map(raw, "numeral_map")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.NumeralMap = make(map[string]Numeral, len(elems))
+			for k, r := range elems {
+				var v Numeral
+				if err := d.Int32(r, (*int32)(&v)); err != nil {
+					return err
+				}
+				m.NumeralMap[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("count", "count"); ok {
+		if err := d.Int64(raw, &m.Count); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("total", "total"); ok {
+		if err := d.Uint64(raw, &m.Total); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("counts", "counts"); ok {
+		elems, err := d.List(raw, "counts")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Counts = make([]uint64, len(elems))
+			for i, r := range elems {
+				if err := d.Uint64(r, &m.Counts[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("note", "note"); ok {
+		if err := d.String(raw, &m.Note); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("simple", "simple"); ok {
+		if !d.Null(raw) {
+			m.Simple = new(Simple3)
+			if err := d.Message(raw, m.Simple, "simple"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Secrets3) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("user", "user", m.User == "") {
+		e.String(m.User)
+	}
+	if e.SensitiveField("password", "password", m.Password == "") {
+		e.String(m.Password)
+	}
+	if e.SensitiveField("key", "key", m.Key == nil) {
+		e.Bytes(m.Key)
+	}
+	if !e.Redact() && e.Field("pin", "pin", m.Pin == 0) {
+		e.Int32(m.Pin)
+	}
+	if !e.Redact() && e.Field("numeral", "numeral", m.Numeral == 0) {
+		e.Enum(int32(m.Numeral), Numeral_name)
+	}
+	if e.SensitiveField("tokens", "tokens", m.Tokens == nil) {
+		e.BeginList()
+		for _, x := range m.Tokens {
+			e.Elem()
+			e.String(x)
+		}
+		e.EndList()
+	}
+	if e.SensitiveField("keys", "keys", m.Keys == nil) {
+		e.BeginList()
+		for _, x := range m.Keys {
+			e.Elem()
+			e.Bytes(x)
+		}
+		e.EndList()
+	}
+	if !e.Redact() && e.Field("labels", "labels", m.Labels == nil) {
+		keys := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.Labels[k]
+			e.String(v)
+		}
+		e.EndMap()
+	}
+	if !e.Redact() && e.Field("inner", "inner", m.Inner == nil) {
+		if m.Inner == nil {
+			e.Null()
+		} else if err := e.Message(m.Inner); err != nil {
+			return nil, err
+		}
+	}
+	switch x := m.Choice.(type) {
+	case *Secrets3_Code:
+		if e.SensitiveField("code", "code", false) {
+			e.String(x.Code)
+		}
+	case *Secrets3_Serial:
+		if !e.Redact() && e.Field("serial", "serial", false) {
+			e.Int64(x.Serial)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Secrets3) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("user", "user"); ok {
+		if err := d.String(raw, &m.User); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("password", "password"); ok {
+		if err := d.String(raw, &m.Password); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("key", "key"); ok {
+		if err := d.Bytes(raw, &m.Key); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("pin", "pin"); ok {
+		if err := d.Int32(raw, &m.Pin); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("numeral", "numeral"); ok {
+		if err := d.Enum(raw, (*int32)(&m.Numeral), Numeral_value, "jsonpb_generated.Numeral"); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("tokens", "tokens"); ok {
+		elems, err := d.List(raw, "tokens")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Tokens = make([]string, len(elems))
+			for i, r := range elems {
+				if err := d.String(r, &m.Tokens[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("keys", "keys"); ok {
+		elems, err := d.List(raw, "keys")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Keys = make([][]byte, len(elems))
+			for i, r := range elems {
+				if err := d.Bytes(r, &m.Keys[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("labels", "labels"); ok {
+		elems, err := d.Map(raw, "labels")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Labels = make(map[string]string, len(elems))
+			for k, r := range elems {
+				var v string
+				if err := d.String(r, &v); err != nil {
+					return err
+				}
+				m.Labels[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("inner", "inner"); ok {
+		if !d.Null(raw) {
+			m.Inner = new(Simple3)
+			if err := d.Message(raw, m.Inner, "inner"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("code", "code"); ok {
+		x := new(Secrets3_Code)
+		m.Choice = x
+		if err := d.String(raw, &x.Code); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("serial", "serial"); ok {
+		x := new(Secrets3_Serial)
+		m.Choice = x
+		if err := d.Int64(raw, &x.Serial); err != nil {
+			return err
+		}
+	}
+	return d.End()
+}
+
+func init() {
+	proto.RegisterFile("jsonpb_generated/more_test_objects.proto", fileDescriptor_more_test_objects_6e2b88b063d3a65d)
+}
+
+var fileDescriptor_more_test_objects_6e2b88b063d3a65d = []byte{
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdd, 0x6a, 0xe3, 0x46,
+	0x14, 0xc7, 0x57, 0xb6, 0x24, 0xcb, 0xc7, 0x49, 0xd7, 0x0c, 0xa5, 0x4c, 0xdd, 0xb2, 0x04, 0x41,
+	0x97, 0xb4, 0xb0, 0x0e, 0x8d, 0x42, 0x9a, 0xee, 0xb2, 0xb4, 0xf1, 0xb2, 0x4b, 0x4b, 0x1b, 0x07,
+	0x64, 0x42, 0x4b, 0x6f, 0x82, 0x6c, 0x0f, 0xa9, 0x12, 0x69, 0x24, 0x34, 0xa3, 0x2d, 0x7a, 0x88,
+	0x42, 0xa1, 0x77, 0x7d, 0x83, 0x3e, 0x4f, 0x5f, 0xa1, 0xaf, 0xb0, 0xf7, 0x65, 0x3e, 0xf4, 0x61,
+	0xaf, 0x6d, 0x25, 0xd0, 0xbb, 0xd1, 0x39, 0xff, 0xdf, 0xd1, 0x99, 0xa3, 0xff, 0x78, 0x0c, 0x87,
+	0xb7, 0x2c, 0xa1, 0xe9, 0xfc, 0xfa, 0x86, 0x50, 0x92, 0x05, 0x9c, 0x2c, 0x8f, 0xe2, 0x24, 0x23,
+	0xd7, 0x9c, 0x30, 0x7e, 0x9d, 0xcc, 0x6f, 0xc9, 0x82, 0xb3, 0x71, 0x9a, 0x25, 0x3c, 0x41, 0xc3,
+	0x75, 0xe5, 0x08, 0x44, 0x44, 0x65, 0x47, 0x8f, 0x19, 0xa1, 0x2c, 0xe4, 0xe1, 0x5b, 0xa2, 0x02,
+	0xee, 0x27, 0xd0, 0x9b, 0x85, 0x71, 0x1a, 0x11, 0x0f, 0x0d, 0xa1, 0xbb, 0xcc, 0xe7, 0xd8, 0x38,
+	0x30, 0x0e, 0x0d, 0x5f, 0x2c, 0xdd, 0xa7, 0xb0, 0xa7, 0x92, 0xb3, 0x28, 0x5c, 0x10, 0x0f, 0x7d,
+	0x04, 0x36, 0x13, 0x2b, 0x86, 0x8d, 0x83, 0xee, 0x61, 0xdf, 0xd7, 0x4f, 0xee, 0xef, 0x06, 0x80,
+	0x12, 0x5e, 0x04, 0xa9, 0x87, 0x5e, 0x41, 0x8f, 0xf1, 0x2c, 0xa4, 0x37, 0x85, 0xd4, 0x0d, 0x8e,
+	0x3f, 0x1f, 0xaf, 0x37, 0x35, 0xae, 0xe5, 0xe3, 0x99, 0xd2, 0xbe, 0xa6, 0x3c, 0x2b, 0xfc, 0x92,
+	0x1c, 0x3d, 0x87, 0xbd, 0x66, 0x42, 0x74, 0x77, 0x47, 0x0a, 0xd9, 0x5d, 0xdf, 0x17, 0x4b, 0xf4,
+	0x21, 0x58, 0x6f, 0x83, 0x28, 0x27, 0xb8, 0x23, 0x63, 0xea, 0xe1, 0x79, 0xe7, 0xcc, 0x70, 0xbf,
+	0x85, 0x81, 0xaa, 0x3f, 0xcd, 0xa3, 0xc8, 0x43, 0x5f, 0x82, 0xcd, 0xe4, 0xa3, 0xa4, 0x07, 0xc7,
+	0x1f, 0x6f, 0x6b, 0xc7, 0xf3, 0xb5, 0xd0, 0x7d, 0xd7, 0x07, 0xeb, 0x22, 0x48, 0xd3, 0x02, 0x9d,
+	0x81, 0x45, 0xf3, 0x38, 0x2e, 0xb7, 0xe2, 0xbe, 0xcf, 0x4a, 0xdd, 0x78, 0x2a, 0x44, 0x6a, 0x0f,
+	0x0a, 0x10, 0x24, 0xe3, 0x59, 0x56, 0xe0, 0xce, 0x6e, 0x72, 0x26, 0x44, 0x9a, 0x94, 0x80, 0x20,
+	0x93, 0xf9, 0xed, 0x6d, 0x81, 0xbb, 0xbb, 0xc9, 0x4b, 0x21, 0xd2, 0xa4, 0x04, 0x04, 0x39, 0xcf,
+	0x6f, 0x6e, 0x0a, 0x6c, 0xee, 0x26, 0x27, 0x42, 0xa4, 0x49, 0x09, 0x48, 0x32, 0x49, 0xa2, 0x02,
+	0x5b, 0x2d, 0xa4, 0x10, 0x95, 0xa4, 0x58, 0x0b, 0x92, 0xd0, 0x3c, 0x2e, 0xb0, 0xbd, 0x9b, 0x7c,
+	0x2d, 0x44, 0x9a, 0x94, 0x00, 0x3a, 0x07, 0x87, 0x79, 0xc7, 0xea, 0xb5, 0x3d, 0x09, 0x7f, 0xb6,
+	0x75, 0x48, 0x5a, 0xa7, 0xf8, 0x0a, 0x93, 0x25, 0x4e, 0x4f, 0x54, 0x09, 0xa7, 0xa5, 0xc4, 0xe9,
+	0xc9, 0x4a, 0x89, 0xd3, 0x93, 0xaa, 0x44, 0x5e, 0x76, 0xd1, 0xdf, 0x5d, 0xe2, 0x6a, 0xb5, 0x8b,
+	0xbc, 0xd1, 0x45, 0x5e, 0x76, 0x01, 0x2d, 0x25, 0x56, 0xbb, 0x28, 0xb1, 0xd1, 0x19, 0x40, 0x6d,
+	0xa1, 0xa6, 0xdb, 0xbb, 0x1b, 0xdc, 0x6e, 0x35, 0xdc, 0x2e, 0xc8, 0xda, 0x42, 0x0f, 0x39, 0x27,
+	0xa3, 0x19, 0x40, 0x6d, 0xa1, 0x26, 0x69, 0x29, 0xf2, 0xa8, 0x49, 0xee, 0x3c, 0x37, 0xab, 0xed,
+	0xd4, 0xee, 0x6a, 0xdb, 0x48, 0x7f, 0x9d, 0xac, 0x46, 0xd3, 0x24, 0x9d, 0x0d, 0xa4, 0xb3, 0xb6,
+	0x91, 0xda, 0x5d, 0x1b, 0x46, 0xb0, 0xb2, 0x91, 0x0f, 0x36, 0x6d, 0x64, 0x9a, 0xc7, 0x24, 0x0b,
+	0xa2, 0x66, 0xd1, 0x17, 0xb0, 0xbf, 0xe2, 0xba, 0x0d, 0x03, 0xda, 0xde, 0x91, 0x80, 0x4f, 0x4f,
+	0x36, 0xc3, 0xdd, 0x7b, 0xc0, 0x57, 0xdb, 0xde, 0xbc, 0x7f, 0x1f, 0x78, 0xdb, 0x9b, 0xcd, 0x16,
+	0xd8, 0xfd, 0xdb, 0x84, 0xfd, 0x37, 0x21, 0x89, 0x96, 0x97, 0x29, 0x0f, 0x13, 0xca, 0x3c, 0xf4,
+	0x12, 0x7a, 0x54, 0xcd, 0x06, 0x1b, 0x2d, 0xc3, 0x9b, 0x38, 0x7f, 0xfd, 0xf3, 0xef, 0x9f, 0x9d,
+	0x8e, 0x63, 0xf8, 0x25, 0x83, 0x9e, 0x81, 0x49, 0x83, 0xf8, 0x1e, 0x83, 0x97, 0x32, 0xf4, 0x0d,
+	0x38, 0x9a, 0x64, 0xf2, 0xc7, 0xef, 0x9e, 0xaf, 0xab, 0x20, 0xf4, 0x0b, 0x0c, 0xf4, 0xfa, 0x3a,
+	0x0e, 0x52, 0xfd, 0x33, 0x78, 0xf4, 0x7e, 0x8d, 0x95, 0x4d, 0x96, 0x15, 0x2f, 0x82, 0x54, 0x8e,
+	0xac, 0x51, 0x19, 0x68, 0x95, 0x42, 0x4f, 0xc0, 0x5a, 0x24, 0x39, 0xe5, 0xd8, 0x12, 0x1f, 0xb1,
+	0x14, 0x0d, 0x0d, 0x5f, 0x85, 0xc5, 0x58, 0x79, 0xc2, 0x83, 0x08, 0xdb, 0x72, 0xd4, 0xea, 0x01,
+	0x1d, 0x80, 0x2d, 0xd3, 0x4c, 0xfe, 0xc4, 0xd9, 0x0d, 0x4c, 0xc7, 0xd1, 0xa7, 0x60, 0xd2, 0x84,
+	0x13, 0xec, 0x08, 0xc3, 0x96, 0x79, 0x6c, 0xf8, 0x32, 0x8a, 0x5e, 0x56, 0xb7, 0x57, 0xbf, 0xe5,
+	0x14, 0x4e, 0x40, 0xa2, 0xe6, 0xd0, 0xc0, 0x46, 0x79, 0x93, 0x8d, 0x7e, 0x86, 0xc7, 0x6b, 0xbb,
+	0xfb, 0x9f, 0xce, 0x87, 0xfb, 0xae, 0x0b, 0xce, 0x8c, 0x2c, 0x32, 0xc2, 0x99, 0x87, 0x10, 0x98,
+	0x39, 0x23, 0x99, 0x2e, 0x2a, 0xd7, 0xc8, 0x05, 0x27, 0x0d, 0x18, 0xfb, 0x2d, 0xc9, 0x96, 0xea,
+	0xb0, 0x4f, 0xec, 0x3f, 0x44, 0x83, 0x86, 0x5f, 0xc5, 0x11, 0x56, 0xbd, 0x74, 0x0f, 0x8c, 0xc3,
+	0xbd, 0x2a, 0x2d, 0x7b, 0xc2, 0xd0, 0x4d, 0x43, 0x8a, 0x4d, 0x71, 0xda, 0xea, 0x4c, 0x1a, 0x52,
+	0xf4, 0xa2, 0xb6, 0xa4, 0xd5, 0x66, 0xc9, 0x12, 0xac, 0x0c, 0xf9, 0x04, 0x6c, 0x9e, 0xdc, 0x11,
+	0xca, 0xe4, 0x75, 0x55, 0xb7, 0xa4, 0xa3, 0x68, 0x04, 0xe6, 0x1d, 0x29, 0xd4, 0xc7, 0xaa, 0x3b,
+	0x92, 0x31, 0xf4, 0x06, 0xec, 0x28, 0x98, 0x93, 0x88, 0xe9, 0xab, 0xe6, 0xe9, 0x86, 0x4f, 0xa1,
+	0x07, 0x32, 0xfe, 0x51, 0x0a, 0x95, 0x9d, 0xaa, 0x77, 0x28, 0x1a, 0x7d, 0x05, 0x56, 0x48, 0x29,
+	0xc9, 0xda, 0xbf, 0x68, 0x49, 0x2a, 0xbd, 0x70, 0xca, 0x22, 0x59, 0x12, 0x0c, 0xcd, 0x69, 0x7e,
+	0xf7, 0xc8, 0x97, 0x51, 0xe1, 0x34, 0x46, 0xb2, 0x30, 0x88, 0xf0, 0x40, 0x1a, 0xb4, 0xce, 0xeb,
+	0xf8, 0xe8, 0x6b, 0x18, 0x34, 0xfa, 0x7a, 0xc8, 0x5d, 0x31, 0x71, 0xc0, 0x5e, 0xfc, 0x9a, 0x84,
+	0x0b, 0xf2, 0xc5, 0x33, 0xe8, 0xe9, 0xe9, 0xa2, 0x01, 0xf4, 0xae, 0xa6, 0x3f, 0x4c, 0x2f, 0x7f,
+	0x9a, 0x0e, 0x1f, 0x21, 0x00, 0xfb, 0xdc, 0x3f, 0x9f, 0x7c, 0xff, 0x6a, 0x68, 0xa0, 0x3e, 0x58,
+	0xfe, 0xe5, 0xc5, 0xf9, 0x74, 0xd8, 0x99, 0xdb, 0xf2, 0x8f, 0xa6, 0xf7, 0xdf, 0x00, 0x7e, 0x82,
+	0xf4, 0xb3, 0xc3, 0x0a, 0x00, 0x00,
+}
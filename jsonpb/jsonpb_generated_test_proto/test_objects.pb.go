@@ -0,0 +1,3749 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: jsonpb_generated/test_objects.proto
+
+package jsonpb_generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import any "github.com/golang/protobuf/ptypes/any"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import _struct "github.com/golang/protobuf/ptypes/struct"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+import (
+	jsonpb "github.com/golang/protobuf/jsonpb"
+	sort "sort"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Widget_Color int32
+
+const (
+	Widget_RED   Widget_Color = 0
+	Widget_GREEN Widget_Color = 1
+	Widget_BLUE  Widget_Color = 2
+)
+
+var Widget_Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+	2: "BLUE",
+}
+var Widget_Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+	"BLUE":  2,
+}
+
+func (x Widget_Color) Enum() *Widget_Color {
+	p := new(Widget_Color)
+	*p = x
+	return p
+}
+func (x Widget_Color) String() string {
+	return proto.EnumName(Widget_Color_name, int32(x))
+}
+func (x *Widget_Color) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Widget_Color_value, data, "Widget_Color")
+	if err != nil {
+		return err
+	}
+	*x = Widget_Color(value)
+	return nil
+}
+func (Widget_Color) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{3, 0}
+}
+
+// Test message for holding primitive types.
+type Simple struct {
+	OBool                *bool    `protobuf:"varint,1,opt,name=o_bool,json=oBool" json:"o_bool,omitempty"`
+	OInt32               *int32   `protobuf:"varint,2,opt,name=o_int32,json=oInt32" json:"o_int32,omitempty"`
+	OInt32Str            *int32   `protobuf:"varint,3,opt,name=o_int32_str,json=oInt32Str" json:"o_int32_str,omitempty"`
+	OInt64               *int64   `protobuf:"varint,4,opt,name=o_int64,json=oInt64" json:"o_int64,omitempty"`
+	OInt64Str            *int64   `protobuf:"varint,5,opt,name=o_int64_str,json=oInt64Str" json:"o_int64_str,omitempty"`
+	OUint32              *uint32  `protobuf:"varint,6,opt,name=o_uint32,json=oUint32" json:"o_uint32,omitempty"`
+	OUint32Str           *uint32  `protobuf:"varint,7,opt,name=o_uint32_str,json=oUint32Str" json:"o_uint32_str,omitempty"`
+	OUint64              *uint64  `protobuf:"varint,8,opt,name=o_uint64,json=oUint64" json:"o_uint64,omitempty"`
+	OUint64Str           *uint64  `protobuf:"varint,9,opt,name=o_uint64_str,json=oUint64Str" json:"o_uint64_str,omitempty"`
+	OSint32              *int32   `protobuf:"zigzag32,10,opt,name=o_sint32,json=oSint32" json:"o_sint32,omitempty"`
+	OSint32Str           *int32   `protobuf:"zigzag32,11,opt,name=o_sint32_str,json=oSint32Str" json:"o_sint32_str,omitempty"`
+	OSint64              *int64   `protobuf:"zigzag64,12,opt,name=o_sint64,json=oSint64" json:"o_sint64,omitempty"`
+	OSint64Str           *int64   `protobuf:"zigzag64,13,opt,name=o_sint64_str,json=oSint64Str" json:"o_sint64_str,omitempty"`
+	OFloat               *float32 `protobuf:"fixed32,14,opt,name=o_float,json=oFloat" json:"o_float,omitempty"`
+	OFloatStr            *float32 `protobuf:"fixed32,15,opt,name=o_float_str,json=oFloatStr" json:"o_float_str,omitempty"`
+	ODouble              *float64 `protobuf:"fixed64,16,opt,name=o_double,json=oDouble" json:"o_double,omitempty"`
+	ODoubleStr           *float64 `protobuf:"fixed64,17,opt,name=o_double_str,json=oDoubleStr" json:"o_double_str,omitempty"`
+	OString              *string  `protobuf:"bytes,18,opt,name=o_string,json=oString" json:"o_string,omitempty"`
+	OBytes               []byte   `protobuf:"bytes,19,opt,name=o_bytes,json=oBytes" json:"o_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Simple) Reset()         { *m = Simple{} }
+func (m *Simple) String() string { return proto.CompactTextString(m) }
+func (*Simple) ProtoMessage()    {}
+func (*Simple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{0}
+}
+func (m *Simple) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Simple.Unmarshal(m, b)
+}
+func (m *Simple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Simple.Marshal(b, m, deterministic)
+}
+func (dst *Simple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Simple.Merge(dst, src)
+}
+func (m *Simple) XXX_Size() int {
+	return xxx_messageInfo_Simple.Size(m)
+}
+func (m *Simple) XXX_DiscardUnknown() {
+	xxx_messageInfo_Simple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Simple proto.InternalMessageInfo
+
+func (m *Simple) GetOBool() bool {
+	if m != nil && m.OBool != nil {
+		return *m.OBool
+	}
+	return false
+}
+
+func (m *Simple) GetOInt32() int32 {
+	if m != nil && m.OInt32 != nil {
+		return *m.OInt32
+	}
+	return 0
+}
+
+func (m *Simple) GetOInt32Str() int32 {
+	if m != nil && m.OInt32Str != nil {
+		return *m.OInt32Str
+	}
+	return 0
+}
+
+func (m *Simple) GetOInt64() int64 {
+	if m != nil && m.OInt64 != nil {
+		return *m.OInt64
+	}
+	return 0
+}
+
+func (m *Simple) GetOInt64Str() int64 {
+	if m != nil && m.OInt64Str != nil {
+		return *m.OInt64Str
+	}
+	return 0
+}
+
+func (m *Simple) GetOUint32() uint32 {
+	if m != nil && m.OUint32 != nil {
+		return *m.OUint32
+	}
+	return 0
+}
+
+func (m *Simple) GetOUint32Str() uint32 {
+	if m != nil && m.OUint32Str != nil {
+		return *m.OUint32Str
+	}
+	return 0
+}
+
+func (m *Simple) GetOUint64() uint64 {
+	if m != nil && m.OUint64 != nil {
+		return *m.OUint64
+	}
+	return 0
+}
+
+func (m *Simple) GetOUint64Str() uint64 {
+	if m != nil && m.OUint64Str != nil {
+		return *m.OUint64Str
+	}
+	return 0
+}
+
+func (m *Simple) GetOSint32() int32 {
+	if m != nil && m.OSint32 != nil {
+		return *m.OSint32
+	}
+	return 0
+}
+
+func (m *Simple) GetOSint32Str() int32 {
+	if m != nil && m.OSint32Str != nil {
+		return *m.OSint32Str
+	}
+	return 0
+}
+
+func (m *Simple) GetOSint64() int64 {
+	if m != nil && m.OSint64 != nil {
+		return *m.OSint64
+	}
+	return 0
+}
+
+func (m *Simple) GetOSint64Str() int64 {
+	if m != nil && m.OSint64Str != nil {
+		return *m.OSint64Str
+	}
+	return 0
+}
+
+func (m *Simple) GetOFloat() float32 {
+	if m != nil && m.OFloat != nil {
+		return *m.OFloat
+	}
+	return 0
+}
+
+func (m *Simple) GetOFloatStr() float32 {
+	if m != nil && m.OFloatStr != nil {
+		return *m.OFloatStr
+	}
+	return 0
+}
+
+func (m *Simple) GetODouble() float64 {
+	if m != nil && m.ODouble != nil {
+		return *m.ODouble
+	}
+	return 0
+}
+
+func (m *Simple) GetODoubleStr() float64 {
+	if m != nil && m.ODoubleStr != nil {
+		return *m.ODoubleStr
+	}
+	return 0
+}
+
+func (m *Simple) GetOString() string {
+	if m != nil && m.OString != nil {
+		return *m.OString
+	}
+	return ""
+}
+
+func (m *Simple) GetOBytes() []byte {
+	if m != nil {
+		return m.OBytes
+	}
+	return nil
+}
+
+// Test message for holding special non-finites primitives.
+type NonFinites struct {
+	FNan                 *float32 `protobuf:"fixed32,1,opt,name=f_nan,json=fNan" json:"f_nan,omitempty"`
+	FPinf                *float32 `protobuf:"fixed32,2,opt,name=f_pinf,json=fPinf" json:"f_pinf,omitempty"`
+	FNinf                *float32 `protobuf:"fixed32,3,opt,name=f_ninf,json=fNinf" json:"f_ninf,omitempty"`
+	DNan                 *float64 `protobuf:"fixed64,4,opt,name=d_nan,json=dNan" json:"d_nan,omitempty"`
+	DPinf                *float64 `protobuf:"fixed64,5,opt,name=d_pinf,json=dPinf" json:"d_pinf,omitempty"`
+	DNinf                *float64 `protobuf:"fixed64,6,opt,name=d_ninf,json=dNinf" json:"d_ninf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NonFinites) Reset()         { *m = NonFinites{} }
+func (m *NonFinites) String() string { return proto.CompactTextString(m) }
+func (*NonFinites) ProtoMessage()    {}
+func (*NonFinites) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{1}
+}
+func (m *NonFinites) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NonFinites.Unmarshal(m, b)
+}
+func (m *NonFinites) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NonFinites.Marshal(b, m, deterministic)
+}
+func (dst *NonFinites) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonFinites.Merge(dst, src)
+}
+func (m *NonFinites) XXX_Size() int {
+	return xxx_messageInfo_NonFinites.Size(m)
+}
+func (m *NonFinites) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonFinites.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonFinites proto.InternalMessageInfo
+
+func (m *NonFinites) GetFNan() float32 {
+	if m != nil && m.FNan != nil {
+		return *m.FNan
+	}
+	return 0
+}
+
+func (m *NonFinites) GetFPinf() float32 {
+	if m != nil && m.FPinf != nil {
+		return *m.FPinf
+	}
+	return 0
+}
+
+func (m *NonFinites) GetFNinf() float32 {
+	if m != nil && m.FNinf != nil {
+		return *m.FNinf
+	}
+	return 0
+}
+
+func (m *NonFinites) GetDNan() float64 {
+	if m != nil && m.DNan != nil {
+		return *m.DNan
+	}
+	return 0
+}
+
+func (m *NonFinites) GetDPinf() float64 {
+	if m != nil && m.DPinf != nil {
+		return *m.DPinf
+	}
+	return 0
+}
+
+func (m *NonFinites) GetDNinf() float64 {
+	if m != nil && m.DNinf != nil {
+		return *m.DNinf
+	}
+	return 0
+}
+
+// Test message for holding repeated primitives.
+type Repeats struct {
+	RBool                []bool    `protobuf:"varint,1,rep,name=r_bool,json=rBool" json:"r_bool,omitempty"`
+	RInt32               []int32   `protobuf:"varint,2,rep,name=r_int32,json=rInt32" json:"r_int32,omitempty"`
+	RInt64               []int64   `protobuf:"varint,3,rep,name=r_int64,json=rInt64" json:"r_int64,omitempty"`
+	RUint32              []uint32  `protobuf:"varint,4,rep,name=r_uint32,json=rUint32" json:"r_uint32,omitempty"`
+	RUint64              []uint64  `protobuf:"varint,5,rep,name=r_uint64,json=rUint64" json:"r_uint64,omitempty"`
+	RSint32              []int32   `protobuf:"zigzag32,6,rep,name=r_sint32,json=rSint32" json:"r_sint32,omitempty"`
+	RSint64              []int64   `protobuf:"zigzag64,7,rep,name=r_sint64,json=rSint64" json:"r_sint64,omitempty"`
+	RFloat               []float32 `protobuf:"fixed32,8,rep,name=r_float,json=rFloat" json:"r_float,omitempty"`
+	RDouble              []float64 `protobuf:"fixed64,9,rep,name=r_double,json=rDouble" json:"r_double,omitempty"`
+	RString              []string  `protobuf:"bytes,10,rep,name=r_string,json=rString" json:"r_string,omitempty"`
+	RBytes               [][]byte  `protobuf:"bytes,11,rep,name=r_bytes,json=rBytes" json:"r_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Repeats) Reset()         { *m = Repeats{} }
+func (m *Repeats) String() string { return proto.CompactTextString(m) }
+func (*Repeats) ProtoMessage()    {}
+func (*Repeats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{2}
+}
+func (m *Repeats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Repeats.Unmarshal(m, b)
+}
+func (m *Repeats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Repeats.Marshal(b, m, deterministic)
+}
+func (dst *Repeats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Repeats.Merge(dst, src)
+}
+func (m *Repeats) XXX_Size() int {
+	return xxx_messageInfo_Repeats.Size(m)
+}
+func (m *Repeats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Repeats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Repeats proto.InternalMessageInfo
+
+func (m *Repeats) GetRBool() []bool {
+	if m != nil {
+		return m.RBool
+	}
+	return nil
+}
+
+func (m *Repeats) GetRInt32() []int32 {
+	if m != nil {
+		return m.RInt32
+	}
+	return nil
+}
+
+func (m *Repeats) GetRInt64() []int64 {
+	if m != nil {
+		return m.RInt64
+	}
+	return nil
+}
+
+func (m *Repeats) GetRUint32() []uint32 {
+	if m != nil {
+		return m.RUint32
+	}
+	return nil
+}
+
+func (m *Repeats) GetRUint64() []uint64 {
+	if m != nil {
+		return m.RUint64
+	}
+	return nil
+}
+
+func (m *Repeats) GetRSint32() []int32 {
+	if m != nil {
+		return m.RSint32
+	}
+	return nil
+}
+
+func (m *Repeats) GetRSint64() []int64 {
+	if m != nil {
+		return m.RSint64
+	}
+	return nil
+}
+
+func (m *Repeats) GetRFloat() []float32 {
+	if m != nil {
+		return m.RFloat
+	}
+	return nil
+}
+
+func (m *Repeats) GetRDouble() []float64 {
+	if m != nil {
+		return m.RDouble
+	}
+	return nil
+}
+
+func (m *Repeats) GetRString() []string {
+	if m != nil {
+		return m.RString
+	}
+	return nil
+}
+
+func (m *Repeats) GetRBytes() [][]byte {
+	if m != nil {
+		return m.RBytes
+	}
+	return nil
+}
+
+// Test message for holding enums and nested messages.
+type Widget struct {
+	Color                *Widget_Color  `protobuf:"varint,1,opt,name=color,enum=jsonpb_generated.Widget_Color" json:"color,omitempty"`
+	RColor               []Widget_Color `protobuf:"varint,2,rep,name=r_color,json=rColor,enum=jsonpb_generated.Widget_Color" json:"r_color,omitempty"`
+	Simple               *Simple        `protobuf:"bytes,10,opt,name=simple" json:"simple,omitempty"`
+	RSimple              []*Simple      `protobuf:"bytes,11,rep,name=r_simple,json=rSimple" json:"r_simple,omitempty"`
+	Repeats              *Repeats       `protobuf:"bytes,20,opt,name=repeats" json:"repeats,omitempty"`
+	RRepeats             []*Repeats     `protobuf:"bytes,21,rep,name=r_repeats,json=rRepeats" json:"r_repeats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Widget) Reset()         { *m = Widget{} }
+func (m *Widget) String() string { return proto.CompactTextString(m) }
+func (*Widget) ProtoMessage()    {}
+func (*Widget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{3}
+}
+func (m *Widget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Widget.Unmarshal(m, b)
+}
+func (m *Widget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Widget.Marshal(b, m, deterministic)
+}
+func (dst *Widget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Widget.Merge(dst, src)
+}
+func (m *Widget) XXX_Size() int {
+	return xxx_messageInfo_Widget.Size(m)
+}
+func (m *Widget) XXX_DiscardUnknown() {
+	xxx_messageInfo_Widget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Widget proto.InternalMessageInfo
+
+func (m *Widget) GetColor() Widget_Color {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return Widget_RED
+}
+
+func (m *Widget) GetRColor() []Widget_Color {
+	if m != nil {
+		return m.RColor
+	}
+	return nil
+}
+
+func (m *Widget) GetSimple() *Simple {
+	if m != nil {
+		return m.Simple
+	}
+	return nil
+}
+
+func (m *Widget) GetRSimple() []*Simple {
+	if m != nil {
+		return m.RSimple
+	}
+	return nil
+}
+
+func (m *Widget) GetRepeats() *Repeats {
+	if m != nil {
+		return m.Repeats
+	}
+	return nil
+}
+
+func (m *Widget) GetRRepeats() []*Repeats {
+	if m != nil {
+		return m.RRepeats
+	}
+	return nil
+}
+
+type Maps struct {
+	MInt64Str            map[int64]string `protobuf:"bytes,1,rep,name=m_int64_str,json=mInt64Str" json:"m_int64_str,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MBoolSimple          map[bool]*Simple `protobuf:"bytes,2,rep,name=m_bool_simple,json=mBoolSimple" json:"m_bool_simple,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Maps) Reset()         { *m = Maps{} }
+func (m *Maps) String() string { return proto.CompactTextString(m) }
+func (*Maps) ProtoMessage()    {}
+func (*Maps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{4}
+}
+func (m *Maps) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Maps.Unmarshal(m, b)
+}
+func (m *Maps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Maps.Marshal(b, m, deterministic)
+}
+func (dst *Maps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Maps.Merge(dst, src)
+}
+func (m *Maps) XXX_Size() int {
+	return xxx_messageInfo_Maps.Size(m)
+}
+func (m *Maps) XXX_DiscardUnknown() {
+	xxx_messageInfo_Maps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Maps proto.InternalMessageInfo
+
+func (m *Maps) GetMInt64Str() map[int64]string {
+	if m != nil {
+		return m.MInt64Str
+	}
+	return nil
+}
+
+func (m *Maps) GetMBoolSimple() map[bool]*Simple {
+	if m != nil {
+		return m.MBoolSimple
+	}
+	return nil
+}
+
+type MsgWithOneof struct {
+	// Types that are valid to be assigned to Union:
+	//	*MsgWithOneof_Title
+	//	*MsgWithOneof_Salary
+	//	*MsgWithOneof_Country
+	//	*MsgWithOneof_HomeAddress
+	//	*MsgWithOneof_MsgWithRequired
+	Union                isMsgWithOneof_Union `protobuf_oneof:"union"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MsgWithOneof) Reset()         { *m = MsgWithOneof{} }
+func (m *MsgWithOneof) String() string { return proto.CompactTextString(m) }
+func (*MsgWithOneof) ProtoMessage()    {}
+func (*MsgWithOneof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{5}
+}
+func (m *MsgWithOneof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithOneof.Unmarshal(m, b)
+}
+func (m *MsgWithOneof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithOneof.Marshal(b, m, deterministic)
+}
+func (dst *MsgWithOneof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithOneof.Merge(dst, src)
+}
+func (m *MsgWithOneof) XXX_Size() int {
+	return xxx_messageInfo_MsgWithOneof.Size(m)
+}
+func (m *MsgWithOneof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithOneof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithOneof proto.InternalMessageInfo
+
+type isMsgWithOneof_Union interface {
+	isMsgWithOneof_Union()
+}
+
+type MsgWithOneof_Title struct {
+	Title string `protobuf:"bytes,1,opt,name=title,oneof"`
+}
+type MsgWithOneof_Salary struct {
+	Salary int64 `protobuf:"varint,2,opt,name=salary,oneof"`
+}
+type MsgWithOneof_Country struct {
+	Country string `protobuf:"bytes,3,opt,name=Country,oneof"`
+}
+type MsgWithOneof_HomeAddress struct {
+	HomeAddress string `protobuf:"bytes,4,opt,name=home_address,json=homeAddress,oneof"`
+}
+type MsgWithOneof_MsgWithRequired struct {
+	MsgWithRequired *MsgWithRequired `protobuf:"bytes,5,opt,name=msg_with_required,json=msgWithRequired,oneof"`
+}
+
+func (*MsgWithOneof_Title) isMsgWithOneof_Union()           {}
+func (*MsgWithOneof_Salary) isMsgWithOneof_Union()          {}
+func (*MsgWithOneof_Country) isMsgWithOneof_Union()         {}
+func (*MsgWithOneof_HomeAddress) isMsgWithOneof_Union()     {}
+func (*MsgWithOneof_MsgWithRequired) isMsgWithOneof_Union() {}
+
+func (m *MsgWithOneof) GetUnion() isMsgWithOneof_Union {
+	if m != nil {
+		return m.Union
+	}
+	return nil
+}
+
+func (m *MsgWithOneof) GetTitle() string {
+	if x, ok := m.GetUnion().(*MsgWithOneof_Title); ok {
+		return x.Title
+	}
+	return ""
+}
+
+func (m *MsgWithOneof) GetSalary() int64 {
+	if x, ok := m.GetUnion().(*MsgWithOneof_Salary); ok {
+		return x.Salary
+	}
+	return 0
+}
+
+func (m *MsgWithOneof) GetCountry() string {
+	if x, ok := m.GetUnion().(*MsgWithOneof_Country); ok {
+		return x.Country
+	}
+	return ""
+}
+
+func (m *MsgWithOneof) GetHomeAddress() string {
+	if x, ok := m.GetUnion().(*MsgWithOneof_HomeAddress); ok {
+		return x.HomeAddress
+	}
+	return ""
+}
+
+func (m *MsgWithOneof) GetMsgWithRequired() *MsgWithRequired {
+	if x, ok := m.GetUnion().(*MsgWithOneof_MsgWithRequired); ok {
+		return x.MsgWithRequired
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*MsgWithOneof) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _MsgWithOneof_OneofMarshaler, _MsgWithOneof_OneofUnmarshaler, _MsgWithOneof_OneofSizer, []interface{}{
+		(*MsgWithOneof_Title)(nil),
+		(*MsgWithOneof_Salary)(nil),
+		(*MsgWithOneof_Country)(nil),
+		(*MsgWithOneof_HomeAddress)(nil),
+		(*MsgWithOneof_MsgWithRequired)(nil),
+	}
+}
+
+func _MsgWithOneof_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*MsgWithOneof)
+	// union
+	switch x := m.Union.(type) {
+	case *MsgWithOneof_Title:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Title)
+	case *MsgWithOneof_Salary:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Salary))
+	case *MsgWithOneof_Country:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Country)
+	case *MsgWithOneof_HomeAddress:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.HomeAddress)
+	case *MsgWithOneof_MsgWithRequired:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgWithRequired); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("MsgWithOneof.Union has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _MsgWithOneof_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*MsgWithOneof)
+	switch tag {
+	case 1: // union.title
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Union = &MsgWithOneof_Title{x}
+		return true, err
+	case 2: // union.salary
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Union = &MsgWithOneof_Salary{int64(x)}
+		return true, err
+	case 3: // union.Country
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Union = &MsgWithOneof_Country{x}
+		return true, err
+	case 4: // union.home_address
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Union = &MsgWithOneof_HomeAddress{x}
+		return true, err
+	case 5: // union.msg_with_required
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MsgWithRequired)
+		err := b.DecodeMessage(msg)
+		m.Union = &MsgWithOneof_MsgWithRequired{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _MsgWithOneof_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*MsgWithOneof)
+	// union
+	switch x := m.Union.(type) {
+	case *MsgWithOneof_Title:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Title)))
+		n += len(x.Title)
+	case *MsgWithOneof_Salary:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Salary))
+	case *MsgWithOneof_Country:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Country)))
+		n += len(x.Country)
+	case *MsgWithOneof_HomeAddress:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.HomeAddress)))
+		n += len(x.HomeAddress)
+	case *MsgWithOneof_MsgWithRequired:
+		s := proto.Size(x.MsgWithRequired)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Real struct {
+	Value                        *float64 `protobuf:"fixed64,1,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *Real) Reset()         { *m = Real{} }
+func (m *Real) String() string { return proto.CompactTextString(m) }
+func (*Real) ProtoMessage()    {}
+func (*Real) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{6}
+}
+
+var extRange_Real = []proto.ExtensionRange{
+	{Start: 100, End: 536870911},
+}
+
+func (*Real) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Real
+}
+func (m *Real) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Real.Unmarshal(m, b)
+}
+func (m *Real) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Real.Marshal(b, m, deterministic)
+}
+func (dst *Real) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Real.Merge(dst, src)
+}
+func (m *Real) XXX_Size() int {
+	return xxx_messageInfo_Real.Size(m)
+}
+func (m *Real) XXX_DiscardUnknown() {
+	xxx_messageInfo_Real.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Real proto.InternalMessageInfo
+
+func (m *Real) GetValue() float64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+type Complex struct {
+	Imaginary                    *float64 `protobuf:"fixed64,1,opt,name=imaginary" json:"imaginary,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *Complex) Reset()         { *m = Complex{} }
+func (m *Complex) String() string { return proto.CompactTextString(m) }
+func (*Complex) ProtoMessage()    {}
+func (*Complex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{7}
+}
+
+var extRange_Complex = []proto.ExtensionRange{
+	{Start: 100, End: 536870911},
+}
+
+func (*Complex) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Complex
+}
+func (m *Complex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Complex.Unmarshal(m, b)
+}
+func (m *Complex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Complex.Marshal(b, m, deterministic)
+}
+func (dst *Complex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Complex.Merge(dst, src)
+}
+func (m *Complex) XXX_Size() int {
+	return xxx_messageInfo_Complex.Size(m)
+}
+func (m *Complex) XXX_DiscardUnknown() {
+	xxx_messageInfo_Complex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Complex proto.InternalMessageInfo
+
+func (m *Complex) GetImaginary() float64 {
+	if m != nil && m.Imaginary != nil {
+		return *m.Imaginary
+	}
+	return 0
+}
+
+var E_Complex_RealExtension = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*Complex)(nil),
+	Field:         123,
+	Name:          "jsonpb_generated.Complex.real_extension",
+	Tag:           "bytes,123,opt,name=real_extension,json=realExtension",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+type KnownTypes struct {
+	An                   *any.Any              `protobuf:"bytes,14,opt,name=an" json:"an,omitempty"`
+	Dur                  *duration.Duration    `protobuf:"bytes,1,opt,name=dur" json:"dur,omitempty"`
+	St                   *_struct.Struct       `protobuf:"bytes,12,opt,name=st" json:"st,omitempty"`
+	Ts                   *timestamp.Timestamp  `protobuf:"bytes,2,opt,name=ts" json:"ts,omitempty"`
+	Lv                   *_struct.ListValue    `protobuf:"bytes,15,opt,name=lv" json:"lv,omitempty"`
+	Val                  *_struct.Value        `protobuf:"bytes,16,opt,name=val" json:"val,omitempty"`
+	Dbl                  *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=dbl" json:"dbl,omitempty"`
+	Flt                  *wrappers.FloatValue  `protobuf:"bytes,4,opt,name=flt" json:"flt,omitempty"`
+	I64                  *wrappers.Int64Value  `protobuf:"bytes,5,opt,name=i64" json:"i64,omitempty"`
+	U64                  *wrappers.UInt64Value `protobuf:"bytes,6,opt,name=u64" json:"u64,omitempty"`
+	I32                  *wrappers.Int32Value  `protobuf:"bytes,7,opt,name=i32" json:"i32,omitempty"`
+	U32                  *wrappers.UInt32Value `protobuf:"bytes,8,opt,name=u32" json:"u32,omitempty"`
+	Bool                 *wrappers.BoolValue   `protobuf:"bytes,9,opt,name=bool" json:"bool,omitempty"`
+	Str                  *wrappers.StringValue `protobuf:"bytes,10,opt,name=str" json:"str,omitempty"`
+	Bytes                *wrappers.BytesValue  `protobuf:"bytes,11,opt,name=bytes" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *KnownTypes) Reset()         { *m = KnownTypes{} }
+func (m *KnownTypes) String() string { return proto.CompactTextString(m) }
+func (*KnownTypes) ProtoMessage()    {}
+func (*KnownTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{8}
+}
+func (m *KnownTypes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KnownTypes.Unmarshal(m, b)
+}
+func (m *KnownTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KnownTypes.Marshal(b, m, deterministic)
+}
+func (dst *KnownTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KnownTypes.Merge(dst, src)
+}
+func (m *KnownTypes) XXX_Size() int {
+	return xxx_messageInfo_KnownTypes.Size(m)
+}
+func (m *KnownTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_KnownTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KnownTypes proto.InternalMessageInfo
+
+func (m *KnownTypes) GetAn() *any.Any {
+	if m != nil {
+		return m.An
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetDur() *duration.Duration {
+	if m != nil {
+		return m.Dur
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetSt() *_struct.Struct {
+	if m != nil {
+		return m.St
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetLv() *_struct.ListValue {
+	if m != nil {
+		return m.Lv
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetVal() *_struct.Value {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetDbl() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Dbl
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetFlt() *wrappers.FloatValue {
+	if m != nil {
+		return m.Flt
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetI64() *wrappers.Int64Value {
+	if m != nil {
+		return m.I64
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetU64() *wrappers.UInt64Value {
+	if m != nil {
+		return m.U64
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetI32() *wrappers.Int32Value {
+	if m != nil {
+		return m.I32
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetU32() *wrappers.UInt32Value {
+	if m != nil {
+		return m.U32
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetBool() *wrappers.BoolValue {
+	if m != nil {
+		return m.Bool
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetStr() *wrappers.StringValue {
+	if m != nil {
+		return m.Str
+	}
+	return nil
+}
+
+func (m *KnownTypes) GetBytes() *wrappers.BytesValue {
+	if m != nil {
+		return m.Bytes
+	}
+	return nil
+}
+
+// Test messages for marshaling/unmarshaling required fields.
+type MsgWithRequired struct {
+	Str                  *string  `protobuf:"bytes,1,req,name=str" json:"str,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgWithRequired) Reset()         { *m = MsgWithRequired{} }
+func (m *MsgWithRequired) String() string { return proto.CompactTextString(m) }
+func (*MsgWithRequired) ProtoMessage()    {}
+func (*MsgWithRequired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{9}
+}
+func (m *MsgWithRequired) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithRequired.Unmarshal(m, b)
+}
+func (m *MsgWithRequired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithRequired.Marshal(b, m, deterministic)
+}
+func (dst *MsgWithRequired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithRequired.Merge(dst, src)
+}
+func (m *MsgWithRequired) XXX_Size() int {
+	return xxx_messageInfo_MsgWithRequired.Size(m)
+}
+func (m *MsgWithRequired) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithRequired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithRequired proto.InternalMessageInfo
+
+func (m *MsgWithRequired) GetStr() string {
+	if m != nil && m.Str != nil {
+		return *m.Str
+	}
+	return ""
+}
+
+type MsgWithIndirectRequired struct {
+	Subm                 *MsgWithRequired            `protobuf:"bytes,1,opt,name=subm" json:"subm,omitempty"`
+	MapField             map[string]*MsgWithRequired `protobuf:"bytes,2,rep,name=map_field,json=mapField" json:"map_field,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SliceField           []*MsgWithRequired          `protobuf:"bytes,3,rep,name=slice_field,json=sliceField" json:"slice_field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *MsgWithIndirectRequired) Reset()         { *m = MsgWithIndirectRequired{} }
+func (m *MsgWithIndirectRequired) String() string { return proto.CompactTextString(m) }
+func (*MsgWithIndirectRequired) ProtoMessage()    {}
+func (*MsgWithIndirectRequired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{10}
+}
+func (m *MsgWithIndirectRequired) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithIndirectRequired.Unmarshal(m, b)
+}
+func (m *MsgWithIndirectRequired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithIndirectRequired.Marshal(b, m, deterministic)
+}
+func (dst *MsgWithIndirectRequired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithIndirectRequired.Merge(dst, src)
+}
+func (m *MsgWithIndirectRequired) XXX_Size() int {
+	return xxx_messageInfo_MsgWithIndirectRequired.Size(m)
+}
+func (m *MsgWithIndirectRequired) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithIndirectRequired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithIndirectRequired proto.InternalMessageInfo
+
+func (m *MsgWithIndirectRequired) GetSubm() *MsgWithRequired {
+	if m != nil {
+		return m.Subm
+	}
+	return nil
+}
+
+func (m *MsgWithIndirectRequired) GetMapField() map[string]*MsgWithRequired {
+	if m != nil {
+		return m.MapField
+	}
+	return nil
+}
+
+func (m *MsgWithIndirectRequired) GetSliceField() []*MsgWithRequired {
+	if m != nil {
+		return m.SliceField
+	}
+	return nil
+}
+
+type MsgWithRequiredBytes struct {
+	Byts                 []byte   `protobuf:"bytes,1,req,name=byts" json:"byts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgWithRequiredBytes) Reset()         { *m = MsgWithRequiredBytes{} }
+func (m *MsgWithRequiredBytes) String() string { return proto.CompactTextString(m) }
+func (*MsgWithRequiredBytes) ProtoMessage()    {}
+func (*MsgWithRequiredBytes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{11}
+}
+func (m *MsgWithRequiredBytes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithRequiredBytes.Unmarshal(m, b)
+}
+func (m *MsgWithRequiredBytes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithRequiredBytes.Marshal(b, m, deterministic)
+}
+func (dst *MsgWithRequiredBytes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithRequiredBytes.Merge(dst, src)
+}
+func (m *MsgWithRequiredBytes) XXX_Size() int {
+	return xxx_messageInfo_MsgWithRequiredBytes.Size(m)
+}
+func (m *MsgWithRequiredBytes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithRequiredBytes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithRequiredBytes proto.InternalMessageInfo
+
+func (m *MsgWithRequiredBytes) GetByts() []byte {
+	if m != nil {
+		return m.Byts
+	}
+	return nil
+}
+
+type MsgWithRequiredWKT struct {
+	Str                  *wrappers.StringValue `protobuf:"bytes,1,req,name=str" json:"str,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MsgWithRequiredWKT) Reset()         { *m = MsgWithRequiredWKT{} }
+func (m *MsgWithRequiredWKT) String() string { return proto.CompactTextString(m) }
+func (*MsgWithRequiredWKT) ProtoMessage()    {}
+func (*MsgWithRequiredWKT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{12}
+}
+func (m *MsgWithRequiredWKT) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithRequiredWKT.Unmarshal(m, b)
+}
+func (m *MsgWithRequiredWKT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithRequiredWKT.Marshal(b, m, deterministic)
+}
+func (dst *MsgWithRequiredWKT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithRequiredWKT.Merge(dst, src)
+}
+func (m *MsgWithRequiredWKT) XXX_Size() int {
+	return xxx_messageInfo_MsgWithRequiredWKT.Size(m)
+}
+func (m *MsgWithRequiredWKT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithRequiredWKT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithRequiredWKT proto.InternalMessageInfo
+
+func (m *MsgWithRequiredWKT) GetStr() *wrappers.StringValue {
+	if m != nil {
+		return m.Str
+	}
+	return nil
+}
+
+// A MessageSet, whose items are written with the names of their messages.
+type MsgSet struct {
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `protobuf_messageset:"1" json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *MsgSet) Reset()         { *m = MsgSet{} }
+func (m *MsgSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSet) ProtoMessage()    {}
+func (*MsgSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{13}
+}
+
+func (m *MsgSet) MarshalJSON() ([]byte, error) {
+	return proto.MarshalMessageSetJSON(&m.XXX_InternalExtensions)
+}
+func (m *MsgSet) UnmarshalJSON(buf []byte) error {
+	return proto.UnmarshalMessageSetJSON(buf, &m.XXX_InternalExtensions)
+}
+
+var extRange_MsgSet = []proto.ExtensionRange{
+	{Start: 100, End: 536870911},
+}
+
+func (*MsgSet) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_MsgSet
+}
+func (m *MsgSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSet.Unmarshal(m, b)
+}
+func (m *MsgSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSet.Marshal(b, m, deterministic)
+}
+func (dst *MsgSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSet.Merge(dst, src)
+}
+func (m *MsgSet) XXX_Size() int {
+	return xxx_messageInfo_MsgSet.Size(m)
+}
+func (m *MsgSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSet proto.InternalMessageInfo
+
+type MsgSetItem struct {
+	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetItem) Reset()         { *m = MsgSetItem{} }
+func (m *MsgSetItem) String() string { return proto.CompactTextString(m) }
+func (*MsgSetItem) ProtoMessage()    {}
+func (*MsgSetItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{14}
+}
+func (m *MsgSetItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetItem.Unmarshal(m, b)
+}
+func (m *MsgSetItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetItem.Marshal(b, m, deterministic)
+}
+func (dst *MsgSetItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetItem.Merge(dst, src)
+}
+func (m *MsgSetItem) XXX_Size() int {
+	return xxx_messageInfo_MsgSetItem.Size(m)
+}
+func (m *MsgSetItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetItem proto.InternalMessageInfo
+
+func (m *MsgSetItem) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_MsgSetItem_MessageSetExtension = &proto.ExtensionDesc{
+	ExtendedType:  (*MsgSet)(nil),
+	ExtensionType: (*MsgSetItem)(nil),
+	Field:         100,
+	Name:          "jsonpb_generated.MsgSetItem",
+	Tag:           "bytes,100,opt,name=message_set_extension,json=messageSetExtension",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+// Groups, and extended messages in every position.
+type Extended struct {
+	Real    *Real            `protobuf:"bytes,1,opt,name=real" json:"real,omitempty"`
+	Reals   []*Real          `protobuf:"bytes,2,rep,name=reals" json:"reals,omitempty"`
+	RealMap map[string]*Real `protobuf:"bytes,3,rep,name=real_map,json=realMap" json:"real_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//	*Extended_RealChoice
+	//	*Extended_Choicegroup
+	Choice               isExtended_Choice         `protobuf_oneof:"choice"`
+	Optionalgroup        *Extended_OptionalGroup   `protobuf:"group,7,opt,name=OptionalGroup,json=optionalgroup" json:"optionalgroup,omitempty"`
+	Repeatedgroup        []*Extended_RepeatedGroup `protobuf:"group,10,rep,name=RepeatedGroup,json=repeatedgroup" json:"repeatedgroup,omitempty"`
+	MsgSet               *MsgSet                   `protobuf:"bytes,12,opt,name=msg_set,json=msgSet" json:"msg_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *Extended) Reset()         { *m = Extended{} }
+func (m *Extended) String() string { return proto.CompactTextString(m) }
+func (*Extended) ProtoMessage()    {}
+func (*Extended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{15}
+}
+func (m *Extended) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended.Unmarshal(m, b)
+}
+func (m *Extended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended.Marshal(b, m, deterministic)
+}
+func (dst *Extended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended.Merge(dst, src)
+}
+func (m *Extended) XXX_Size() int {
+	return xxx_messageInfo_Extended.Size(m)
+}
+func (m *Extended) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended proto.InternalMessageInfo
+
+type isExtended_Choice interface {
+	isExtended_Choice()
+}
+
+type Extended_RealChoice struct {
+	RealChoice *Real `protobuf:"bytes,4,opt,name=real_choice,json=realChoice,oneof"`
+}
+type Extended_Choicegroup struct {
+	Choicegroup *Extended_ChoiceGroup `protobuf:"group,5,opt,name=ChoiceGroup,json=choicegroup,oneof"`
+}
+
+func (*Extended_RealChoice) isExtended_Choice()  {}
+func (*Extended_Choicegroup) isExtended_Choice() {}
+
+func (m *Extended) GetChoice() isExtended_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Extended) GetReal() *Real {
+	if m != nil {
+		return m.Real
+	}
+	return nil
+}
+
+func (m *Extended) GetReals() []*Real {
+	if m != nil {
+		return m.Reals
+	}
+	return nil
+}
+
+func (m *Extended) GetRealMap() map[string]*Real {
+	if m != nil {
+		return m.RealMap
+	}
+	return nil
+}
+
+func (m *Extended) GetRealChoice() *Real {
+	if x, ok := m.GetChoice().(*Extended_RealChoice); ok {
+		return x.RealChoice
+	}
+	return nil
+}
+
+func (m *Extended) GetChoicegroup() *Extended_ChoiceGroup {
+	if x, ok := m.GetChoice().(*Extended_Choicegroup); ok {
+		return x.Choicegroup
+	}
+	return nil
+}
+
+func (m *Extended) GetOptionalgroup() *Extended_OptionalGroup {
+	if m != nil {
+		return m.Optionalgroup
+	}
+	return nil
+}
+
+func (m *Extended) GetRepeatedgroup() []*Extended_RepeatedGroup {
+	if m != nil {
+		return m.Repeatedgroup
+	}
+	return nil
+}
+
+func (m *Extended) GetMsgSet() *MsgSet {
+	if m != nil {
+		return m.MsgSet
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Extended) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Extended_OneofMarshaler, _Extended_OneofUnmarshaler, _Extended_OneofSizer, []interface{}{
+		(*Extended_RealChoice)(nil),
+		(*Extended_Choicegroup)(nil),
+	}
+}
+
+func _Extended_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Extended)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Extended_RealChoice:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RealChoice); err != nil {
+			return err
+		}
+	case *Extended_Choicegroup:
+		b.EncodeVarint(5<<3 | proto.WireStartGroup)
+		if err := b.Marshal(x.Choicegroup); err != nil {
+			return err
+		}
+		b.EncodeVarint(5<<3 | proto.WireEndGroup)
+	case nil:
+	default:
+		return fmt.Errorf("Extended.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Extended_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Extended)
+	switch tag {
+	case 4: // choice.real_choice
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Real)
+		err := b.DecodeMessage(msg)
+		m.Choice = &Extended_RealChoice{msg}
+		return true, err
+	case 5: // choice.choicegroup
+		if wire != proto.WireStartGroup {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Extended_ChoiceGroup)
+		err := b.DecodeGroup(msg)
+		m.Choice = &Extended_Choicegroup{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Extended_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Extended)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Extended_RealChoice:
+		s := proto.Size(x.RealChoice)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Extended_Choicegroup:
+		n += 1 // tag and wire
+		n += proto.Size(x.Choicegroup)
+		n += 1 // tag and wire
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Extended_ChoiceGroup struct {
+	Real                 *Real    `protobuf:"bytes,6,opt,name=real" json:"real,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Extended_ChoiceGroup) Reset()         { *m = Extended_ChoiceGroup{} }
+func (m *Extended_ChoiceGroup) String() string { return proto.CompactTextString(m) }
+func (*Extended_ChoiceGroup) ProtoMessage()    {}
+func (*Extended_ChoiceGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{15, 1}
+}
+func (m *Extended_ChoiceGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended_ChoiceGroup.Unmarshal(m, b)
+}
+func (m *Extended_ChoiceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended_ChoiceGroup.Marshal(b, m, deterministic)
+}
+func (dst *Extended_ChoiceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended_ChoiceGroup.Merge(dst, src)
+}
+func (m *Extended_ChoiceGroup) XXX_Size() int {
+	return xxx_messageInfo_Extended_ChoiceGroup.Size(m)
+}
+func (m *Extended_ChoiceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended_ChoiceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended_ChoiceGroup proto.InternalMessageInfo
+
+func (m *Extended_ChoiceGroup) GetReal() *Real {
+	if m != nil {
+		return m.Real
+	}
+	return nil
+}
+
+type Extended_OptionalGroup struct {
+	Real                 *Real    `protobuf:"bytes,8,opt,name=real" json:"real,omitempty"`
+	Tags                 []string `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Extended_OptionalGroup) Reset()         { *m = Extended_OptionalGroup{} }
+func (m *Extended_OptionalGroup) String() string { return proto.CompactTextString(m) }
+func (*Extended_OptionalGroup) ProtoMessage()    {}
+func (*Extended_OptionalGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{15, 2}
+}
+func (m *Extended_OptionalGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended_OptionalGroup.Unmarshal(m, b)
+}
+func (m *Extended_OptionalGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended_OptionalGroup.Marshal(b, m, deterministic)
+}
+func (dst *Extended_OptionalGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended_OptionalGroup.Merge(dst, src)
+}
+func (m *Extended_OptionalGroup) XXX_Size() int {
+	return xxx_messageInfo_Extended_OptionalGroup.Size(m)
+}
+func (m *Extended_OptionalGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended_OptionalGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended_OptionalGroup proto.InternalMessageInfo
+
+func (m *Extended_OptionalGroup) GetReal() *Real {
+	if m != nil {
+		return m.Real
+	}
+	return nil
+}
+
+func (m *Extended_OptionalGroup) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type Extended_RepeatedGroup struct {
+	N                    *int32   `protobuf:"varint,11,opt,name=n" json:"n,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Extended_RepeatedGroup) Reset()         { *m = Extended_RepeatedGroup{} }
+func (m *Extended_RepeatedGroup) String() string { return proto.CompactTextString(m) }
+func (*Extended_RepeatedGroup) ProtoMessage()    {}
+func (*Extended_RepeatedGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{15, 3}
+}
+func (m *Extended_RepeatedGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended_RepeatedGroup.Unmarshal(m, b)
+}
+func (m *Extended_RepeatedGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended_RepeatedGroup.Marshal(b, m, deterministic)
+}
+func (dst *Extended_RepeatedGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended_RepeatedGroup.Merge(dst, src)
+}
+func (m *Extended_RepeatedGroup) XXX_Size() int {
+	return xxx_messageInfo_Extended_RepeatedGroup.Size(m)
+}
+func (m *Extended_RepeatedGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended_RepeatedGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended_RepeatedGroup proto.InternalMessageInfo
+
+func (m *Extended_RepeatedGroup) GetN() int32 {
+	if m != nil && m.N != nil {
+		return *m.N
+	}
+	return 0
+}
+
+type ExtGroup struct {
+	Label                *string  `protobuf:"bytes,130,opt,name=label" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtGroup) Reset()         { *m = ExtGroup{} }
+func (m *ExtGroup) String() string { return proto.CompactTextString(m) }
+func (*ExtGroup) ProtoMessage()    {}
+func (*ExtGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_df9d69967335e263, []int{16}
+}
+func (m *ExtGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtGroup.Unmarshal(m, b)
+}
+func (m *ExtGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtGroup.Marshal(b, m, deterministic)
+}
+func (dst *ExtGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtGroup.Merge(dst, src)
+}
+func (m *ExtGroup) XXX_Size() int {
+	return xxx_messageInfo_ExtGroup.Size(m)
+}
+func (m *ExtGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtGroup proto.InternalMessageInfo
+
+func (m *ExtGroup) GetLabel() string {
+	if m != nil && m.Label != nil {
+		return *m.Label
+	}
+	return ""
+}
+
+var E_Name = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         124,
+	Name:          "jsonpb_generated.name",
+	Tag:           "bytes,124,opt,name=name",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+var E_Extm = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*MsgWithRequired)(nil),
+	Field:         125,
+	Name:          "jsonpb_generated.extm",
+	Tag:           "bytes,125,opt,name=extm",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+var E_RealItem = &proto.ExtensionDesc{
+	ExtendedType:  (*MsgSet)(nil),
+	ExtensionType: (*Real)(nil),
+	Field:         101,
+	Name:          "jsonpb_generated.real_item",
+	Tag:           "bytes,101,opt,name=real_item,json=realItem",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+var E_Reals = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: ([]*Real)(nil),
+	Field:         126,
+	Name:          "jsonpb_generated.reals",
+	Tag:           "bytes,126,rep,name=reals",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+var E_Color = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*Widget_Color)(nil),
+	Field:         127,
+	Name:          "jsonpb_generated.color",
+	Tag:           "varint,127,opt,name=color,enum=jsonpb_generated.Widget_Color",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+var E_Counts = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: ([]int64)(nil),
+	Field:         128,
+	Name:          "jsonpb_generated.counts",
+	Tag:           "varint,128,rep,name=counts",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+var E_Extgroup = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*ExtGroup)(nil),
+	Field:         129,
+	Name:          "jsonpb_generated.extgroup",
+	Tag:           "group,129,opt,name=ExtGroup,json=extgroup",
+	Filename:      "jsonpb_generated/test_objects.proto",
+}
+
+func init() {
+	proto.RegisterType((*Simple)(nil), "jsonpb_generated.Simple")
+	proto.RegisterType((*NonFinites)(nil), "jsonpb_generated.NonFinites")
+	proto.RegisterType((*Repeats)(nil), "jsonpb_generated.Repeats")
+	proto.RegisterType((*Widget)(nil), "jsonpb_generated.Widget")
+	proto.RegisterType((*Maps)(nil), "jsonpb_generated.Maps")
+	proto.RegisterMapType((map[bool]*Simple)(nil), "jsonpb_generated.Maps.MBoolSimpleEntry")
+	proto.RegisterMapType((map[int64]string)(nil), "jsonpb_generated.Maps.MInt64StrEntry")
+	proto.RegisterType((*MsgWithOneof)(nil), "jsonpb_generated.MsgWithOneof")
+	proto.RegisterType((*Real)(nil), "jsonpb_generated.Real")
+	proto.RegisterType((*Complex)(nil), "jsonpb_generated.Complex")
+	proto.RegisterType((*KnownTypes)(nil), "jsonpb_generated.KnownTypes")
+	proto.RegisterType((*MsgWithRequired)(nil), "jsonpb_generated.MsgWithRequired")
+	proto.RegisterType((*MsgWithIndirectRequired)(nil), "jsonpb_generated.MsgWithIndirectRequired")
+	proto.RegisterMapType((map[string]*MsgWithRequired)(nil), "jsonpb_generated.MsgWithIndirectRequired.MapFieldEntry")
+	proto.RegisterType((*MsgWithRequiredBytes)(nil), "jsonpb_generated.MsgWithRequiredBytes")
+	proto.RegisterType((*MsgWithRequiredWKT)(nil), "jsonpb_generated.MsgWithRequiredWKT")
+	proto.RegisterType((*MsgSet)(nil), "jsonpb_generated.MsgSet")
+	proto.RegisterMessageSetType((*MsgSetItem)(nil), 100, "jsonpb_generated.MsgSetItem")
+	proto.RegisterType((*MsgSetItem)(nil), "jsonpb_generated.MsgSetItem")
+	proto.RegisterType((*Extended)(nil), "jsonpb_generated.Extended")
+	proto.RegisterMapType((map[string]*Real)(nil), "jsonpb_generated.Extended.RealMapEntry")
+	proto.RegisterType((*Extended_ChoiceGroup)(nil), "jsonpb_generated.Extended.ChoiceGroup")
+	proto.RegisterType((*Extended_OptionalGroup)(nil), "jsonpb_generated.Extended.OptionalGroup")
+	proto.RegisterType((*Extended_RepeatedGroup)(nil), "jsonpb_generated.Extended.RepeatedGroup")
+	proto.RegisterType((*ExtGroup)(nil), "jsonpb_generated.ExtGroup")
+	proto.RegisterEnum("jsonpb_generated.Widget_Color", Widget_Color_name, Widget_Color_value)
+	proto.RegisterExtension(E_Complex_RealExtension)
+	proto.RegisterExtension(E_MsgSetItem_MessageSetExtension)
+	proto.RegisterExtension(E_Name)
+	proto.RegisterExtension(E_Extm)
+	proto.RegisterExtension(E_RealItem)
+	proto.RegisterExtension(E_Reals)
+	proto.RegisterExtension(E_Color)
+	proto.RegisterExtension(E_Counts)
+	proto.RegisterExtension(E_Extgroup)
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Simple) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("o_bool", "oBool", m.OBool == nil) {
+		if m.OBool == nil {
+			e.Null()
+		} else {
+			e.Bool(*m.OBool)
+		}
+	}
+	if e.Field("o_int32", "oInt32", m.OInt32 == nil) {
+		if m.OInt32 == nil {
+			e.Null()
+		} else {
+			e.Int32(*m.OInt32)
+		}
+	}
+	if e.Field("o_int32_str", "oInt32Str", m.OInt32Str == nil) {
+		if m.OInt32Str == nil {
+			e.Null()
+		} else {
+			e.Int32(*m.OInt32Str)
+		}
+	}
+	if e.Field("o_int64", "oInt64", m.OInt64 == nil) {
+		if m.OInt64 == nil {
+			e.Null()
+		} else {
+			e.Int64(*m.OInt64)
+		}
+	}
+	if e.Field("o_int64_str", "oInt64Str", m.OInt64Str == nil) {
+		if m.OInt64Str == nil {
+			e.Null()
+		} else {
+			e.Int64(*m.OInt64Str)
+		}
+	}
+	if e.Field("o_uint32", "oUint32", m.OUint32 == nil) {
+		if m.OUint32 == nil {
+			e.Null()
+		} else {
+			e.Uint32(*m.OUint32)
+		}
+	}
+	if e.Field("o_uint32_str", "oUint32Str", m.OUint32Str == nil) {
+		if m.OUint32Str == nil {
+			e.Null()
+		} else {
+			e.Uint32(*m.OUint32Str)
+		}
+	}
+	if e.Field("o_uint64", "oUint64", m.OUint64 == nil) {
+		if m.OUint64 == nil {
+			e.Null()
+		} else {
+			e.Uint64(*m.OUint64)
+		}
+	}
+	if e.Field("o_uint64_str", "oUint64Str", m.OUint64Str == nil) {
+		if m.OUint64Str == nil {
+			e.Null()
+		} else {
+			e.Uint64(*m.OUint64Str)
+		}
+	}
+	if e.Field("o_sint32", "oSint32", m.OSint32 == nil) {
+		if m.OSint32 == nil {
+			e.Null()
+		} else {
+			e.Int32(*m.OSint32)
+		}
+	}
+	if e.Field("o_sint32_str", "oSint32Str", m.OSint32Str == nil) {
+		if m.OSint32Str == nil {
+			e.Null()
+		} else {
+			e.Int32(*m.OSint32Str)
+		}
+	}
+	if e.Field("o_sint64", "oSint64", m.OSint64 == nil) {
+		if m.OSint64 == nil {
+			e.Null()
+		} else {
+			e.Int64(*m.OSint64)
+		}
+	}
+	if e.Field("o_sint64_str", "oSint64Str", m.OSint64Str == nil) {
+		if m.OSint64Str == nil {
+			e.Null()
+		} else {
+			e.Int64(*m.OSint64Str)
+		}
+	}
+	if e.Field("o_float", "oFloat", m.OFloat == nil) {
+		if m.OFloat == nil {
+			e.Null()
+		} else {
+			e.Float32(*m.OFloat)
+		}
+	}
+	if e.Field("o_float_str", "oFloatStr", m.OFloatStr == nil) {
+		if m.OFloatStr == nil {
+			e.Null()
+		} else {
+			e.Float32(*m.OFloatStr)
+		}
+	}
+	if e.Field("o_double", "oDouble", m.ODouble == nil) {
+		if m.ODouble == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.ODouble)
+		}
+	}
+	if e.Field("o_double_str", "oDoubleStr", m.ODoubleStr == nil) {
+		if m.ODoubleStr == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.ODoubleStr)
+		}
+	}
+	if e.Field("o_string", "oString", m.OString == nil) {
+		if m.OString == nil {
+			e.Null()
+		} else {
+			e.String(*m.OString)
+		}
+	}
+	if e.Field("o_bytes", "oBytes", m.OBytes == nil) {
+		e.Bytes(m.OBytes)
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Simple) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("o_bool", "oBool"); ok {
+		if !d.Null(raw) {
+			m.OBool = new(bool)
+			if err := d.Bool(raw, m.OBool); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_int32", "oInt32"); ok {
+		if !d.Null(raw) {
+			m.OInt32 = new(int32)
+			if err := d.Int32(raw, m.OInt32); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_int32_str", "oInt32Str"); ok {
+		if !d.Null(raw) {
+			m.OInt32Str = new(int32)
+			if err := d.Int32(raw, m.OInt32Str); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_int64", "oInt64"); ok {
+		if !d.Null(raw) {
+			m.OInt64 = new(int64)
+			if err := d.Int64(raw, m.OInt64); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_int64_str", "oInt64Str"); ok {
+		if !d.Null(raw) {
+			m.OInt64Str = new(int64)
+			if err := d.Int64(raw, m.OInt64Str); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_uint32", "oUint32"); ok {
+		if !d.Null(raw) {
+			m.OUint32 = new(uint32)
+			if err := d.Uint32(raw, m.OUint32); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_uint32_str", "oUint32Str"); ok {
+		if !d.Null(raw) {
+			m.OUint32Str = new(uint32)
+			if err := d.Uint32(raw, m.OUint32Str); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_uint64", "oUint64"); ok {
+		if !d.Null(raw) {
+			m.OUint64 = new(uint64)
+			if err := d.Uint64(raw, m.OUint64); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_uint64_str", "oUint64Str"); ok {
+		if !d.Null(raw) {
+			m.OUint64Str = new(uint64)
+			if err := d.Uint64(raw, m.OUint64Str); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_sint32", "oSint32"); ok {
+		if !d.Null(raw) {
+			m.OSint32 = new(int32)
+			if err := d.Int32(raw, m.OSint32); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_sint32_str", "oSint32Str"); ok {
+		if !d.Null(raw) {
+			m.OSint32Str = new(int32)
+			if err := d.Int32(raw, m.OSint32Str); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_sint64", "oSint64"); ok {
+		if !d.Null(raw) {
+			m.OSint64 = new(int64)
+			if err := d.Int64(raw, m.OSint64); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_sint64_str", "oSint64Str"); ok {
+		if !d.Null(raw) {
+			m.OSint64Str = new(int64)
+			if err := d.Int64(raw, m.OSint64Str); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_float", "oFloat"); ok {
+		if !d.Null(raw) {
+			m.OFloat = new(float32)
+			if err := d.Float32(raw, m.OFloat); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_float_str", "oFloatStr"); ok {
+		if !d.Null(raw) {
+			m.OFloatStr = new(float32)
+			if err := d.Float32(raw, m.OFloatStr); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_double", "oDouble"); ok {
+		if !d.Null(raw) {
+			m.ODouble = new(float64)
+			if err := d.Float64(raw, m.ODouble); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_double_str", "oDoubleStr"); ok {
+		if !d.Null(raw) {
+			m.ODoubleStr = new(float64)
+			if err := d.Float64(raw, m.ODoubleStr); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_string", "oString"); ok {
+		if !d.Null(raw) {
+			m.OString = new(string)
+			if err := d.String(raw, m.OString); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("o_bytes", "oBytes"); ok {
+		if err := d.Bytes(raw, &m.OBytes); err != nil {
+			return err
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *NonFinites) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("f_nan", "fNan", m.FNan == nil) {
+		if m.FNan == nil {
+			e.Null()
+		} else {
+			e.Float32(*m.FNan)
+		}
+	}
+	if e.Field("f_pinf", "fPinf", m.FPinf == nil) {
+		if m.FPinf == nil {
+			e.Null()
+		} else {
+			e.Float32(*m.FPinf)
+		}
+	}
+	if e.Field("f_ninf", "fNinf", m.FNinf == nil) {
+		if m.FNinf == nil {
+			e.Null()
+		} else {
+			e.Float32(*m.FNinf)
+		}
+	}
+	if e.Field("d_nan", "dNan", m.DNan == nil) {
+		if m.DNan == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.DNan)
+		}
+	}
+	if e.Field("d_pinf", "dPinf", m.DPinf == nil) {
+		if m.DPinf == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.DPinf)
+		}
+	}
+	if e.Field("d_ninf", "dNinf", m.DNinf == nil) {
+		if m.DNinf == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.DNinf)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *NonFinites) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("f_nan", "fNan"); ok {
+		if !d.Null(raw) {
+			m.FNan = new(float32)
+			if err := d.Float32(raw, m.FNan); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("f_pinf", "fPinf"); ok {
+		if !d.Null(raw) {
+			m.FPinf = new(float32)
+			if err := d.Float32(raw, m.FPinf); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("f_ninf", "fNinf"); ok {
+		if !d.Null(raw) {
+			m.FNinf = new(float32)
+			if err := d.Float32(raw, m.FNinf); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("d_nan", "dNan"); ok {
+		if !d.Null(raw) {
+			m.DNan = new(float64)
+			if err := d.Float64(raw, m.DNan); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("d_pinf", "dPinf"); ok {
+		if !d.Null(raw) {
+			m.DPinf = new(float64)
+			if err := d.Float64(raw, m.DPinf); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("d_ninf", "dNinf"); ok {
+		if !d.Null(raw) {
+			m.DNinf = new(float64)
+			if err := d.Float64(raw, m.DNinf); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Repeats) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("r_bool", "rBool", m.RBool == nil) {
+		e.BeginList()
+		for _, x := range m.RBool {
+			e.Elem()
+			e.Bool(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_int32", "rInt32", m.RInt32 == nil) {
+		e.BeginList()
+		for _, x := range m.RInt32 {
+			e.Elem()
+			e.Int32(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_int64", "rInt64", m.RInt64 == nil) {
+		e.BeginList()
+		for _, x := range m.RInt64 {
+			e.Elem()
+			e.Int64(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_uint32", "rUint32", m.RUint32 == nil) {
+		e.BeginList()
+		for _, x := range m.RUint32 {
+			e.Elem()
+			e.Uint32(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_uint64", "rUint64", m.RUint64 == nil) {
+		e.BeginList()
+		for _, x := range m.RUint64 {
+			e.Elem()
+			e.Uint64(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_sint32", "rSint32", m.RSint32 == nil) {
+		e.BeginList()
+		for _, x := range m.RSint32 {
+			e.Elem()
+			e.Int32(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_sint64", "rSint64", m.RSint64 == nil) {
+		e.BeginList()
+		for _, x := range m.RSint64 {
+			e.Elem()
+			e.Int64(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_float", "rFloat", m.RFloat == nil) {
+		e.BeginList()
+		for _, x := range m.RFloat {
+			e.Elem()
+			e.Float32(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_double", "rDouble", m.RDouble == nil) {
+		e.BeginList()
+		for _, x := range m.RDouble {
+			e.Elem()
+			e.Float64(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_string", "rString", m.RString == nil) {
+		e.BeginList()
+		for _, x := range m.RString {
+			e.Elem()
+			e.String(x)
+		}
+		e.EndList()
+	}
+	if e.Field("r_bytes", "rBytes", m.RBytes == nil) {
+		e.BeginList()
+		for _, x := range m.RBytes {
+			e.Elem()
+			e.Bytes(x)
+		}
+		e.EndList()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Repeats) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("r_bool", "rBool"); ok {
+		elems, err := d.List(raw, "r_bool")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RBool = make([]bool, len(elems))
+			for i, r := range elems {
+				if err := d.Bool(r, &m.RBool[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_int32", "rInt32"); ok {
+		elems, err := d.List(raw, "r_int32")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RInt32 = make([]int32, len(elems))
+			for i, r := range elems {
+				if err := d.Int32(r, &m.RInt32[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_int64", "rInt64"); ok {
+		elems, err := d.List(raw, "r_int64")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RInt64 = make([]int64, len(elems))
+			for i, r := range elems {
+				if err := d.Int64(r, &m.RInt64[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_uint32", "rUint32"); ok {
+		elems, err := d.List(raw, "r_uint32")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RUint32 = make([]uint32, len(elems))
+			for i, r := range elems {
+				if err := d.Uint32(r, &m.RUint32[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_uint64", "rUint64"); ok {
+		elems, err := d.List(raw, "r_uint64")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RUint64 = make([]uint64, len(elems))
+			for i, r := range elems {
+				if err := d.Uint64(r, &m.RUint64[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_sint32", "rSint32"); ok {
+		elems, err := d.List(raw, "r_sint32")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RSint32 = make([]int32, len(elems))
+			for i, r := range elems {
+				if err := d.Int32(r, &m.RSint32[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_sint64", "rSint64"); ok {
+		elems, err := d.List(raw, "r_sint64")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RSint64 = make([]int64, len(elems))
+			for i, r := range elems {
+				if err := d.Int64(r, &m.RSint64[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_float", "rFloat"); ok {
+		elems, err := d.List(raw, "r_float")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RFloat = make([]float32, len(elems))
+			for i, r := range elems {
+				if err := d.Float32(r, &m.RFloat[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_double", "rDouble"); ok {
+		elems, err := d.List(raw, "r_double")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RDouble = make([]float64, len(elems))
+			for i, r := range elems {
+				if err := d.Float64(r, &m.RDouble[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_string", "rString"); ok {
+		elems, err := d.List(raw, "r_string")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RString = make([]string, len(elems))
+			for i, r := range elems {
+				if err := d.String(r, &m.RString[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("r_bytes", "rBytes"); ok {
+		elems, err := d.List(raw, "r_bytes")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RBytes = make([][]byte, len(elems))
+			for i, r := range elems {
+				if err := d.Bytes(r, &m.RBytes[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Widget) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("color", "color", m.Color == nil) {
+		if m.Color == nil {
+			e.Null()
+		} else {
+			e.Enum(int32(*m.Color), Widget_Color_name)
+		}
+	}
+	if e.Field("r_color", "rColor", m.RColor == nil) {
+		e.BeginList()
+		for _, x := range m.RColor {
+			e.Elem()
+			e.Enum(int32(x), Widget_Color_name)
+		}
+		e.EndList()
+	}
+	if e.Field("simple", "simple", m.Simple == nil) {
+		if m.Simple == nil {
+			e.Null()
+		} else if err := e.Message(m.Simple); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("r_simple", "rSimple", m.RSimple == nil) {
+		e.BeginList()
+		for _, x := range m.RSimple {
+			e.Elem()
+			if x == nil {
+				e.Null()
+			} else if err := e.Message(x); err != nil {
+				return nil, err
+			}
+		}
+		e.EndList()
+	}
+	if e.Field("repeats", "repeats", m.Repeats == nil) {
+		if m.Repeats == nil {
+			e.Null()
+		} else if err := e.Message(m.Repeats); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("r_repeats", "rRepeats", m.RRepeats == nil) {
+		e.BeginList()
+		for _, x := range m.RRepeats {
+			e.Elem()
+			if x == nil {
+				e.Null()
+			} else if err := e.Message(x); err != nil {
+				return nil, err
+			}
+		}
+		e.EndList()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Widget) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("color", "color"); ok {
+		if !d.Null(raw) {
+			m.Color = new(Widget_Color)
+			if err := d.Enum(raw, (*int32)(m.Color), Widget_Color_value, "jsonpb_generated.Widget_Color"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("r_color", "rColor"); ok {
+		elems, err := d.List(raw, "r_color")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RColor = make([]Widget_Color, len(elems))
+			for i, r := range elems {
+				if err := d.Enum(r, (*int32)(&m.RColor[i]), Widget_Color_value, "jsonpb_generated.Widget_Color"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("simple", "simple"); ok {
+		if !d.Null(raw) {
+			m.Simple = new(Simple)
+			if err := d.Message(raw, m.Simple, "simple"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("r_simple", "rSimple"); ok {
+		elems, err := d.List(raw, "r_simple")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RSimple = make([]*Simple, len(elems))
+			for i, r := range elems {
+				if !d.Null(r) {
+					m.RSimple[i] = new(Simple)
+					if err := d.Message(r, m.RSimple[i], "r_simple"); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("repeats", "repeats"); ok {
+		if !d.Null(raw) {
+			m.Repeats = new(Repeats)
+			if err := d.Message(raw, m.Repeats, "repeats"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("r_repeats", "rRepeats"); ok {
+		elems, err := d.List(raw, "r_repeats")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RRepeats = make([]*Repeats, len(elems))
+			for i, r := range elems {
+				if !d.Null(r) {
+					m.RRepeats[i] = new(Repeats)
+					if err := d.Message(r, m.RRepeats[i], "r_repeats"); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Maps) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("m_int64_str", "mInt64Str", m.MInt64Str == nil) {
+		keys := make([]int64, 0, len(m.MInt64Str))
+		for k := range m.MInt64Str {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.IntKey(int64(k))
+			v := m.MInt64Str[k]
+			e.String(v)
+		}
+		e.EndMap()
+	}
+	if e.Field("m_bool_simple", "mBoolSimple", m.MBoolSimple == nil) {
+		keys := make([]bool, 0, len(m.MBoolSimple))
+		for k := range m.MBoolSimple {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		e.BeginMap()
+		for _, k := range keys {
+			e.BoolKey(k)
+			v := m.MBoolSimple[k]
+			if v == nil {
+				e.Null()
+			} else if err := e.Message(v); err != nil {
+				return nil, err
+			}
+		}
+		e.EndMap()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Maps) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("m_int64_str", "mInt64Str"); ok {
+		elems, err := d.Map(raw, "m_int64_str")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.MInt64Str = make(map[int64]string, len(elems))
+			for s, r := range elems {
+				var k int64
+				if err := d.Int64([]byte(s), &k); err != nil {
+					return err
+				}
+				var v string
+				if err := d.String(r, &v); err != nil {
+					return err
+				}
+				m.MInt64Str[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("m_bool_simple", "mBoolSimple"); ok {
+		elems, err := d.Map(raw, "m_bool_simple")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.MBoolSimple = make(map[bool]*Simple, len(elems))
+			for s, r := range elems {
+				var k bool
+				if err := d.Bool([]byte(s), &k); err != nil {
+					return err
+				}
+				var v *Simple
+				if !d.Null(r) {
+					v = new(Simple)
+					if err := d.Message(r, v, ""); err != nil {
+						return err
+					}
+				}
+				m.MBoolSimple[k] = v
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgWithOneof) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	switch x := m.Union.(type) {
+	case *MsgWithOneof_Title:
+		if e.Field("title", "title", false) {
+			e.String(x.Title)
+		}
+	case *MsgWithOneof_Salary:
+		if e.Field("salary", "salary", false) {
+			e.Int64(x.Salary)
+		}
+	case *MsgWithOneof_Country:
+		if e.Field("Country", "Country", false) {
+			e.String(x.Country)
+		}
+	case *MsgWithOneof_HomeAddress:
+		if e.Field("home_address", "homeAddress", false) {
+			e.String(x.HomeAddress)
+		}
+	case *MsgWithOneof_MsgWithRequired:
+		if e.Field("msg_with_required", "msgWithRequired", false) {
+			if x.MsgWithRequired == nil {
+				e.Null()
+			} else if err := e.Message(x.MsgWithRequired); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgWithOneof) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("title", "title"); ok {
+		x := new(MsgWithOneof_Title)
+		m.Union = x
+		if err := d.String(raw, &x.Title); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("salary", "salary"); ok {
+		x := new(MsgWithOneof_Salary)
+		m.Union = x
+		if err := d.Int64(raw, &x.Salary); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("Country", "Country"); ok {
+		x := new(MsgWithOneof_Country)
+		m.Union = x
+		if err := d.String(raw, &x.Country); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("home_address", "homeAddress"); ok {
+		x := new(MsgWithOneof_HomeAddress)
+		m.Union = x
+		if err := d.String(raw, &x.HomeAddress); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("msg_with_required", "msgWithRequired"); ok {
+		x := new(MsgWithOneof_MsgWithRequired)
+		m.Union = x
+		if !d.Null(raw) {
+			x.MsgWithRequired = new(MsgWithRequired)
+			if err := d.Message(raw, x.MsgWithRequired, "msg_with_required"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Real) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("value", "value", m.Value == nil) {
+		if m.Value == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.Value)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Real) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("value", "value"); ok {
+		if !d.Null(raw) {
+			m.Value = new(float64)
+			if err := d.Float64(raw, m.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Complex) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("imaginary", "imaginary", m.Imaginary == nil) {
+		if m.Imaginary == nil {
+			e.Null()
+		} else {
+			e.Float64(*m.Imaginary)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Complex) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("imaginary", "imaginary"); ok {
+		if !d.Null(raw) {
+			m.Imaginary = new(float64)
+			if err := d.Float64(raw, m.Imaginary); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *KnownTypes) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("an", "an", m.An == nil) {
+		if m.An == nil {
+			e.Null()
+		} else if err := e.Message(m.An); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("dur", "dur", m.Dur == nil) {
+		if m.Dur == nil {
+			e.Null()
+		} else if err := e.Message(m.Dur); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("st", "st", m.St == nil) {
+		if m.St == nil {
+			e.Null()
+		} else if err := e.Message(m.St); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("ts", "ts", m.Ts == nil) {
+		if m.Ts == nil {
+			e.Null()
+		} else if err := e.Message(m.Ts); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("lv", "lv", m.Lv == nil) {
+		if m.Lv == nil {
+			e.Null()
+		} else if err := e.Message(m.Lv); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("val", "val", m.Val == nil) {
+		if m.Val == nil {
+			e.Null()
+		} else if err := e.Message(m.Val); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("dbl", "dbl", m.Dbl == nil) {
+		if m.Dbl == nil {
+			e.Null()
+		} else if err := e.Message(m.Dbl); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("flt", "flt", m.Flt == nil) {
+		if m.Flt == nil {
+			e.Null()
+		} else if err := e.Message(m.Flt); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("i64", "i64", m.I64 == nil) {
+		if m.I64 == nil {
+			e.Null()
+		} else if err := e.Message(m.I64); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("u64", "u64", m.U64 == nil) {
+		if m.U64 == nil {
+			e.Null()
+		} else if err := e.Message(m.U64); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("i32", "i32", m.I32 == nil) {
+		if m.I32 == nil {
+			e.Null()
+		} else if err := e.Message(m.I32); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("u32", "u32", m.U32 == nil) {
+		if m.U32 == nil {
+			e.Null()
+		} else if err := e.Message(m.U32); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("bool", "bool", m.Bool == nil) {
+		if m.Bool == nil {
+			e.Null()
+		} else if err := e.Message(m.Bool); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("str", "str", m.Str == nil) {
+		if m.Str == nil {
+			e.Null()
+		} else if err := e.Message(m.Str); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("bytes", "bytes", m.Bytes == nil) {
+		if m.Bytes == nil {
+			e.Null()
+		} else if err := e.Message(m.Bytes); err != nil {
+			return nil, err
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *KnownTypes) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("an", "an"); ok {
+		if !d.Null(raw) {
+			m.An = new(any.Any)
+			if err := d.Message(raw, m.An, "an"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("dur", "dur"); ok {
+		if !d.Null(raw) {
+			m.Dur = new(duration.Duration)
+			if err := d.Message(raw, m.Dur, "dur"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("st", "st"); ok {
+		if !d.Null(raw) {
+			m.St = new(_struct.Struct)
+			if err := d.Message(raw, m.St, "st"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("ts", "ts"); ok {
+		if !d.Null(raw) {
+			m.Ts = new(timestamp.Timestamp)
+			if err := d.Message(raw, m.Ts, "ts"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("lv", "lv"); ok {
+		if !d.Null(raw) {
+			m.Lv = new(_struct.ListValue)
+			if err := d.Message(raw, m.Lv, "lv"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("val", "val"); ok {
+		m.Val = new(_struct.Value)
+		if err := d.Message(raw, m.Val, "val"); err != nil {
+			return err
+		}
+	}
+	if raw, ok := d.Field("dbl", "dbl"); ok {
+		if !d.Null(raw) {
+			m.Dbl = new(wrappers.DoubleValue)
+			if err := d.Message(raw, m.Dbl, "dbl"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("flt", "flt"); ok {
+		if !d.Null(raw) {
+			m.Flt = new(wrappers.FloatValue)
+			if err := d.Message(raw, m.Flt, "flt"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("i64", "i64"); ok {
+		if !d.Null(raw) {
+			m.I64 = new(wrappers.Int64Value)
+			if err := d.Message(raw, m.I64, "i64"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("u64", "u64"); ok {
+		if !d.Null(raw) {
+			m.U64 = new(wrappers.UInt64Value)
+			if err := d.Message(raw, m.U64, "u64"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("i32", "i32"); ok {
+		if !d.Null(raw) {
+			m.I32 = new(wrappers.Int32Value)
+			if err := d.Message(raw, m.I32, "i32"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("u32", "u32"); ok {
+		if !d.Null(raw) {
+			m.U32 = new(wrappers.UInt32Value)
+			if err := d.Message(raw, m.U32, "u32"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("bool", "bool"); ok {
+		if !d.Null(raw) {
+			m.Bool = new(wrappers.BoolValue)
+			if err := d.Message(raw, m.Bool, "bool"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("str", "str"); ok {
+		if !d.Null(raw) {
+			m.Str = new(wrappers.StringValue)
+			if err := d.Message(raw, m.Str, "str"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("bytes", "bytes"); ok {
+		if !d.Null(raw) {
+			m.Bytes = new(wrappers.BytesValue)
+			if err := d.Message(raw, m.Bytes, "bytes"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgWithRequired) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("str", "str", m.Str == nil) {
+		if m.Str == nil {
+			e.Null()
+		} else {
+			e.String(*m.Str)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgWithRequired) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("str", "str"); ok {
+		if !d.Null(raw) {
+			m.Str = new(string)
+			if err := d.String(raw, m.Str); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgWithIndirectRequired) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("subm", "subm", m.Subm == nil) {
+		if m.Subm == nil {
+			e.Null()
+		} else if err := e.Message(m.Subm); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("map_field", "mapField", m.MapField == nil) {
+		keys := make([]string, 0, len(m.MapField))
+		for k := range m.MapField {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.MapField[k]
+			if v == nil {
+				e.Null()
+			} else if err := e.Message(v); err != nil {
+				return nil, err
+			}
+		}
+		e.EndMap()
+	}
+	if e.Field("slice_field", "sliceField", m.SliceField == nil) {
+		e.BeginList()
+		for _, x := range m.SliceField {
+			e.Elem()
+			if x == nil {
+				e.Null()
+			} else if err := e.Message(x); err != nil {
+				return nil, err
+			}
+		}
+		e.EndList()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgWithIndirectRequired) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("subm", "subm"); ok {
+		if !d.Null(raw) {
+			m.Subm = new(MsgWithRequired)
+			if err := d.Message(raw, m.Subm, "subm"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("map_field", "mapField"); ok {
+		elems, err := d.Map(raw, "map_field")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.MapField = make(map[string]*MsgWithRequired, len(elems))
+			for k, r := range elems {
+				var v *MsgWithRequired
+				if !d.Null(r) {
+					v = new(MsgWithRequired)
+					if err := d.Message(r, v, ""); err != nil {
+						return err
+					}
+				}
+				m.MapField[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("slice_field", "sliceField"); ok {
+		elems, err := d.List(raw, "slice_field")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.SliceField = make([]*MsgWithRequired, len(elems))
+			for i, r := range elems {
+				if !d.Null(r) {
+					m.SliceField[i] = new(MsgWithRequired)
+					if err := d.Message(r, m.SliceField[i], "slice_field"); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgWithRequiredBytes) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("byts", "byts", m.Byts == nil) {
+		e.Bytes(m.Byts)
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgWithRequiredBytes) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("byts", "byts"); ok {
+		if err := d.Bytes(raw, &m.Byts); err != nil {
+			return err
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgWithRequiredWKT) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("str", "str", m.Str == nil) {
+		if m.Str == nil {
+			e.Null()
+		} else if err := e.Message(m.Str); err != nil {
+			return nil, err
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgWithRequiredWKT) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("str", "str"); ok {
+		if !d.Null(raw) {
+			m.Str = new(wrappers.StringValue)
+			if err := d.Message(raw, m.Str, "str"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgSet) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgSet) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *MsgSetItem) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("name", "name", m.Name == nil) {
+		if m.Name == nil {
+			e.Null()
+		} else {
+			e.String(*m.Name)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *MsgSetItem) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("name", "name"); ok {
+		if !d.Null(raw) {
+			m.Name = new(string)
+			if err := d.String(raw, m.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Extended) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("real", "real", m.Real == nil) {
+		if m.Real == nil {
+			e.Null()
+		} else if err := e.Message(m.Real); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("reals", "reals", m.Reals == nil) {
+		e.BeginList()
+		for _, x := range m.Reals {
+			e.Elem()
+			if x == nil {
+				e.Null()
+			} else if err := e.Message(x); err != nil {
+				return nil, err
+			}
+		}
+		e.EndList()
+	}
+	if e.Field("real_map", "realMap", m.RealMap == nil) {
+		keys := make([]string, 0, len(m.RealMap))
+		for k := range m.RealMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.BeginMap()
+		for _, k := range keys {
+			e.Key(k)
+			v := m.RealMap[k]
+			if v == nil {
+				e.Null()
+			} else if err := e.Message(v); err != nil {
+				return nil, err
+			}
+		}
+		e.EndMap()
+	}
+	switch x := m.Choice.(type) {
+	case *Extended_RealChoice:
+		if e.Field("real_choice", "realChoice", false) {
+			if x.RealChoice == nil {
+				e.Null()
+			} else if err := e.Message(x.RealChoice); err != nil {
+				return nil, err
+			}
+		}
+	case *Extended_Choicegroup:
+		if e.Field("ChoiceGroup", "choicegroup", false) {
+			if x.Choicegroup == nil {
+				e.Null()
+			} else if err := e.Message(x.Choicegroup); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if e.Field("OptionalGroup", "optionalgroup", m.Optionalgroup == nil) {
+		if m.Optionalgroup == nil {
+			e.Null()
+		} else if err := e.Message(m.Optionalgroup); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("RepeatedGroup", "repeatedgroup", m.Repeatedgroup == nil) {
+		e.BeginList()
+		for _, x := range m.Repeatedgroup {
+			e.Elem()
+			if x == nil {
+				e.Null()
+			} else if err := e.Message(x); err != nil {
+				return nil, err
+			}
+		}
+		e.EndList()
+	}
+	if e.Field("msg_set", "msgSet", m.MsgSet == nil) {
+		if m.MsgSet == nil {
+			e.Null()
+		} else if err := e.Message(m.MsgSet); err != nil {
+			return nil, err
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Extended) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("real", "real"); ok {
+		if !d.Null(raw) {
+			m.Real = new(Real)
+			if err := d.Message(raw, m.Real, "real"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("reals", "reals"); ok {
+		elems, err := d.List(raw, "reals")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Reals = make([]*Real, len(elems))
+			for i, r := range elems {
+				if !d.Null(r) {
+					m.Reals[i] = new(Real)
+					if err := d.Message(r, m.Reals[i], "reals"); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("real_map", "realMap"); ok {
+		elems, err := d.Map(raw, "real_map")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.RealMap = make(map[string]*Real, len(elems))
+			for k, r := range elems {
+				var v *Real
+				if !d.Null(r) {
+					v = new(Real)
+					if err := d.Message(r, v, ""); err != nil {
+						return err
+					}
+				}
+				m.RealMap[k] = v
+			}
+		}
+	}
+	if raw, ok := d.Field("OptionalGroup", "optionalgroup"); ok {
+		if !d.Null(raw) {
+			m.Optionalgroup = new(Extended_OptionalGroup)
+			if err := d.Message(raw, m.Optionalgroup, "OptionalGroup"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("RepeatedGroup", "repeatedgroup"); ok {
+		elems, err := d.List(raw, "RepeatedGroup")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Repeatedgroup = make([]*Extended_RepeatedGroup, len(elems))
+			for i, r := range elems {
+				if !d.Null(r) {
+					m.Repeatedgroup[i] = new(Extended_RepeatedGroup)
+					if err := d.Message(r, m.Repeatedgroup[i], "RepeatedGroup"); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	if raw, ok := d.Field("msg_set", "msgSet"); ok {
+		if !d.Null(raw) {
+			m.MsgSet = new(MsgSet)
+			if err := d.Message(raw, m.MsgSet, "msg_set"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("real_choice", "realChoice"); ok {
+		x := new(Extended_RealChoice)
+		m.Choice = x
+		if !d.Null(raw) {
+			x.RealChoice = new(Real)
+			if err := d.Message(raw, x.RealChoice, "real_choice"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("ChoiceGroup", "choicegroup"); ok {
+		x := new(Extended_Choicegroup)
+		m.Choice = x
+		if !d.Null(raw) {
+			x.Choicegroup = new(Extended_ChoiceGroup)
+			if err := d.Message(raw, x.Choicegroup, "ChoiceGroup"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Extended_ChoiceGroup) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("real", "real", m.Real == nil) {
+		if m.Real == nil {
+			e.Null()
+		} else if err := e.Message(m.Real); err != nil {
+			return nil, err
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Extended_ChoiceGroup) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("real", "real"); ok {
+		if !d.Null(raw) {
+			m.Real = new(Real)
+			if err := d.Message(raw, m.Real, "real"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Extended_OptionalGroup) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("real", "real", m.Real == nil) {
+		if m.Real == nil {
+			e.Null()
+		} else if err := e.Message(m.Real); err != nil {
+			return nil, err
+		}
+	}
+	if e.Field("tags", "tags", m.Tags == nil) {
+		e.BeginList()
+		for _, x := range m.Tags {
+			e.Elem()
+			e.String(x)
+		}
+		e.EndList()
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Extended_OptionalGroup) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("real", "real"); ok {
+		if !d.Null(raw) {
+			m.Real = new(Real)
+			if err := d.Message(raw, m.Real, "real"); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := d.Field("tags", "tags"); ok {
+		elems, err := d.List(raw, "tags")
+		if err != nil {
+			return err
+		}
+		if elems != nil {
+			m.Tags = make([]string, len(elems))
+			for i, r := range elems {
+				if err := d.String(r, &m.Tags[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *Extended_RepeatedGroup) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("n", "n", m.N == nil) {
+		if m.N == nil {
+			e.Null()
+		} else {
+			e.Int32(*m.N)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *Extended_RepeatedGroup) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("n", "n"); ok {
+		if !d.Null(raw) {
+			m.N = new(int32)
+			if err := d.Int32(raw, m.N); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+// MarshalJSONPB marshals m to JSON with jm, as jsonpb.Marshaler would
+// without this method.
+func (m *ExtGroup) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	e := jm.XXX_Encoder(m)
+	if e.Field("label", "label", m.Label == nil) {
+		if m.Label == nil {
+			e.Null()
+		} else {
+			e.String(*m.Label)
+		}
+	}
+	return e.End()
+}
+
+// UnmarshalJSONPB unmarshals JSON into m with ju, as jsonpb.Unmarshaler would
+// without this method.
+func (m *ExtGroup) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	d, err := ju.XXX_Decoder(m, b)
+	if d == nil {
+		return err
+	}
+	if raw, ok := d.Field("label", "label"); ok {
+		if !d.Null(raw) {
+			m.Label = new(string)
+			if err := d.String(raw, m.Label); err != nil {
+				return err
+			}
+		}
+	}
+	return d.End()
+}
+
+func init() {
+	proto.RegisterFile("jsonpb_generated/test_objects.proto", fileDescriptor_test_objects_df9d69967335e263)
+}
+
+var fileDescriptor_test_objects_df9d69967335e263 = []byte{
+	// 1874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcd, 0x72, 0x1b, 0xb9,
+	0x11, 0xf6, 0xcc, 0x70, 0x48, 0x4e, 0x53, 0xb2, 0x65, 0xf8, 0x6f, 0xcc, 0x38, 0xce, 0x2c, 0x9d,
+	0xdd, 0x65, 0x29, 0x1b, 0x3a, 0x4b, 0x31, 0x74, 0x56, 0x95, 0xcb, 0xca, 0x92, 0xd7, 0x8e, 0x2d,
+	0x29, 0x05, 0xd9, 0xf1, 0x9e, 0xc2, 0x1a, 0x6a, 0x40, 0x7a, 0x9c, 0xf9, 0x61, 0x00, 0xd0, 0xb6,
+	0x2a, 0x7f, 0x4a, 0xae, 0x9b, 0x53, 0x1e, 0x22, 0xd7, 0x54, 0xe5, 0x98, 0x47, 0xc8, 0x2b, 0xe4,
+	0x2d, 0xf2, 0x04, 0x29, 0x34, 0x30, 0xfc, 0x93, 0x46, 0x56, 0x4e, 0x1c, 0xa0, 0xbf, 0xef, 0x6b,
+	0xa0, 0xd1, 0xdd, 0x00, 0xe1, 0xc1, 0x5b, 0x91, 0x67, 0x93, 0xe1, 0x60, 0xcc, 0x32, 0xc6, 0x43,
+	0xc9, 0xa2, 0x87, 0x92, 0x09, 0x39, 0xc8, 0x87, 0x6f, 0xd9, 0xb1, 0x14, 0x9d, 0x09, 0xcf, 0x65,
+	0x4e, 0x36, 0x56, 0x41, 0xcd, 0xbb, 0xe3, 0x3c, 0x1f, 0x27, 0xec, 0x21, 0xda, 0x87, 0xd3, 0xd1,
+	0xc3, 0x30, 0x3b, 0xd1, 0xe0, 0xe6, 0xfd, 0x55, 0x53, 0x34, 0xe5, 0xa1, 0x8c, 0xf3, 0xcc, 0xd8,
+	0xef, 0xad, 0xda, 0x85, 0xe4, 0xd3, 0x63, 0x69, 0xac, 0x3f, 0x58, 0xb5, 0xca, 0x38, 0x65, 0x42,
+	0x86, 0xe9, 0xa4, 0x4c, 0xfe, 0x3d, 0x0f, 0x27, 0x13, 0xc6, 0xcd, 0x5a, 0x5b, 0xff, 0xa8, 0x40,
+	0xf5, 0x28, 0x4e, 0x27, 0x09, 0x23, 0xb7, 0xa0, 0x9a, 0x0f, 0x86, 0x79, 0x9e, 0xf8, 0x56, 0x60,
+	0xb5, 0xeb, 0xd4, 0xcd, 0x77, 0xf2, 0x3c, 0x21, 0x77, 0xa0, 0x96, 0x0f, 0xe2, 0x4c, 0x6e, 0x75,
+	0x7d, 0x3b, 0xb0, 0xda, 0x2e, 0xad, 0xe6, 0xcf, 0xd4, 0x88, 0xdc, 0x87, 0x86, 0x31, 0x0c, 0x84,
+	0xe4, 0xbe, 0x83, 0x46, 0x4f, 0x1b, 0x8f, 0x24, 0x9f, 0x11, 0xfb, 0x3d, 0xbf, 0x12, 0x58, 0x6d,
+	0x47, 0x13, 0xfb, 0xbd, 0x19, 0xb1, 0xdf, 0x43, 0xa2, 0x8b, 0x46, 0x4f, 0x1b, 0x15, 0xf1, 0x2e,
+	0xd4, 0xf3, 0xc1, 0x54, 0xbb, 0xac, 0x06, 0x56, 0x7b, 0x9d, 0xd6, 0xf2, 0x57, 0x38, 0x24, 0x01,
+	0xac, 0x15, 0x26, 0xe4, 0xd6, 0xd0, 0x0c, 0xc6, 0xbc, 0x44, 0xee, 0xf7, 0xfc, 0x7a, 0x60, 0xb5,
+	0x2b, 0x86, 0xdc, 0xef, 0xcd, 0xc9, 0xc6, 0xb1, 0x87, 0x66, 0x30, 0xe6, 0x19, 0x59, 0x68, 0xcf,
+	0x10, 0x58, 0xed, 0xeb, 0xb4, 0x96, 0x1f, 0x2d, 0x78, 0x16, 0x73, 0xcf, 0x0d, 0x34, 0x83, 0x31,
+	0x2f, 0x91, 0xfb, 0x3d, 0x7f, 0x2d, 0xb0, 0xda, 0xc4, 0x90, 0x0b, 0xcf, 0x62, 0xee, 0x79, 0x1d,
+	0xcd, 0x60, 0xcc, 0xb3, 0x60, 0x8d, 0x92, 0x3c, 0x94, 0xfe, 0xd5, 0xc0, 0x6a, 0xdb, 0xb4, 0x9a,
+	0x3f, 0x51, 0x23, 0x1d, 0x2c, 0x34, 0x20, 0xf3, 0x1a, 0x1a, 0x3d, 0x6d, 0x9c, 0x79, 0x8d, 0xf2,
+	0xe9, 0x30, 0x61, 0xfe, 0x46, 0x60, 0xb5, 0x2d, 0x5a, 0xcb, 0x77, 0x71, 0xa8, 0xbd, 0x6a, 0x13,
+	0x72, 0xaf, 0xa3, 0x19, 0x8c, 0x79, 0xbe, 0x64, 0xc9, 0xe3, 0x6c, 0xec, 0x93, 0xc0, 0x6a, 0x7b,
+	0x6a, 0xc9, 0x38, 0xd4, 0x0b, 0x1a, 0x9e, 0x48, 0x26, 0xfc, 0x1b, 0x81, 0xd5, 0x5e, 0xa3, 0xd5,
+	0x7c, 0x47, 0x8d, 0x5a, 0x7f, 0xb3, 0x00, 0x0e, 0xf2, 0xec, 0x49, 0x9c, 0xc5, 0x92, 0x09, 0x72,
+	0x03, 0xdc, 0xd1, 0x20, 0x0b, 0x33, 0x4c, 0x1a, 0x9b, 0x56, 0x46, 0x07, 0x61, 0xa6, 0x52, 0x69,
+	0x34, 0x98, 0xc4, 0xd9, 0x08, 0x53, 0xc6, 0xa6, 0xee, 0xe8, 0x97, 0x71, 0x36, 0xd2, 0xd3, 0x99,
+	0x9a, 0x76, 0xcc, 0xf4, 0x81, 0x9a, 0xbe, 0x01, 0x6e, 0x84, 0x12, 0x15, 0x5c, 0x60, 0x25, 0x32,
+	0x12, 0x91, 0x96, 0x70, 0x71, 0xd6, 0x8d, 0x0a, 0x89, 0x48, 0x4b, 0x54, 0xcd, 0xb4, 0x92, 0x68,
+	0xfd, 0xdd, 0x86, 0x1a, 0x65, 0x13, 0x16, 0x4a, 0xa1, 0x20, 0xbc, 0xc8, 0x63, 0x47, 0xe5, 0x31,
+	0x2f, 0xf2, 0x98, 0xcf, 0xf2, 0xd8, 0x51, 0x79, 0xcc, 0x75, 0x1e, 0x17, 0x86, 0x7e, 0xcf, 0x77,
+	0x02, 0xa7, 0xed, 0x68, 0x43, 0xbf, 0xa7, 0xa2, 0xc3, 0x8b, 0x3c, 0xac, 0x04, 0x8e, 0xca, 0x43,
+	0x6e, 0xf2, 0x70, 0x66, 0xea, 0xf7, 0x7c, 0x37, 0x70, 0xda, 0x15, 0x63, 0x2a, 0x58, 0xa2, 0xc8,
+	0x5e, 0x47, 0xe5, 0x10, 0x3f, 0x5a, 0x60, 0x99, 0x0c, 0xa9, 0x05, 0x4e, 0x9b, 0x18, 0x53, 0xbf,
+	0xa7, 0x17, 0xa1, 0xcf, 0xbf, 0x1e, 0x38, 0xea, 0xfc, 0xb9, 0x3e, 0x7f, 0xe4, 0x98, 0xf3, 0xf5,
+	0x02, 0x47, 0x9d, 0x2f, 0x37, 0xe7, 0xab, 0xe5, 0xf4, 0xe9, 0x41, 0xe0, 0xa8, 0xd3, 0xe3, 0xf3,
+	0xd3, 0xe3, 0xe6, 0xf4, 0x1a, 0x81, 0xa3, 0x4e, 0x8f, 0xeb, 0xd3, 0xfb, 0xaf, 0x0d, 0xd5, 0xd7,
+	0x71, 0x34, 0x66, 0x92, 0xf4, 0xc0, 0x3d, 0xce, 0x93, 0x9c, 0xe3, 0xc9, 0x5d, 0xed, 0xde, 0xef,
+	0xac, 0xb6, 0xad, 0x8e, 0x06, 0x76, 0x1e, 0x2b, 0x14, 0xd5, 0x60, 0xf2, 0x48, 0x29, 0x6b, 0x9e,
+	0x0a, 0xe3, 0xc7, 0x79, 0x55, 0x8e, 0xbf, 0xe4, 0x27, 0x50, 0x15, 0xd8, 0x68, 0xb0, 0xb2, 0x1a,
+	0x5d, 0xff, 0x2c, 0x4f, 0x37, 0x22, 0x6a, 0x70, 0x64, 0x4b, 0x87, 0x0b, 0x39, 0x6a, 0x17, 0x17,
+	0x71, 0x6a, 0x5c, 0x7f, 0x90, 0x2d, 0xa8, 0x71, 0x9d, 0x08, 0xfe, 0x4d, 0xf4, 0x73, 0xf7, 0x2c,
+	0xc7, 0x64, 0x0a, 0x2d, 0x90, 0xa4, 0x0f, 0x1e, 0x1f, 0x14, 0xb4, 0x5b, 0x81, 0x73, 0x31, 0xad,
+	0xce, 0xcd, 0x57, 0xeb, 0x53, 0x70, 0xf5, 0xe6, 0x6a, 0xe0, 0xd0, 0xbd, 0xdd, 0x8d, 0x2b, 0xc4,
+	0x03, 0xf7, 0x1b, 0xba, 0xb7, 0x77, 0xb0, 0x61, 0x91, 0x3a, 0x54, 0x76, 0x5e, 0xbc, 0xda, 0xdb,
+	0xb0, 0x5b, 0xff, 0xb4, 0xa1, 0xb2, 0x1f, 0x4e, 0x04, 0xd9, 0x83, 0x46, 0xba, 0xd0, 0xf9, 0x2c,
+	0xf4, 0xf4, 0xe9, 0x59, 0x4f, 0x0a, 0xdc, 0xd9, 0x2f, 0x1a, 0xe2, 0x5e, 0x26, 0xf9, 0x09, 0xf5,
+	0xd2, 0x62, 0x4c, 0x9e, 0xc3, 0x7a, 0x8a, 0x19, 0x5e, 0x44, 0xc7, 0x46, 0xa1, 0xcf, 0xcb, 0x84,
+	0x54, 0xfe, 0xeb, 0xf0, 0x68, 0xa9, 0x46, 0x3a, 0x9f, 0x69, 0xfe, 0x1c, 0xae, 0x2e, 0x7b, 0x22,
+	0x1b, 0xe0, 0xfc, 0x86, 0x9d, 0x60, 0x5a, 0x38, 0x54, 0x7d, 0x92, 0x9b, 0xe0, 0xbe, 0x0b, 0x93,
+	0x29, 0xc3, 0x72, 0xf6, 0xa8, 0x1e, 0x6c, 0xdb, 0x3f, 0xb3, 0x9a, 0xdf, 0xc2, 0xc6, 0xaa, 0xfc,
+	0x22, 0xbf, 0xae, 0xf9, 0x9d, 0x45, 0xfe, 0x45, 0xc7, 0x38, 0x57, 0x6e, 0xfd, 0xc7, 0x82, 0xb5,
+	0x7d, 0x31, 0x7e, 0x1d, 0xcb, 0x37, 0x87, 0x19, 0xcb, 0x47, 0xe4, 0x36, 0xb8, 0x32, 0x96, 0x09,
+	0x43, 0x61, 0xef, 0xe9, 0x15, 0xaa, 0x87, 0xc4, 0x87, 0xaa, 0x08, 0x93, 0x90, 0x9f, 0xa0, 0xba,
+	0xf3, 0xf4, 0x0a, 0x35, 0x63, 0xd2, 0x84, 0xda, 0xe3, 0x7c, 0xaa, 0xd6, 0xe4, 0x3b, 0x86, 0x53,
+	0x4c, 0x90, 0x07, 0xb0, 0xf6, 0x26, 0x4f, 0xd9, 0x20, 0x8c, 0x22, 0xce, 0x84, 0xf0, 0x2b, 0x06,
+	0xd0, 0x50, 0xb3, 0x5f, 0xeb, 0x49, 0x72, 0x08, 0xd7, 0x53, 0x31, 0x1e, 0xbc, 0x8f, 0xe5, 0x9b,
+	0x01, 0x67, 0xbf, 0x9d, 0xc6, 0x9c, 0x45, 0xd8, 0x8f, 0x1a, 0xdd, 0x4f, 0xce, 0x09, 0xb6, 0x5e,
+	0x2d, 0x35, 0xc0, 0xa7, 0x57, 0xe8, 0xb5, 0x74, 0x79, 0x6a, 0xa7, 0x06, 0xee, 0x34, 0x8b, 0xf3,
+	0xac, 0xf5, 0x19, 0x54, 0x28, 0x0b, 0x93, 0x79, 0x64, 0x2d, 0xdd, 0xce, 0x70, 0xb0, 0x59, 0xaf,
+	0x47, 0x1b, 0xa7, 0xa7, 0xa7, 0xa7, 0x76, 0xeb, 0x3b, 0x4b, 0xed, 0x41, 0xc5, 0xe6, 0x03, 0xb9,
+	0x07, 0x5e, 0x9c, 0x86, 0xe3, 0x38, 0x53, 0x7b, 0xd5, 0xf8, 0xf9, 0xc4, 0x9c, 0xd3, 0xfd, 0x16,
+	0xae, 0x72, 0x16, 0x26, 0x03, 0xf6, 0x41, 0xb2, 0x4c, 0xc4, 0x79, 0x46, 0x6e, 0x9f, 0x97, 0xcc,
+	0x61, 0xe2, 0xff, 0xae, 0xac, 0x42, 0x8c, 0x4b, 0xba, 0xae, 0x84, 0xf6, 0x0a, 0x9d, 0xd6, 0xbf,
+	0x5c, 0x80, 0xe7, 0x59, 0xfe, 0x3e, 0x7b, 0x79, 0x32, 0x61, 0x82, 0xfc, 0x10, 0xec, 0x30, 0xc3,
+	0xfb, 0xaa, 0xd1, 0xbd, 0xd9, 0xd1, 0x2f, 0x8d, 0x4e, 0xf1, 0xd2, 0xe8, 0x7c, 0x9d, 0x9d, 0x50,
+	0x3b, 0xcc, 0xc8, 0x8f, 0xc0, 0x89, 0xa6, 0xba, 0xcb, 0x28, 0x5f, 0xab, 0xb0, 0x5d, 0xf3, 0xde,
+	0xa1, 0x0a, 0x45, 0x3e, 0x07, 0x5b, 0x48, 0xbc, 0x3e, 0x1b, 0xdd, 0x3b, 0x67, 0xb0, 0x47, 0xf8,
+	0xf6, 0xa1, 0xb6, 0x90, 0x64, 0x13, 0x6c, 0x29, 0x4c, 0x3e, 0x35, 0xcf, 0x00, 0x5f, 0x16, 0xcf,
+	0x20, 0x6a, 0x4b, 0xa1, 0xb0, 0xc9, 0x3b, 0xff, 0x5a, 0x09, 0xf6, 0x45, 0x2c, 0xe4, 0xaf, 0x54,
+	0xd8, 0xa9, 0x9d, 0xbc, 0x23, 0x6d, 0x70, 0xde, 0x85, 0x09, 0x5e, 0xa5, 0x8d, 0xee, 0xed, 0x33,
+	0x60, 0x0d, 0x54, 0x10, 0xd2, 0x01, 0x27, 0x1a, 0x26, 0x98, 0x59, 0x8d, 0xee, 0xbd, 0xb3, 0xfb,
+	0xc2, 0x26, 0x6d, 0xf0, 0xd1, 0x30, 0x21, 0x3f, 0x06, 0x67, 0x94, 0x48, 0x4c, 0xb4, 0x46, 0xf7,
+	0x7b, 0x67, 0xf0, 0xd8, 0xee, 0x0d, 0x7c, 0x94, 0x48, 0x05, 0x8f, 0xf1, 0x76, 0x39, 0x1f, 0x8e,
+	0x25, 0x6b, 0xe0, 0x71, 0xbf, 0xa7, 0x56, 0x33, 0xed, 0xf7, 0xfc, 0x6a, 0xc9, 0x6a, 0x5e, 0x2d,
+	0xe2, 0xa7, 0xfd, 0x1e, 0xca, 0x6f, 0x75, 0xfd, 0x5a, 0xb9, 0xfc, 0x56, 0xb7, 0x90, 0xdf, 0xea,
+	0xa2, 0xfc, 0x56, 0xd7, 0xaf, 0x5f, 0x20, 0x3f, 0xc3, 0x4f, 0x11, 0x5f, 0xc1, 0x2b, 0xd8, 0x2b,
+	0x09, 0xba, 0xea, 0x19, 0x1a, 0x8e, 0x38, 0xa5, 0xaf, 0x3a, 0x22, 0x94, 0xe8, 0xeb, 0x6b, 0xcd,
+	0xe8, 0x0b, 0xc9, 0xc9, 0x97, 0xe0, 0x16, 0xd7, 0xdb, 0xf9, 0x1b, 0xc0, 0xeb, 0x4e, 0x13, 0x34,
+	0xb2, 0xf5, 0x00, 0xae, 0xad, 0x54, 0x28, 0xd9, 0xd0, 0x5e, 0xad, 0xc0, 0x6e, 0x7b, 0xa8, 0xdb,
+	0xfa, 0xb7, 0x0d, 0x77, 0x0c, 0xea, 0x59, 0x16, 0xc5, 0x9c, 0x1d, 0xcb, 0x19, 0xfa, 0xa7, 0x50,
+	0x11, 0xd3, 0x61, 0xea, 0x5b, 0x97, 0x6c, 0x00, 0x14, 0xe1, 0xe4, 0x25, 0x78, 0x69, 0x38, 0x19,
+	0x8c, 0x62, 0x96, 0x44, 0xa6, 0x53, 0x3f, 0x2a, 0xe5, 0xae, 0x3a, 0x55, 0x1d, 0xfc, 0x89, 0x62,
+	0xea, 0xce, 0x5d, 0x4f, 0xcd, 0x90, 0xec, 0x40, 0x43, 0x24, 0xf1, 0x31, 0x33, 0xba, 0x4e, 0xe0,
+	0x5c, 0x6e, 0x4d, 0x80, 0x2c, 0xd4, 0x68, 0xfe, 0x1a, 0xd6, 0x97, 0xe4, 0x17, 0x3b, 0xb7, 0xa7,
+	0x3b, 0xf7, 0xa3, 0xe5, 0xce, 0x7d, 0x09, 0x07, 0x0b, 0x2d, 0x7c, 0x13, 0x6e, 0xae, 0x58, 0xf1,
+	0x54, 0x08, 0x81, 0xca, 0xf0, 0x44, 0x0a, 0x8c, 0xfb, 0x1a, 0xc5, 0xef, 0xd6, 0x2e, 0x90, 0x15,
+	0xec, 0xeb, 0xe7, 0x2f, 0x8b, 0xb4, 0x50, 0xc0, 0xcb, 0xa4, 0x45, 0xeb, 0x36, 0x54, 0xf7, 0xc5,
+	0xf8, 0x88, 0xc9, 0x79, 0x3b, 0xdc, 0xb6, 0xeb, 0x56, 0xeb, 0xaf, 0x16, 0x80, 0x36, 0x3c, 0x93,
+	0x2c, 0x55, 0x0b, 0xc8, 0xc2, 0xd4, 0xdc, 0x24, 0x14, 0xbf, 0xbb, 0x6f, 0xe1, 0x56, 0xca, 0x84,
+	0x08, 0xc7, 0x6c, 0x20, 0x98, 0x5c, 0x68, 0x9e, 0xfe, 0xb9, 0x7b, 0x3e, 0x62, 0xd2, 0x8f, 0x4c,
+	0xb6, 0x96, 0xd8, 0x95, 0x2b, 0x7a, 0xc3, 0x88, 0x1e, 0x31, 0x39, 0xef, 0xa3, 0xdf, 0x55, 0xa1,
+	0x8e, 0xa3, 0x88, 0x45, 0x64, 0x13, 0x2a, 0xaa, 0xcb, 0x9a, 0xb4, 0x2a, 0x69, 0xd5, 0x14, 0x31,
+	0xe4, 0x0b, 0x70, 0xd5, 0xaf, 0x30, 0x79, 0x54, 0x06, 0xd6, 0x20, 0xb2, 0x03, 0x75, 0xf5, 0x31,
+	0x48, 0xc3, 0x89, 0xef, 0x94, 0x3d, 0x11, 0x8a, 0x75, 0x20, 0x73, 0x3f, 0x9c, 0xe8, 0x44, 0xab,
+	0x71, 0x3d, 0x22, 0x5f, 0x41, 0x03, 0x35, 0x8e, 0xdf, 0xe4, 0xf1, 0x31, 0x33, 0xdd, 0xab, 0xc4,
+	0xef, 0xd3, 0x2b, 0x14, 0x14, 0xf8, 0x31, 0x62, 0xc9, 0x2f, 0xa0, 0xa1, 0x59, 0x63, 0x9e, 0x4f,
+	0x27, 0xd8, 0xc9, 0xa0, 0xfb, 0xd9, 0x05, 0x2b, 0xd0, 0xbc, 0x6f, 0x14, 0x5a, 0xdd, 0xc4, 0x0b,
+	0x64, 0x72, 0x00, 0xeb, 0xf9, 0x44, 0x5d, 0x13, 0x61, 0xa2, 0xd5, 0x6a, 0xa8, 0xd6, 0xbe, 0x40,
+	0xed, 0xd0, 0xe0, 0x51, 0x8f, 0x2e, 0xd3, 0x95, 0x9e, 0x7e, 0xef, 0xb1, 0x48, 0xeb, 0xa9, 0x07,
+	0xf4, 0xc5, 0x7a, 0xd4, 0xe0, 0x8d, 0xde, 0x12, 0x9d, 0x7c, 0x09, 0x35, 0xf5, 0x52, 0x10, 0xac,
+	0xb8, 0xbc, 0x4a, 0xb3, 0x86, 0x56, 0x53, 0xfc, 0x6d, 0x52, 0x58, 0x5b, 0x0c, 0xf9, 0x39, 0xc5,
+	0xf7, 0xc5, 0x72, 0xf1, 0x95, 0x9e, 0xf6, 0xfc, 0x39, 0xf6, 0x15, 0x34, 0x16, 0x82, 0x38, 0x4b,
+	0xad, 0xea, 0xc7, 0x53, 0xab, 0x79, 0x08, 0xeb, 0x4b, 0x11, 0x9b, 0x91, 0xeb, 0x1f, 0x27, 0xab,
+	0x82, 0x92, 0xe1, 0x58, 0xe0, 0x3f, 0x14, 0x8f, 0xe2, 0x77, 0xf3, 0xfb, 0xb0, 0xbe, 0x14, 0x32,
+	0xb2, 0x06, 0x56, 0x86, 0xfd, 0xda, 0xa5, 0x56, 0xb6, 0x53, 0x87, 0xaa, 0x3e, 0xe0, 0xd6, 0x27,
+	0x58, 0x0c, 0x1a, 0x73, 0x0b, 0xdc, 0x24, 0x1c, 0xb2, 0xc4, 0xff, 0x8b, 0x8e, 0x83, 0x1e, 0x6d,
+	0x6f, 0xea, 0x82, 0x2d, 0x7d, 0xc8, 0xfc, 0x7e, 0x5e, 0xc8, 0xdb, 0xfb, 0x50, 0x61, 0x1f, 0x64,
+	0x5a, 0x8a, 0xfd, 0xc3, 0xa5, 0xdb, 0xb7, 0x92, 0xd9, 0xde, 0x07, 0x0f, 0x0b, 0x20, 0x56, 0x8d,
+	0xa3, 0xbc, 0x17, 0xb0, 0x0b, 0xa3, 0x84, 0x75, 0xa8, 0xfa, 0xc1, 0xf6, 0xae, 0xa9, 0xe0, 0xd2,
+	0xe5, 0xfd, 0xf1, 0x12, 0x95, 0xbd, 0xfd, 0xc2, 0xfc, 0x77, 0x2b, 0x55, 0xf9, 0xd3, 0xff, 0xf1,
+	0x9f, 0x6e, 0xfb, 0x21, 0x54, 0x8f, 0xd5, 0xb3, 0xb8, 0x7c, 0x51, 0xa7, 0x96, 0xfe, 0x67, 0xac,
+	0x61, 0xdb, 0x87, 0x50, 0x67, 0x1f, 0xa4, 0xce, 0xfc, 0x32, 0xca, 0x9f, 0x2d, 0xac, 0xd0, 0xe6,
+	0xb9, 0x15, 0xa5, 0x6b, 0x68, 0x26, 0xf2, 0xbf, 0x01, 0x00, 0xc8, 0xa4, 0xdd, 0x45, 0x58, 0x13,
+	0x00, 0x00,
+}
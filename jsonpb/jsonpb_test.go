@@ -35,6 +35,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 	"math"
 	"reflect"
 	"strings"
@@ -614,6 +615,93 @@ func TestMarshalWithCustomValidation(t *testing.T) {
 	}
 }
 
+// secretObject has fields marked with the network.api.sensitive option.
+type secretObject struct {
+	User     *string       `protobuf:"bytes,1,opt,name=user"`
+	Password *string       `protobuf:"bytes,2,opt,name=password,sensitive"`
+	Tokens   []string      `protobuf:"bytes,3,rep,name=tokens,sensitive"`
+	Anything *anypb.Any    `protobuf:"bytes,4,opt,name=anything"`
+	Inner    *secretObject `protobuf:"bytes,5,opt,name=inner"`
+}
+
+func (m *secretObject) Reset()         { *m = secretObject{} }
+func (m *secretObject) String() string { return proto.CompactTextString(m) }
+func (*secretObject) ProtoMessage()    {}
+
+func init() {
+	proto.RegisterType((*secretObject)(nil), "jsonpb.SecretObject")
+}
+
+func TestMarshalRedactSensitive(t *testing.T) {
+	b, err := proto.Marshal(&secretObject{User: proto.String("u"), Password: proto.String("p")})
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	msg := &secretObject{
+		User:     proto.String("gopher"),
+		Password: proto.String("hunter2"),
+		Tokens:   []string{"t1", "t2"},
+		Anything: &anypb.Any{TypeUrl: "type.googleapis.com/jsonpb.SecretObject", Value: b},
+		Inner:    &secretObject{Password: proto.String("hunter3")},
+	}
+
+	m := &Marshaler{RedactSensitive: true}
+	got, err := m.MarshalToString(msg)
+	if err != nil {
+		t.Fatalf("MarshalToString: %v", err)
+	}
+	want := `{"user":"gopher","password":"[REDACTED]","tokens":["[REDACTED]","[REDACTED]"],` +
+		`"anything":{"@type":"type.googleapis.com/jsonpb.SecretObject","user":"u","password":"[REDACTED]"},` +
+		`"inner":{"password":"[REDACTED]"}}`
+	if got != want {
+		t.Errorf("redacted JSON:\ngot  %s\nwant %s", got, want)
+	}
+
+	got, err = new(Marshaler).MarshalToString(msg)
+	if err != nil {
+		t.Fatalf("MarshalToString: %v", err)
+	}
+	if !strings.Contains(got, `"password":"hunter2"`) {
+		t.Errorf("JSON without RedactSensitive lacks sensitive fields: %s", got)
+	}
+}
+
+var secretsObject = &pb.Secrets3{
+	User:     "gopher",
+	Password: "hunter2",
+	Key:      []byte("key"),
+	Pin:      1234,
+	Numeral:  pb.Numeral_ROMAN,
+	Tokens:   []string{"t1", ""},
+	Keys:     [][]byte{[]byte("k1"), {}},
+	Labels:   map[string]string{"secret": "label"},
+	Inner:    &pb.Simple3{Dub: 1},
+	Choice:   &pb.Secrets3_Code{Code: "code"},
+}
+
+// The redacted JSON of sensitive fields of every type is read back
+// as proto.CloneRedacted leaves the message.
+func TestMarshalRedactSensitiveRoundTrip(t *testing.T) {
+	for _, m := range []*Marshaler{{RedactSensitive: true}, {RedactSensitive: true, EmitDefaults: true}} {
+		js, err := m.MarshalToString(secretsObject)
+		if err != nil {
+			t.Fatalf("MarshalToString: %v", err)
+		}
+		for _, s := range []string{"hunter2", "1234", "ROMAN", "label", `:"code"`} {
+			if strings.Contains(js, s) {
+				t.Errorf("redacted JSON with %+v shows %q: %s", *m, s, js)
+			}
+		}
+		got := new(pb.Secrets3)
+		if err := (&Unmarshaler{Strict: true}).Unmarshal(strings.NewReader(js), got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", js, err)
+		}
+		if want := proto.CloneRedacted(secretsObject); !proto.Equal(got, want) {
+			t.Errorf("Unmarshal(%s):\n got %v\nwant %v", js, got, want)
+		}
+	}
+}
+
 // Test marshaling message containing unset required fields should produce error.
 func TestMarshalUnsetRequiredFields(t *testing.T) {
 	msgExt := &pb.Real{}
@@ -923,6 +1011,515 @@ func TestUnmarshalNext(t *testing.T) {
 	}
 }
 
+func TestLineReaderWriter(t *testing.T) {
+	msgs := []*pb.Simple{
+		{OInt32: proto.Int32(1), OString: proto.String("one")},
+		{},
+		simpleObject,
+	}
+	var b bytes.Buffer
+	w := NewLineWriter(&b, &Marshaler{Indent: "  "})
+	for _, m := range msgs {
+		if err := w.WriteMessage(m); err != nil {
+			t.Fatalf("WriteMessage(%v): %v", m, err)
+		}
+	}
+	if got, want := strings.Count(b.String(), "\n"), len(msgs); got != want {
+		t.Errorf("got %d lines, want %d:\n%s", got, want, b.String())
+	}
+
+	r := NewLineReader(&b, nil)
+	for _, want := range msgs {
+		got := &pb.Simple{OBool: proto.Bool(true)}
+		if err := r.ReadMessage(got); err != nil {
+			t.Fatalf("ReadMessage: %v", err)
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("ReadMessage: got %v, want %v", got, want)
+		}
+	}
+	if err := r.ReadMessage(&pb.Simple{}); err != io.EOF {
+		t.Errorf("ReadMessage at end: got %v, want io.EOF", err)
+	}
+}
+
+func TestFieldWriter(t *testing.T) {
+	header := &pb.Widget{
+		Color:    pb.Widget_BLUE.Enum(),
+		RColor:   []pb.Widget_Color{pb.Widget_RED},
+		Simple:   &pb.Simple{OInt32: proto.Int32(1)},
+		Repeats:  &pb.Repeats{RString: []string{"after"}},
+		RRepeats: []*pb.Repeats{{RInt32: []int32{1}}},
+	}
+	elems := []*pb.Simple{{OString: proto.String("a")}, nil, {}, simpleObject}
+	for _, m := range []*Marshaler{
+		{},
+		{Indent: "  "},
+		{EmitDefaults: true, Indent: "\t", OrigName: true},
+		{RedactSensitive: true},
+	} {
+		for _, n := range []int{0, 1, len(elems)} {
+			var b bytes.Buffer
+			w, err := NewFieldWriter(&b, m, header, "r_simple")
+			if err != nil {
+				t.Fatalf("NewFieldWriter: %v", err)
+			}
+			for _, e := range elems[:n] {
+				if err := w.Write(e); err != nil {
+					t.Fatalf("Write(%v): %v", e, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			full := proto.Clone(header).(*pb.Widget)
+			full.RSimple = elems[:n]
+			if n == 0 {
+				full.RSimple = nil
+			}
+			want, err := m.MarshalToString(full)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := b.String(); got != want {
+				t.Errorf("FieldWriter with %+v and %d elements:\n got %s\nwant %s", *m, n, got, want)
+			}
+		}
+	}
+
+	if _, err := NewFieldWriter(ioutil.Discard, nil, header, "simple"); err == nil {
+		t.Error("NewFieldWriter for a singular field succeeded")
+	}
+	if _, err := NewFieldWriter(ioutil.Discard, nil, header, "bogus"); err == nil {
+		t.Error("NewFieldWriter for an unknown field succeeded")
+	}
+	w, err := NewFieldWriter(ioutil.Discard, nil, header, "r_simple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(&pb.Repeats{}); err == nil {
+		t.Error("Write of an element of the wrong type succeeded")
+	}
+}
+
+func TestFieldReader(t *testing.T) {
+	const in = `{"color":"BLUE","simple":{"oInt32":1},"rSimple":[{"oString":"a"},null,{"oBool":true}],` +
+		`"repeats":{"rString":["after"]}}`
+	header := new(pb.Widget)
+	r, err := NewFieldReader(strings.NewReader(in), nil, header, "r_simple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*pb.Simple
+	for {
+		e := &pb.Simple{OInt64: proto.Int64(7)}
+		err := r.Read(e)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		got = append(got, e)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	want := []*pb.Simple{{OString: proto.String("a")}, {}, {OBool: proto.Bool(true)}}
+	if len(got) != len(want) {
+		t.Fatalf("read %v, want %v", got, want)
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("element %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+	wantHeader := &pb.Widget{
+		Color:   pb.Widget_BLUE.Enum(),
+		Simple:  &pb.Simple{OInt32: proto.Int32(1)},
+		Repeats: &pb.Repeats{RString: []string{"after"}},
+	}
+	if !proto.Equal(header, wantHeader) {
+		t.Errorf("header: got %v, want %v", header, wantHeader)
+	}
+
+	// Close skips the elements that have not been read.
+	header = new(pb.Widget)
+	r, err = NewFieldReader(strings.NewReader(in), nil, header, "r_simple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Read(new(pb.Simple)); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if !proto.Equal(header, wantHeader) {
+		t.Errorf("header: got %v, want %v", header, wantHeader)
+	}
+
+	for _, tt := range []struct {
+		in   string
+		opts proto.UnmarshalOptions
+	}{
+		{in: `{"rSimple":[{}]`},
+		{in: `{"rSimple":{}}`},
+		{in: `{"rSimple":[],"r_simple":[]}`},
+		{in: `{"rSimple":[{"bogus":1}]}`},
+		{in: `{"bogus":1,"rSimple":[]}`},
+		{in: `[]`},
+		{in: ``},
+		{in: `{"rSimple":[{},{}]}`, opts: proto.UnmarshalOptions{MaxElements: 1}},
+	} {
+		r, err := NewFieldReader(strings.NewReader(tt.in), &Unmarshaler{Options: tt.opts}, new(pb.Widget), "r_simple")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for err == nil {
+			err = r.Read(new(pb.Simple))
+		}
+		if err == io.EOF {
+			err = r.Close()
+		}
+		if err == nil {
+			t.Errorf("reading %q succeeded", tt.in)
+		}
+	}
+}
+
+func TestUnmarshalOptionsLimits(t *testing.T) {
+	tests := []struct {
+		desc  string
+		opts  proto.UnmarshalOptions
+		in    string
+		pb    proto.Message
+		limit string // expected LimitError.Limit, or "" for success
+	}{
+		{"depth within limit", proto.UnmarshalOptions{MaxDepth: 3}, `{"submessage":{"submessage":{"name":"x"}}}`, new(proto3pb.Message), ""},
+		{"depth exceeded", proto.UnmarshalOptions{MaxDepth: 2}, `{"submessage":{"submessage":{"name":"x"}}}`, new(proto3pb.Message), proto.LimitDepth},
+		{"Struct depth exceeded", proto.UnmarshalOptions{MaxDepth: 4}, `{"a":{"b":{"c":1}}}`, new(stpb.Struct), proto.LimitDepth},
+		{"bytes exceeded", proto.UnmarshalOptions{MaxBytes: 10}, `{"name":"abcdef"}`, new(proto3pb.Message), proto.LimitBytes},
+		{"elements within limit", proto.UnmarshalOptions{MaxElements: 3}, `{"key":[1,2,3]}`, new(proto3pb.Message), ""},
+		{"elements exceeded", proto.UnmarshalOptions{MaxElements: 3}, `{"key":[1,2,3,4]}`, new(proto3pb.Message), proto.LimitElements},
+		{"map entries exceeded", proto.UnmarshalOptions{MaxElements: 1}, `{"stringMap":{"a":"b","c":"d"}}`, new(proto3pb.Message), proto.LimitElements},
+		{"ListValue elements exceeded", proto.UnmarshalOptions{MaxElements: 1}, `[1,2]`, new(stpb.ListValue), proto.LimitElements},
+		{"unknown fields discarded", proto.UnmarshalOptions{DiscardUnknown: true}, `{"name":"x","bogus":1}`, new(proto3pb.Message), ""},
+	}
+	for _, tt := range tests {
+		u := Unmarshaler{Options: tt.opts}
+		err := u.Unmarshal(strings.NewReader(tt.in), tt.pb)
+		if tt.limit == "" {
+			if err != nil {
+				t.Errorf("%s: Unmarshal: %v", tt.desc, err)
+			}
+			continue
+		}
+		le, ok := err.(*proto.LimitError)
+		if !ok || le.Limit != tt.limit {
+			t.Errorf("%s: Unmarshal: got error %v, want %s LimitError", tt.desc, err, tt.limit)
+		}
+	}
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   string
+		pb   proto.Message
+		path string // expected PathError.Path, or "" for success
+	}{
+		{"valid input", `{"simple":{"oBool":true},"rSimple":[{"oInt32":1}]}`, new(pb.Widget), ""},
+		{"null Value element", `{"lv":[null],"st":{"a":null}}`, new(pb.KnownTypes), ""},
+		{"duplicate key", `{"oBool":true,"oBool":false}`, new(pb.Simple), "$"},
+		{"duplicate nested key", `{"simple":{"oInt32":1,"oInt32":2}}`, new(pb.Widget), "$.simple"},
+		{"duplicate map key", `{"mInt64Str":{"1":"a","1":"b"}}`, new(pb.Maps), "$.mInt64Str"},
+		{"duplicate Struct key", `{"st":{"a":1,"a":2}}`, new(pb.KnownTypes), "$.st"},
+		{"both names", `{"o_bool":true,"oBool":false}`, new(pb.Simple), "$"},
+		{"both names in oneof", `{"home_address":"c","homeAddress":"d"}`, new(pb.MsgWithOneof), "$"},
+		{"null repeated element", `{"rSimple":[{},null]}`, new(pb.Widget), "$.rSimple[1]"},
+		{"null map value", `{"mBoolSimple":{"true":null}}`, new(pb.Maps), `$.mBoolSimple.true`},
+		{"wrong case", `{"OBool":true}`, new(pb.Simple), "$.OBool"},
+		{"bad nested value", `{"rSimple":[{},{"oInt32":"x"}]}`, new(pb.Widget), "$.rSimple[1].oInt32"},
+		{"bad map key", `{"mInt64Str":{"a":"x"}}`, new(pb.Maps), "$.mInt64Str.a"},
+		{"bad map value", `{"mBoolSimple":{"true":{"o bool":1}}}`, new(pb.Maps), `$.mBoolSimple.true["o bool"]`},
+		{"bad Struct value", `{"st":{"a b":{"c":[1,{"d":2,"d":3}]}}}`, new(pb.KnownTypes), `$.st["a b"].c[1]`},
+		{"bad ListValue element", `{"lv":[1,{"a":[{"b":1,"b":2}]}]}`, new(pb.KnownTypes), "$.lv[1].a[0]"},
+		{"bad Any value", `{"an":{"@type":"type.googleapis.com/google.protobuf.Duration","value":"x"}}`, new(pb.KnownTypes), "$.an.value"},
+		{"bad Any field", `{"an":{"@type":"type.googleapis.com/jsonpb.Simple","oInt32":"x"}}`, new(pb.KnownTypes), "$.an.oInt32"},
+		{"unknown field", `{"simple":{"bogus":1}}`, new(pb.Widget), "$.simple.bogus"},
+	}
+	for _, tt := range tests {
+		lenient := Unmarshaler{AllowUnknownFields: strings.Contains(tt.desc, "case")}
+		strict := lenient
+		strict.Strict = true
+		err := strict.Unmarshal(strings.NewReader(tt.in), tt.pb)
+		if tt.path == "" {
+			if err != nil {
+				t.Errorf("%s: Unmarshal: %v", tt.desc, err)
+			}
+			continue
+		}
+		pe, ok := err.(*PathError)
+		if !ok || pe.Path != tt.path {
+			t.Errorf("%s: Unmarshal: got error %v, want PathError at %s", tt.desc, err, tt.path)
+		}
+	}
+
+	// Without Strict, the same input is accepted.
+	for _, in := range []string{`{"o_bool":true,"oBool":false}`, `{"oBool":true,"oBool":false}`} {
+		if err := UnmarshalString(in, new(pb.Simple)); err != nil {
+			t.Errorf("UnmarshalString(%q): %v", in, err)
+		}
+	}
+}
+
+func TestPreserveUnknownFields(t *testing.T) {
+	uf := new(UnknownFields)
+	u := Unmarshaler{UnknownFields: uf}
+	in := &pb.Widget{}
+	if err := u.Unmarshal(strings.NewReader(`{"zNew":{"a": [1, "<b>"]},"simple":{"oBool":true,"added":null},"[jsonpb.later]":2}`), in); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	// Keys are replaced by a later Unmarshal.
+	if err := u.Unmarshal(strings.NewReader(`{"[jsonpb.later]":3}`), in); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got := uf.Get(in.Simple); len(got) != 1 || string(got["added"]) != "null" {
+		t.Errorf("Get(%v) = %s, want the added field", in.Simple, got)
+	}
+
+	tests := []struct {
+		m    Marshaler
+		want string
+	}{
+		{Marshaler{UnknownFields: uf}, `{"simple":{"oBool":true,"added":null},"[jsonpb.later]":3,"zNew":{"a":[1,"<b>"]}}`},
+		{Marshaler{UnknownFields: uf, Indent: "  "}, `{
+  "simple": {
+    "oBool": true,
+    "added": null
+  },
+  "[jsonpb.later]": 3,
+  "zNew": {
+    "a": [
+      1,
+      "<b>"
+    ]
+  }
+}`},
+		// Unknown fields are only written if asked for.
+		{Marshaler{}, `{"simple":{"oBool":true}}`},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.MarshalToString(in)
+		if err != nil {
+			t.Errorf("%+v: MarshalToString: %v", tt.m, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%+v: got\n%s\nwant\n%s", tt.m, got, tt.want)
+		}
+	}
+
+	// The unknown fields stay out of the wire format.
+	if len(in.XXX_unrecognized) > 0 || len(in.Simple.XXX_unrecognized) > 0 {
+		t.Errorf("Unmarshal left unknown fields in the message: %x, %x", in.XXX_unrecognized, in.Simple.XXX_unrecognized)
+	}
+	uf.Delete(in)
+	if got, err := (&Marshaler{UnknownFields: uf}).MarshalToString(in); err != nil || got != `{"simple":{"oBool":true,"added":null}}` {
+		t.Errorf("MarshalToString after Delete = %s, %v", got, err)
+	}
+
+	// Unknown fields are still rejected if the options say so.
+	u.Options.DiscardUnknown = true
+	msg := &pb.Simple{}
+	if err := u.Unmarshal(strings.NewReader(`{"zNew":1}`), msg); err != nil {
+		t.Errorf("Unmarshal with DiscardUnknown: %v", err)
+	}
+	if got := uf.Get(msg); got != nil {
+		t.Errorf("Unmarshal with DiscardUnknown kept %s", got)
+	}
+	u = Unmarshaler{UnknownFields: uf, Strict: true}
+	if err := u.Unmarshal(strings.NewReader(`{"OBool":1}`), new(pb.Simple)); err == nil {
+		t.Error("strict Unmarshal of a field in the wrong case succeeded")
+	}
+}
+
+func TestMarshalStaleUnknownFields(t *testing.T) {
+	// Keys kept before the fields or extensions they name were known
+	// are not written.
+	uf := new(UnknownFields)
+	real := &pb.Real{Value: proto.Float64(1)}
+	if err := proto.SetExtension(real, pb.E_Name, proto.String("Cat")); err != nil {
+		t.Fatal(err)
+	}
+	stale := map[string]json.RawMessage{
+		"value":         json.RawMessage(`2`),
+		"[jsonpb.name]": json.RawMessage(`"Dog"`),
+		"[jsonpb.none]": json.RawMessage(`3`),
+		"zNew":          json.RawMessage(`4`),
+	}
+	if err := uf.keep(real, stale); err != nil {
+		t.Fatal(err)
+	}
+	simple := &pb.Simple{OBool: proto.Bool(true)}
+	if err := uf.keep(simple, map[string]json.RawMessage{"o_bool": json.RawMessage(`false`), "oBool": json.RawMessage(`false`), "OBool": json.RawMessage(`false`)}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		m    Marshaler
+		pb   proto.Message
+		want string
+	}{
+		{Marshaler{UnknownFields: uf}, real, `{"value":1,"[jsonpb.name]":"Cat","[jsonpb.none]":3,"zNew":4}`},
+		{Marshaler{UnknownFields: uf}, simple, `{"oBool":true,"OBool":false}`},
+		{Marshaler{UnknownFields: uf, Naming: UpperCamelCase}, simple, `{"OBool":true}`},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.MarshalToString(tt.pb)
+		if err != nil {
+			t.Errorf("MarshalToString(%v): %v", tt.pb, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("MarshalToString(%v) = %s, want %s", tt.pb, got, tt.want)
+		}
+	}
+}
+
+func TestMarshalForeignUnknownFields(t *testing.T) {
+	// Unknown fields of the wire format are neither written nor dropped.
+	unknown := []byte{0xfa, 0xe1, 0x09, 0x03, 0x12, 0x01, 0x61, 0xff}
+	msg := &pb.Simple{OBool: proto.Bool(true), XXX_unrecognized: unknown}
+	uf := new(UnknownFields)
+	if got, err := (&Marshaler{UnknownFields: uf}).MarshalToString(msg); err != nil || got != `{"oBool":true}` {
+		t.Errorf("MarshalToString = %s, %v; want %s", got, err, `{"oBool":true}`)
+	}
+	u := Unmarshaler{UnknownFields: uf}
+	if err := u.Unmarshal(strings.NewReader(`{"a":1}`), msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !bytes.Equal(msg.XXX_unrecognized, unknown) {
+		t.Errorf("Unmarshal: got unknown fields %x, want %x", msg.XXX_unrecognized, unknown)
+	}
+}
+
+// namedFields is a message with a field whose json_name option sets its name.
+type namedFields struct {
+	FooBar int64  `protobuf:"varint,1,opt,name=foo_bar,json=fooBar" json:"foo_bar,omitempty"`
+	Custom string `protobuf:"bytes,2,opt,name=custom_name,json=renamed" json:"custom_name,omitempty"`
+}
+
+func (m *namedFields) Reset()         { *m = namedFields{} }
+func (m *namedFields) String() string { return proto.CompactTextString(m) }
+func (*namedFields) ProtoMessage()    {}
+
+func TestNamingStrategies(t *testing.T) {
+	names := []struct {
+		naming NamingStrategy
+		in     string
+		want   string
+	}{
+		{LowerCamelCase, "foo_bar", "fooBar"},
+		{LowerCamelCase, "o_int32_str", "oInt32Str"},
+		{LowerCamelCase, "Country", "Country"},
+		{UpperCamelCase, "foo_bar", "FooBar"},
+		{UpperCamelCase, "title", "Title"},
+		{SnakeCase, "foo_bar", "foo_bar"},
+		{SnakeCase, "oInt32Str", "o_int32_str"},
+		{SnakeCase, "Country", "country"},
+		{SnakeCase, "HTTPServer", "http_server"},
+	}
+	for _, tt := range names {
+		if got := tt.naming(tt.in); got != tt.want {
+			t.Errorf("naming %q: got %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	msg := &namedFields{FooBar: 1 << 60, Custom: "x"}
+	marshalers := []struct {
+		m    Marshaler
+		want string
+	}{
+		{Marshaler{}, `{"fooBar":"1152921504606846976","renamed":"x"}`},
+		{Marshaler{Naming: SnakeCase}, `{"foo_bar":"1152921504606846976","renamed":"x"}`},
+		{Marshaler{Naming: UpperCamelCase, Int64sAsNumbers: true}, `{"FooBar":1152921504606846976,"renamed":"x"}`},
+		{Marshaler{Naming: UpperCamelCase, OrigName: true}, `{"foo_bar":"1152921504606846976","custom_name":"x"}`},
+	}
+	for _, tt := range marshalers {
+		got, err := tt.m.MarshalToString(msg)
+		if err != nil || got != tt.want {
+			t.Errorf("%+v: got %s, %v; want %s", tt.m, got, err, tt.want)
+		}
+	}
+	got, err := (&Marshaler{Int64sAsNumbers: true}).MarshalToString(&pb.KnownTypes{I64: &wpb.Int64Value{Value: -1 << 62}})
+	if want := `{"i64":-4611686018427387904}`; err != nil || got != want {
+		t.Errorf("Int64Value as a number: got %s, %v; want %s", got, err, want)
+	}
+
+	unmarshals := []struct {
+		naming NamingStrategy
+		in     string
+		want   *namedFields // nil if unmarshaling fails
+	}{
+		{UpperCamelCase, `{"FooBar":"3","renamed":"y"}`, &namedFields{FooBar: 3, Custom: "y"}},
+		{UpperCamelCase, `{"foo_bar":3,"fooBar":4}`, &namedFields{FooBar: 4}},
+		{UpperCamelCase, `{"FooBar":3,"foo_bar":4}`, &namedFields{FooBar: 3}},
+		{UpperCamelCase, `{"CustomName":"y"}`, nil},
+		{SnakeCase, `{"custom_name":"y"}`, &namedFields{Custom: "y"}},
+		{nil, `{"FooBar":3}`, nil},
+	}
+	for _, tt := range unmarshals {
+		u := Unmarshaler{Naming: tt.naming}
+		got := new(namedFields)
+		err := u.Unmarshal(strings.NewReader(tt.in), got)
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("unmarshaling %s succeeded", tt.in)
+			}
+			continue
+		}
+		if err != nil || !proto.Equal(got, tt.want) {
+			t.Errorf("unmarshaling %s: got %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestFieldOptions(t *testing.T) {
+	msg := &pb.FieldOptions3{
+		Numeral:  pb.Numeral_ROMAN,
+		Name:     pb.Numeral_ARABIC,
+		Numerals: []pb.Numeral{pb.Numeral_ARABIC, pb.Numeral_ROMAN},
+		Count:    1 << 60,
+		Total:    1 << 60,
+		Counts:   []uint64{1 << 60},
+	}
+	tests := []struct {
+		m    Marshaler
+		pb   proto.Message
+		want string
+	}{
+		{Marshaler{}, msg, `{"numeral":2,"name":"ARABIC","numerals":[1,2],"count":1152921504606846976,"total":"1152921504606846976","counts":[1152921504606846976]}`},
+		{Marshaler{EnumsAsInts: true, Int64sAsNumbers: true}, msg, `{"numeral":2,"name":1,"numerals":[1,2],"count":1152921504606846976,"total":1152921504606846976,"counts":[1152921504606846976]}`},
+		{Marshaler{}, &pb.FieldOptions3{}, `{}`},
+		{Marshaler{EmitDefaults: true}, &pb.FieldOptions3{}, `{"numeral":0,"name":"UNKNOWN","numerals":[],"numeralMap":{},"count":0,"total":"0","counts":[]}`},
+		{Marshaler{EmitDefaults: true}, &pb.FieldOptions3{Note: "n", Simple: &pb.Simple3{}}, `{"numeral":0,"name":"UNKNOWN","numerals":[],"numeralMap":{},"count":0,"total":"0","counts":[],"note":"n","simple":{"dub":0}}`},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.MarshalToString(tt.pb)
+		if err != nil || got != tt.want {
+			t.Errorf("marshaling %v with %+v:\n got %s, %v\nwant %s", tt.pb, tt.m, got, err, tt.want)
+		}
+	}
+
+	// The options only concern the writing of JSON.
+	got := new(pb.FieldOptions3)
+	if err := UnmarshalString(`{"numeral":"ROMAN","count":"1152921504606846976"}`, got); err != nil || !proto.Equal(got, &pb.FieldOptions3{Numeral: pb.Numeral_ROMAN, Count: 1 << 60}) {
+		t.Errorf("unmarshaling: got %v, %v", got, err)
+	}
+}
+
 var unmarshalingShouldError = []struct {
 	desc string
 	in   string
@@ -6,6 +6,7 @@ package jsonpb
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import _ "github.com/golang/protobuf/ptypes/network/api"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -41,7 +42,7 @@ func (x Numeral) String() string {
 	return proto.EnumName(Numeral_name, int32(x))
 }
 func (Numeral) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_more_test_objects_bef0d79b901f4c4a, []int{0}
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{0}
 }
 
 type Simple3 struct {
@@ -55,7 +56,7 @@ func (m *Simple3) Reset()         { *m = Simple3{} }
 func (m *Simple3) String() string { return proto.CompactTextString(m) }
 func (*Simple3) ProtoMessage()    {}
 func (*Simple3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_more_test_objects_bef0d79b901f4c4a, []int{0}
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{0}
 }
 func (m *Simple3) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Simple3.Unmarshal(m, b)
@@ -93,7 +94,7 @@ func (m *SimpleSlice3) Reset()         { *m = SimpleSlice3{} }
 func (m *SimpleSlice3) String() string { return proto.CompactTextString(m) }
 func (*SimpleSlice3) ProtoMessage()    {}
 func (*SimpleSlice3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_more_test_objects_bef0d79b901f4c4a, []int{1}
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{1}
 }
 func (m *SimpleSlice3) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SimpleSlice3.Unmarshal(m, b)
@@ -131,7 +132,7 @@ func (m *SimpleMap3) Reset()         { *m = SimpleMap3{} }
 func (m *SimpleMap3) String() string { return proto.CompactTextString(m) }
 func (*SimpleMap3) ProtoMessage()    {}
 func (*SimpleMap3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_more_test_objects_bef0d79b901f4c4a, []int{2}
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{2}
 }
 func (m *SimpleMap3) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SimpleMap3.Unmarshal(m, b)
@@ -169,7 +170,7 @@ func (m *SimpleNull3) Reset()         { *m = SimpleNull3{} }
 func (m *SimpleNull3) String() string { return proto.CompactTextString(m) }
 func (*SimpleNull3) ProtoMessage()    {}
 func (*SimpleNull3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_more_test_objects_bef0d79b901f4c4a, []int{3}
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{3}
 }
 func (m *SimpleNull3) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SimpleNull3.Unmarshal(m, b)
@@ -216,7 +217,7 @@ func (m *Mappy) Reset()         { *m = Mappy{} }
 func (m *Mappy) String() string { return proto.CompactTextString(m) }
 func (*Mappy) ProtoMessage()    {}
 func (*Mappy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_more_test_objects_bef0d79b901f4c4a, []int{4}
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{4}
 }
 func (m *Mappy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Mappy.Unmarshal(m, b)
@@ -306,6 +307,314 @@ func (m *Mappy) GetU64Booly() map[uint64]bool {
 	return nil
 }
 
+type FieldOptions3 struct {
+	Numeral              Numeral            `protobuf:"varint,1,opt,name=numeral,proto3,enum=jsonpb.Numeral" json:"numeral,omitempty"`
+	Name                 Numeral            `protobuf:"varint,2,opt,name=name,proto3,enum=jsonpb.Numeral" json:"name,omitempty"`
+	Numerals             []Numeral          `protobuf:"varint,3,rep,packed,name=numerals,proto3,enum=jsonpb.Numeral" json:"numerals,omitempty"`
+	NumeralMap           map[string]Numeral `protobuf:"bytes,4,rep,name=numeral_map,json=numeralMap,proto3" json:"numeral_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jsonpb.Numeral"`
+	Count                int64              `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Total                uint64             `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Counts               []uint64           `protobuf:"fixed64,7,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	Note                 string             `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	Simple               *Simple3           `protobuf:"bytes,9,opt,name=simple,proto3" json:"simple,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *FieldOptions3) Reset()         { *m = FieldOptions3{} }
+func (m *FieldOptions3) String() string { return proto.CompactTextString(m) }
+func (*FieldOptions3) ProtoMessage()    {}
+func (*FieldOptions3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{5}
+}
+func (m *FieldOptions3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldOptions3.Unmarshal(m, b)
+}
+func (m *FieldOptions3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldOptions3.Marshal(b, m, deterministic)
+}
+func (dst *FieldOptions3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldOptions3.Merge(dst, src)
+}
+func (m *FieldOptions3) XXX_Size() int {
+	return xxx_messageInfo_FieldOptions3.Size(m)
+}
+func (m *FieldOptions3) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldOptions3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldOptions3 proto.InternalMessageInfo
+
+func (m *FieldOptions3) GetNumeral() Numeral {
+	if m != nil {
+		return m.Numeral
+	}
+	return Numeral_UNKNOWN
+}
+
+func (m *FieldOptions3) GetName() Numeral {
+	if m != nil {
+		return m.Name
+	}
+	return Numeral_UNKNOWN
+}
+
+func (m *FieldOptions3) GetNumerals() []Numeral {
+	if m != nil {
+		return m.Numerals
+	}
+	return nil
+}
+
+func (m *FieldOptions3) GetNumeralMap() map[string]Numeral {
+	if m != nil {
+		return m.NumeralMap
+	}
+	return nil
+}
+
+func (m *FieldOptions3) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FieldOptions3) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *FieldOptions3) GetCounts() []uint64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *FieldOptions3) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *FieldOptions3) GetSimple() *Simple3 {
+	if m != nil {
+		return m.Simple
+	}
+	return nil
+}
+
+type Secrets3 struct {
+	User     string            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Password string            `protobuf:"bytes,2,opt,name=password,proto3,sensitive" json:"password,omitempty"`
+	Key      []byte            `protobuf:"bytes,3,opt,name=key,proto3,sensitive" json:"key,omitempty"`
+	Pin      int32             `protobuf:"varint,4,opt,name=pin,proto3,sensitive" json:"pin,omitempty"`
+	Numeral  Numeral           `protobuf:"varint,5,opt,name=numeral,proto3,enum=jsonpb.Numeral,sensitive" json:"numeral,omitempty"`
+	Tokens   []string          `protobuf:"bytes,6,rep,name=tokens,proto3,sensitive" json:"tokens,omitempty"`
+	Keys     [][]byte          `protobuf:"bytes,7,rep,name=keys,proto3,sensitive" json:"keys,omitempty"`
+	Labels   map[string]string `protobuf:"bytes,8,rep,name=labels,proto3,sensitive" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Inner    *Simple3          `protobuf:"bytes,9,opt,name=inner,proto3,sensitive" json:"inner,omitempty"`
+	// Types that are valid to be assigned to Choice:
+	//	*Secrets3_Code
+	//	*Secrets3_Serial
+	Choice               isSecrets3_Choice `protobuf_oneof:"choice"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Secrets3) Reset()         { *m = Secrets3{} }
+func (m *Secrets3) String() string { return proto.CompactTextString(m) }
+func (*Secrets3) ProtoMessage()    {}
+func (*Secrets3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_more_test_objects_fdd4dbb3ed7951af, []int{6}
+}
+func (m *Secrets3) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Secrets3.Unmarshal(m, b)
+}
+func (m *Secrets3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Secrets3.Marshal(b, m, deterministic)
+}
+func (dst *Secrets3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secrets3.Merge(dst, src)
+}
+func (m *Secrets3) XXX_Size() int {
+	return xxx_messageInfo_Secrets3.Size(m)
+}
+func (m *Secrets3) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secrets3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Secrets3 proto.InternalMessageInfo
+
+type isSecrets3_Choice interface {
+	isSecrets3_Choice()
+}
+
+type Secrets3_Code struct {
+	Code string `protobuf:"bytes,10,opt,name=code,proto3,oneof,sensitive"`
+}
+type Secrets3_Serial struct {
+	Serial int64 `protobuf:"varint,11,opt,name=serial,proto3,oneof,sensitive"`
+}
+
+func (*Secrets3_Code) isSecrets3_Choice()   {}
+func (*Secrets3_Serial) isSecrets3_Choice() {}
+
+func (m *Secrets3) GetChoice() isSecrets3_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Secrets3) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Secrets3) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Secrets3) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Secrets3) GetPin() int32 {
+	if m != nil {
+		return m.Pin
+	}
+	return 0
+}
+
+func (m *Secrets3) GetNumeral() Numeral {
+	if m != nil {
+		return m.Numeral
+	}
+	return Numeral_UNKNOWN
+}
+
+func (m *Secrets3) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Secrets3) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Secrets3) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Secrets3) GetInner() *Simple3 {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *Secrets3) GetCode() string {
+	if x, ok := m.GetChoice().(*Secrets3_Code); ok {
+		return x.Code
+	}
+	return ""
+}
+
+func (m *Secrets3) GetSerial() int64 {
+	if x, ok := m.GetChoice().(*Secrets3_Serial); ok {
+		return x.Serial
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Secrets3) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Secrets3_OneofMarshaler, _Secrets3_OneofUnmarshaler, _Secrets3_OneofSizer, []interface{}{
+		(*Secrets3_Code)(nil),
+		(*Secrets3_Serial)(nil),
+	}
+}
+
+func _Secrets3_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Secrets3)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Secrets3_Code:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Code)
+	case *Secrets3_Serial:
+		b.EncodeVarint(11<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Serial))
+	case nil:
+	default:
+		return fmt.Errorf("Secrets3.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Secrets3_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Secrets3)
+	switch tag {
+	case 10: // choice.code
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Choice = &Secrets3_Code{x}
+		return true, err
+	case 11: // choice.serial
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Choice = &Secrets3_Serial{int64(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Secrets3_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Secrets3)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Secrets3_Code:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Code)))
+		n += len(x.Code)
+	case *Secrets3_Serial:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Serial))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 func init() {
 	proto.RegisterType((*Simple3)(nil), "jsonpb.Simple3")
 	proto.RegisterType((*SimpleSlice3)(nil), "jsonpb.SimpleSlice3")
@@ -323,46 +632,74 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "jsonpb.Mappy.StrryEntry")
 	proto.RegisterMapType((map[uint32]bool)(nil), "jsonpb.Mappy.U32boolyEntry")
 	proto.RegisterMapType((map[uint64]bool)(nil), "jsonpb.Mappy.U64boolyEntry")
+	proto.RegisterType((*FieldOptions3)(nil), "jsonpb.FieldOptions3")
+	proto.RegisterMapType((map[string]Numeral)(nil), "jsonpb.FieldOptions3.NumeralMapEntry")
+	proto.RegisterType((*Secrets3)(nil), "jsonpb.Secrets3")
+	proto.RegisterMapType((map[string]string)(nil), "jsonpb.Secrets3.LabelsEntry")
 	proto.RegisterEnum("jsonpb.Numeral", Numeral_name, Numeral_value)
 }
 
 func init() {
-	proto.RegisterFile("more_test_objects.proto", fileDescriptor_more_test_objects_bef0d79b901f4c4a)
-}
-
-var fileDescriptor_more_test_objects_bef0d79b901f4c4a = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdd, 0x6b, 0xdb, 0x3c,
-	0x14, 0x87, 0x5f, 0x27, 0xf5, 0xd7, 0x49, 0xfb, 0x2e, 0x88, 0xb1, 0x99, 0xf4, 0x62, 0xc5, 0xb0,
-	0xad, 0x0c, 0xe6, 0x8b, 0x78, 0x74, 0x5d, 0x77, 0x95, 0x8e, 0x5e, 0x94, 0x11, 0x07, 0x1c, 0xc2,
-	0x2e, 0x4b, 0xdc, 0x99, 0x90, 0xcc, 0x5f, 0xd8, 0xd6, 0xc0, 0xd7, 0xfb, 0xbb, 0x07, 0xe3, 0x48,
-	0x72, 0x2d, 0x07, 0x85, 0x6c, 0x77, 0x52, 0x7e, 0xcf, 0xe3, 0x73, 0x24, 0x1d, 0x02, 0x2f, 0xd3,
-	0xbc, 0x8c, 0x1f, 0xea, 0xb8, 0xaa, 0x1f, 0xf2, 0x68, 0x17, 0x3f, 0xd6, 0x95, 0x57, 0x94, 0x79,
-	0x9d, 0x13, 0x63, 0x57, 0xe5, 0x59, 0x11, 0xb9, 0xe7, 0x60, 0x2e, 0xb7, 0x69, 0x91, 0xc4, 0x3e,
-	0x19, 0xc3, 0xf0, 0x3b, 0x8d, 0x1c, 0xed, 0x42, 0xbb, 0xd4, 0x42, 0x5c, 0xba, 0x6f, 0xe0, 0x94,
-	0x87, 0xcb, 0x64, 0xfb, 0x18, 0xfb, 0xe4, 0x05, 0x18, 0x15, 0xae, 0x2a, 0x47, 0xbb, 0x18, 0x5e,
-	0xda, 0xa1, 0xd8, 0xb9, 0xbf, 0x34, 0x00, 0x0e, 0xce, 0xd7, 0x85, 0x4f, 0x3e, 0x81, 0x59, 0xd5,
-	0xe5, 0x36, 0xdb, 0x34, 0x8c, 0x1b, 0x4d, 0x5f, 0x79, 0xbc, 0x9a, 0xd7, 0x41, 0xde, 0x92, 0x13,
-	0x77, 0x59, 0x5d, 0x36, 0x61, 0xcb, 0x4f, 0x6e, 0xe0, 0x54, 0x0e, 0xb0, 0xa7, 0x1f, 0x71, 0xc3,
-	0x7a, 0xb2, 0x43, 0x5c, 0x92, 0xe7, 0xa0, 0xff, 0x5c, 0x27, 0x34, 0x76, 0x06, 0xec, 0x37, 0xbe,
-	0xb9, 0x19, 0x5c, 0x6b, 0xee, 0x15, 0x8c, 0xf8, 0xf7, 0x03, 0x9a, 0x24, 0x3e, 0x79, 0x0b, 0x46,
-	0xc5, 0xb6, 0xcc, 0x1e, 0x4d, 0x9f, 0xf5, 0x9b, 0xf0, 0x43, 0x11, 0xbb, 0xbf, 0x2d, 0xd0, 0xe7,
-	0xeb, 0xa2, 0x68, 0x88, 0x07, 0x7a, 0x46, 0xd3, 0xb4, 0x6d, 0xdb, 0x69, 0x0d, 0x96, 0x7a, 0x01,
-	0x46, 0xbc, 0x5f, 0x8e, 0x21, 0x5f, 0xd5, 0x65, 0xd9, 0x38, 0x03, 0x15, 0xbf, 0xc4, 0x48, 0xf0,
-	0x0c, 0x43, 0x3e, 0x8f, 0x76, 0xbb, 0xc6, 0x19, 0xaa, 0xf8, 0x05, 0x46, 0x82, 0x67, 0x18, 0xf2,
-	0x11, 0xdd, 0x6c, 0x1a, 0xe7, 0x44, 0xc5, 0xdf, 0x62, 0x24, 0x78, 0x86, 0x31, 0x3e, 0xcf, 0x93,
-	0xc6, 0xd1, 0x95, 0x3c, 0x46, 0x2d, 0x8f, 0x6b, 0xe4, 0xe3, 0x8c, 0xa6, 0x8d, 0x63, 0xa8, 0xf8,
-	0x3b, 0x8c, 0x04, 0xcf, 0x30, 0xf2, 0x11, 0xac, 0xca, 0x9f, 0xf2, 0x12, 0x26, 0x53, 0xce, 0xf7,
-	0x8e, 0x2c, 0x52, 0x6e, 0x3d, 0xc1, 0x4c, 0xbc, 0xfa, 0xc0, 0x45, 0x4b, 0x29, 0x8a, 0xb4, 0x15,
-	0xc5, 0x16, 0x45, 0xda, 0x56, 0xb4, 0x55, 0xe2, 0xaa, 0x5f, 0x91, 0x4a, 0x15, 0x69, 0x5b, 0x11,
-	0x94, 0x62, 0xbf, 0x62, 0x0b, 0x4f, 0xae, 0x01, 0xba, 0x87, 0x96, 0xe7, 0x6f, 0xa8, 0x98, 0x3f,
-	0x5d, 0x9a, 0x3f, 0x34, 0xbb, 0x27, 0xff, 0x97, 0xc9, 0x9d, 0xdc, 0x03, 0x74, 0x8f, 0x2f, 0x9b,
-	0x3a, 0x37, 0x5f, 0xcb, 0xa6, 0x62, 0x92, 0xfb, 0x4d, 0x74, 0x73, 0x71, 0xac, 0x7d, 0x7b, 0xdf,
-	0x7c, 0xba, 0x10, 0xd9, 0xb4, 0x14, 0xa6, 0xb5, 0xd7, 0x7e, 0x37, 0x2b, 0x8a, 0x83, 0xf7, 0xda,
-	0xff, 0xbf, 0x6b, 0x3f, 0xa0, 0x69, 0x5c, 0xae, 0x13, 0xf9, 0x53, 0x9f, 0xe1, 0xac, 0x37, 0x43,
-	0x8a, 0xcb, 0x38, 0xdc, 0x07, 0xca, 0xf2, 0xab, 0x1e, 0x3b, 0xfe, 0xbe, 0xbc, 0x3a, 0x54, 0xf9,
-	0xec, 0x6f, 0xe4, 0x43, 0x95, 0x4f, 0x8e, 0xc8, 0xef, 0xde, 0x83, 0x29, 0x6e, 0x82, 0x8c, 0xc0,
-	0x5c, 0x05, 0x5f, 0x83, 0xc5, 0xb7, 0x60, 0xfc, 0x1f, 0x01, 0x30, 0x66, 0xe1, 0xec, 0xf6, 0xfe,
-	0xcb, 0x58, 0x23, 0x36, 0xe8, 0xe1, 0x62, 0x3e, 0x0b, 0xc6, 0x83, 0xc8, 0x60, 0x7f, 0xe0, 0xfe,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x84, 0x34, 0xaf, 0xdb, 0x05, 0x00, 0x00,
+	proto.RegisterFile("more_test_objects.proto", fileDescriptor_more_test_objects_fdd4dbb3ed7951af)
+}
+
+var fileDescriptor_more_test_objects_fdd4dbb3ed7951af = []byte{
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x18, 0x5d, 0xc7, 0xb1, 0xe3, 0x7c, 0x69, 0x69, 0x34, 0x42, 0x30, 0xca, 0xae, 0x96, 0x2a, 0x68,
+	0xa1, 0x42, 0xda, 0x48, 0xd4, 0x25, 0xec, 0x2e, 0xdc, 0x34, 0x68, 0x11, 0x2b, 0xa8, 0x8b, 0x1c,
+	0x55, 0x5c, 0x56, 0x4e, 0x3a, 0x2a, 0x6e, 0xed, 0x19, 0xcb, 0x63, 0x2f, 0xf2, 0x35, 0x2f, 0x80,
+	0xc4, 0x1d, 0xaf, 0xc5, 0x05, 0x2f, 0xc0, 0x2d, 0x8f, 0x80, 0x84, 0xe6, 0xc7, 0x7f, 0xd5, 0x84,
+	0xdd, 0x6a, 0xaf, 0x32, 0xfe, 0xce, 0x39, 0x33, 0x67, 0xbe, 0x39, 0x1e, 0x07, 0x3e, 0x4c, 0x59,
+	0x4e, 0x2e, 0x0b, 0xc2, 0x8b, 0x4b, 0xb6, 0xb9, 0x21, 0xdb, 0x82, 0x2f, 0xb2, 0x9c, 0x15, 0x0c,
+	0xb9, 0x37, 0x9c, 0xd1, 0x6c, 0x33, 0x03, 0xf1, 0xab, 0x6a, 0xb3, 0x03, 0x4e, 0x28, 0x8f, 0x8b,
+	0xf8, 0x35, 0x51, 0x85, 0xf9, 0x43, 0x18, 0xad, 0xe3, 0x34, 0x4b, 0x88, 0x8f, 0xa6, 0x60, 0x5f,
+	0x95, 0x1b, 0x6c, 0x1d, 0x5a, 0x47, 0x56, 0x28, 0x86, 0xf3, 0x4f, 0x60, 0x4f, 0x81, 0xeb, 0x24,
+	0xde, 0x12, 0x1f, 0x7d, 0x00, 0x2e, 0x17, 0x23, 0x8e, 0xad, 0x43, 0xfb, 0x68, 0x1c, 0xea, 0xa7,
+	0xf9, 0xaf, 0x16, 0x80, 0x22, 0x9e, 0x45, 0x99, 0x8f, 0x9e, 0xc3, 0x88, 0x17, 0x79, 0x4c, 0xaf,
+	0x2b, 0xc9, 0x9b, 0x1c, 0x7f, 0xb4, 0x50, 0x56, 0x16, 0x2d, 0x69, 0xb1, 0x56, 0x8c, 0x97, 0xb4,
+	0xc8, 0xab, 0xb0, 0xe6, 0xcf, 0x5e, 0xc0, 0x5e, 0x17, 0x10, 0x9e, 0x6e, 0x49, 0x25, 0x3d, 0x8d,
+	0x43, 0x31, 0x44, 0xef, 0x83, 0xf3, 0x3a, 0x4a, 0x4a, 0x82, 0x07, 0xb2, 0xa6, 0x1e, 0x5e, 0x0c,
+	0x9e, 0x59, 0xf3, 0x25, 0x4c, 0xd4, 0xfc, 0x41, 0x99, 0x24, 0x3e, 0xfa, 0x14, 0x5c, 0x2e, 0x1f,
+	0xa5, 0x7a, 0x72, 0x7c, 0xd0, 0x37, 0xe1, 0x87, 0x1a, 0x9e, 0xff, 0xeb, 0x81, 0x73, 0x16, 0x65,
+	0x59, 0x85, 0x16, 0xe0, 0xd0, 0x32, 0x4d, 0x6b, 0xdb, 0xb8, 0x56, 0x48, 0x74, 0x11, 0x08, 0x48,
+	0xf9, 0x55, 0x34, 0xc1, 0xe7, 0x45, 0x9e, 0x57, 0x78, 0x60, 0xe2, 0xaf, 0x05, 0xa4, 0xf9, 0x92,
+	0x26, 0xf8, 0x6c, 0x73, 0x73, 0x53, 0x61, 0xdb, 0xc4, 0x3f, 0x17, 0x90, 0xe6, 0x4b, 0x9a, 0xe0,
+	0x6f, 0xca, 0xeb, 0xeb, 0x0a, 0x0f, 0x4d, 0xfc, 0x95, 0x80, 0x34, 0x5f, 0xd2, 0x24, 0x9f, 0xb1,
+	0xa4, 0xc2, 0x8e, 0x91, 0x2f, 0xa0, 0x9a, 0x2f, 0xc6, 0x82, 0x4f, 0x68, 0x99, 0x56, 0xd8, 0x35,
+	0xf1, 0x5f, 0x0a, 0x48, 0xf3, 0x25, 0x0d, 0x7d, 0x09, 0x1e, 0xf7, 0x8f, 0xd5, 0x12, 0x23, 0x29,
+	0x79, 0x78, 0x67, 0xcb, 0x1a, 0x55, 0xaa, 0x86, 0x2c, 0x85, 0xcb, 0x13, 0x25, 0xf4, 0x8c, 0xc2,
+	0xe5, 0x49, 0x4f, 0xb8, 0x3c, 0x69, 0x84, 0x65, 0xbd, 0xe2, 0xd8, 0x24, 0xbc, 0xe8, 0xaf, 0x58,
+	0x76, 0x56, 0x2c, 0xeb, 0x15, 0xc1, 0x28, 0xec, 0xaf, 0x58, 0x93, 0x67, 0xcf, 0x00, 0xda, 0x83,
+	0xee, 0xe6, 0xcf, 0x36, 0xe4, 0xcf, 0xe9, 0xe4, 0x4f, 0x28, 0xdb, 0x23, 0xbf, 0x4f, 0x72, 0x67,
+	0xaf, 0x00, 0xda, 0xc3, 0xef, 0x2a, 0x1d, 0xa5, 0x7c, 0xd2, 0x55, 0x1a, 0x92, 0xdc, 0x37, 0xd1,
+	0xe6, 0xe2, 0x4d, 0xf6, 0xc7, 0x77, 0x95, 0x4d, 0x43, 0xba, 0x4a, 0xcf, 0xa0, 0xf4, 0xee, 0xd8,
+	0x6f, 0xb3, 0x62, 0xd8, 0x78, 0xcf, 0xfe, 0x7b, 0xad, 0xfd, 0xa0, 0x4c, 0x49, 0x1e, 0x25, 0xdd,
+	0xa9, 0xbe, 0x82, 0xfd, 0x5e, 0x86, 0x0c, 0xcd, 0xd8, 0xed, 0x43, 0x88, 0x97, 0x27, 0x66, 0xb1,
+	0xfd, 0x16, 0xe2, 0x8b, 0x5d, 0x2b, 0xef, 0xbf, 0x8d, 0x78, 0xd7, 0xca, 0xc3, 0x37, 0x88, 0xe7,
+	0xff, 0xd8, 0xb0, 0xff, 0x6d, 0x4c, 0x92, 0xab, 0xf3, 0xac, 0x88, 0x19, 0xe5, 0x3e, 0xf2, 0x61,
+	0x44, 0x55, 0x6f, 0xb0, 0x65, 0x6c, 0xd9, 0xca, 0xfb, 0xe3, 0xcf, 0xbf, 0x7f, 0x1f, 0x0c, 0x3c,
+	0x2b, 0xac, 0x99, 0xe8, 0x63, 0x18, 0xd2, 0x28, 0xdd, 0xd9, 0x64, 0x09, 0xa2, 0x2f, 0xc0, 0xd3,
+	0x7c, 0x2e, 0x2f, 0xa1, 0xff, 0x9d, 0xba, 0xa1, 0xa2, 0x1f, 0x61, 0xa2, 0xc7, 0x97, 0x69, 0x94,
+	0xe9, 0xeb, 0xe8, 0x49, 0xad, 0xec, 0x99, 0xaf, 0xe7, 0x39, 0x8b, 0x32, 0xd9, 0x8a, 0xce, 0x7c,
+	0x40, 0x1b, 0x08, 0x3d, 0x06, 0x67, 0xcb, 0x4a, 0x5a, 0x60, 0x47, 0x1c, 0x4e, 0x4d, 0x9a, 0x5a,
+	0xa1, 0x2a, 0x8b, 0x76, 0x15, 0xac, 0x88, 0x12, 0xec, 0xca, 0x16, 0xaa, 0x07, 0x74, 0x08, 0xae,
+	0x84, 0xb9, 0xbc, 0x7e, 0xdc, 0x8e, 0x4c, 0xd7, 0xd1, 0x23, 0x18, 0x52, 0x56, 0x10, 0xec, 0x89,
+	0xf8, 0xd5, 0x38, 0xb6, 0x42, 0x59, 0x45, 0x7e, 0xf3, 0x4d, 0x18, 0x1b, 0xdf, 0xa4, 0x15, 0x48,
+	0xc1, 0x70, 0x6a, 0x61, 0xab, 0xfe, 0x3e, 0xcc, 0x02, 0x38, 0xb8, 0xb3, 0xa7, 0x77, 0xca, 0xf8,
+	0xfc, 0x2f, 0x1b, 0xbc, 0x35, 0xd9, 0xe6, 0xa4, 0xe0, 0x3e, 0x42, 0x30, 0x2c, 0x39, 0xc9, 0xf5,
+	0x54, 0x72, 0x8c, 0xe6, 0xe0, 0x65, 0x11, 0xe7, 0xbf, 0xb0, 0xfc, 0x4a, 0xbd, 0xa6, 0x2b, 0xf7,
+	0x37, 0x61, 0xcb, 0x0a, 0x9b, 0x3a, 0xc2, 0xca, 0x81, 0x7d, 0x68, 0x1d, 0xed, 0x35, 0xb0, 0x74,
+	0x82, 0xc1, 0xce, 0x62, 0x8a, 0x87, 0xe2, 0x8d, 0x69, 0x91, 0x2c, 0xa6, 0xe8, 0xf3, 0x36, 0x56,
+	0x8e, 0x39, 0x56, 0x35, 0xbd, 0x09, 0xd5, 0x63, 0x70, 0x0b, 0x76, 0x4b, 0x28, 0x97, 0x9f, 0x88,
+	0xd6, 0x88, 0xae, 0xa2, 0x19, 0x0c, 0x6f, 0x49, 0xa5, 0x8e, 0xa3, 0xf5, 0x21, 0x6b, 0xe8, 0x6b,
+	0x70, 0x93, 0x68, 0x43, 0x12, 0xae, 0xaf, 0xfc, 0x47, 0x4d, 0xb3, 0xf5, 0xe6, 0x17, 0x3f, 0x48,
+	0x58, 0xc5, 0xa4, 0x99, 0x59, 0x69, 0xd0, 0x53, 0x70, 0x62, 0x4a, 0x49, 0xbe, 0xeb, 0xa4, 0x6a,
+	0xbe, 0x62, 0x89, 0x73, 0xdf, 0xb2, 0x2b, 0x82, 0xa1, 0xdb, 0xaf, 0xef, 0x1e, 0x84, 0xb2, 0x2a,
+	0x72, 0xc3, 0x49, 0x1e, 0x47, 0x09, 0x9e, 0xc8, 0xb8, 0xb5, 0xb8, 0xae, 0xcf, 0x9e, 0xc3, 0xa4,
+	0xe3, 0xe6, 0x3e, 0xb7, 0xf7, 0xca, 0x03, 0x77, 0xfb, 0x33, 0x8b, 0xb7, 0xe4, 0xb3, 0xa7, 0x30,
+	0xd2, 0x9d, 0x44, 0x13, 0x18, 0x5d, 0x04, 0xdf, 0x07, 0xe7, 0x3f, 0x05, 0xd3, 0x07, 0x08, 0xc0,
+	0x3d, 0x0d, 0x4f, 0x57, 0xaf, 0xbe, 0x99, 0x5a, 0x68, 0x0c, 0x4e, 0x78, 0x7e, 0x76, 0x1a, 0x4c,
+	0x07, 0x1b, 0x57, 0xfe, 0x05, 0xf3, 0xff, 0x1b, 0x00, 0xb1, 0xc0, 0xc7, 0xda, 0xc2, 0x09, 0x00,
+	0x00,
 }
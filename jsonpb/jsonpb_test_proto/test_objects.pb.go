@@ -59,7 +59,7 @@ func (x *Widget_Color) UnmarshalJSON(data []byte) error {
 	return nil
 }
 func (Widget_Color) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{3, 0}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{3, 0}
 }
 
 // Test message for holding primitive types.
@@ -92,7 +92,7 @@ func (m *Simple) Reset()         { *m = Simple{} }
 func (m *Simple) String() string { return proto.CompactTextString(m) }
 func (*Simple) ProtoMessage()    {}
 func (*Simple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{0}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{0}
 }
 func (m *Simple) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Simple.Unmarshal(m, b)
@@ -262,7 +262,7 @@ func (m *NonFinites) Reset()         { *m = NonFinites{} }
 func (m *NonFinites) String() string { return proto.CompactTextString(m) }
 func (*NonFinites) ProtoMessage()    {}
 func (*NonFinites) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{1}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{1}
 }
 func (m *NonFinites) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NonFinites.Unmarshal(m, b)
@@ -346,7 +346,7 @@ func (m *Repeats) Reset()         { *m = Repeats{} }
 func (m *Repeats) String() string { return proto.CompactTextString(m) }
 func (*Repeats) ProtoMessage()    {}
 func (*Repeats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{2}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{2}
 }
 func (m *Repeats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Repeats.Unmarshal(m, b)
@@ -460,7 +460,7 @@ func (m *Widget) Reset()         { *m = Widget{} }
 func (m *Widget) String() string { return proto.CompactTextString(m) }
 func (*Widget) ProtoMessage()    {}
 func (*Widget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{3}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{3}
 }
 func (m *Widget) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Widget.Unmarshal(m, b)
@@ -534,7 +534,7 @@ func (m *Maps) Reset()         { *m = Maps{} }
 func (m *Maps) String() string { return proto.CompactTextString(m) }
 func (*Maps) ProtoMessage()    {}
 func (*Maps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{4}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{4}
 }
 func (m *Maps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Maps.Unmarshal(m, b)
@@ -585,7 +585,7 @@ func (m *MsgWithOneof) Reset()         { *m = MsgWithOneof{} }
 func (m *MsgWithOneof) String() string { return proto.CompactTextString(m) }
 func (*MsgWithOneof) ProtoMessage()    {}
 func (*MsgWithOneof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{5}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{5}
 }
 func (m *MsgWithOneof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgWithOneof.Unmarshal(m, b)
@@ -799,7 +799,7 @@ func (m *Real) Reset()         { *m = Real{} }
 func (m *Real) String() string { return proto.CompactTextString(m) }
 func (*Real) ProtoMessage()    {}
 func (*Real) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{6}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{6}
 }
 
 var extRange_Real = []proto.ExtensionRange{
@@ -846,7 +846,7 @@ func (m *Complex) Reset()         { *m = Complex{} }
 func (m *Complex) String() string { return proto.CompactTextString(m) }
 func (*Complex) ProtoMessage()    {}
 func (*Complex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{7}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{7}
 }
 
 var extRange_Complex = []proto.ExtensionRange{
@@ -915,7 +915,7 @@ func (m *KnownTypes) Reset()         { *m = KnownTypes{} }
 func (m *KnownTypes) String() string { return proto.CompactTextString(m) }
 func (*KnownTypes) ProtoMessage()    {}
 func (*KnownTypes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{8}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{8}
 }
 func (m *KnownTypes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KnownTypes.Unmarshal(m, b)
@@ -1052,7 +1052,7 @@ func (m *MsgWithRequired) Reset()         { *m = MsgWithRequired{} }
 func (m *MsgWithRequired) String() string { return proto.CompactTextString(m) }
 func (*MsgWithRequired) ProtoMessage()    {}
 func (*MsgWithRequired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{9}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{9}
 }
 func (m *MsgWithRequired) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgWithRequired.Unmarshal(m, b)
@@ -1092,7 +1092,7 @@ func (m *MsgWithIndirectRequired) Reset()         { *m = MsgWithIndirectRequired
 func (m *MsgWithIndirectRequired) String() string { return proto.CompactTextString(m) }
 func (*MsgWithIndirectRequired) ProtoMessage()    {}
 func (*MsgWithIndirectRequired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{10}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{10}
 }
 func (m *MsgWithIndirectRequired) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgWithIndirectRequired.Unmarshal(m, b)
@@ -1144,7 +1144,7 @@ func (m *MsgWithRequiredBytes) Reset()         { *m = MsgWithRequiredBytes{} }
 func (m *MsgWithRequiredBytes) String() string { return proto.CompactTextString(m) }
 func (*MsgWithRequiredBytes) ProtoMessage()    {}
 func (*MsgWithRequiredBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{11}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{11}
 }
 func (m *MsgWithRequiredBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgWithRequiredBytes.Unmarshal(m, b)
@@ -1182,7 +1182,7 @@ func (m *MsgWithRequiredWKT) Reset()         { *m = MsgWithRequiredWKT{} }
 func (m *MsgWithRequiredWKT) String() string { return proto.CompactTextString(m) }
 func (*MsgWithRequiredWKT) ProtoMessage()    {}
 func (*MsgWithRequiredWKT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_test_objects_a4d3e593ea3c686f, []int{12}
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{12}
 }
 func (m *MsgWithRequiredWKT) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgWithRequiredWKT.Unmarshal(m, b)
@@ -1209,6 +1209,452 @@ func (m *MsgWithRequiredWKT) GetStr() *wrappers.StringValue {
 	return nil
 }
 
+// A MessageSet, whose items are written with the names of their messages.
+type MsgSet struct {
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `protobuf_messageset:"1" json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *MsgSet) Reset()         { *m = MsgSet{} }
+func (m *MsgSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSet) ProtoMessage()    {}
+func (*MsgSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{13}
+}
+
+func (m *MsgSet) MarshalJSON() ([]byte, error) {
+	return proto.MarshalMessageSetJSON(&m.XXX_InternalExtensions)
+}
+func (m *MsgSet) UnmarshalJSON(buf []byte) error {
+	return proto.UnmarshalMessageSetJSON(buf, &m.XXX_InternalExtensions)
+}
+
+var extRange_MsgSet = []proto.ExtensionRange{
+	{Start: 100, End: 536870911},
+}
+
+func (*MsgSet) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_MsgSet
+}
+func (m *MsgSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSet.Unmarshal(m, b)
+}
+func (m *MsgSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSet.Marshal(b, m, deterministic)
+}
+func (dst *MsgSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSet.Merge(dst, src)
+}
+func (m *MsgSet) XXX_Size() int {
+	return xxx_messageInfo_MsgSet.Size(m)
+}
+func (m *MsgSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSet proto.InternalMessageInfo
+
+type MsgSetItem struct {
+	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetItem) Reset()         { *m = MsgSetItem{} }
+func (m *MsgSetItem) String() string { return proto.CompactTextString(m) }
+func (*MsgSetItem) ProtoMessage()    {}
+func (*MsgSetItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{14}
+}
+func (m *MsgSetItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetItem.Unmarshal(m, b)
+}
+func (m *MsgSetItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetItem.Marshal(b, m, deterministic)
+}
+func (dst *MsgSetItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetItem.Merge(dst, src)
+}
+func (m *MsgSetItem) XXX_Size() int {
+	return xxx_messageInfo_MsgSetItem.Size(m)
+}
+func (m *MsgSetItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetItem proto.InternalMessageInfo
+
+func (m *MsgSetItem) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_MsgSetItem_MessageSetExtension = &proto.ExtensionDesc{
+	ExtendedType:  (*MsgSet)(nil),
+	ExtensionType: (*MsgSetItem)(nil),
+	Field:         100,
+	Name:          "jsonpb.MsgSetItem",
+	Tag:           "bytes,100,opt,name=message_set_extension,json=messageSetExtension",
+	Filename:      "test_objects.proto",
+}
+
+// Groups, and extended messages in every position.
+type Extended struct {
+	Real    *Real            `protobuf:"bytes,1,opt,name=real" json:"real,omitempty"`
+	Reals   []*Real          `protobuf:"bytes,2,rep,name=reals" json:"reals,omitempty"`
+	RealMap map[string]*Real `protobuf:"bytes,3,rep,name=real_map,json=realMap" json:"real_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//	*Extended_RealChoice
+	//	*Extended_Choicegroup
+	Choice               isExtended_Choice         `protobuf_oneof:"choice"`
+	Optionalgroup        *Extended_OptionalGroup   `protobuf:"group,7,opt,name=OptionalGroup,json=optionalgroup" json:"optionalgroup,omitempty"`
+	Repeatedgroup        []*Extended_RepeatedGroup `protobuf:"group,10,rep,name=RepeatedGroup,json=repeatedgroup" json:"repeatedgroup,omitempty"`
+	MsgSet               *MsgSet                   `protobuf:"bytes,12,opt,name=msg_set,json=msgSet" json:"msg_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *Extended) Reset()         { *m = Extended{} }
+func (m *Extended) String() string { return proto.CompactTextString(m) }
+func (*Extended) ProtoMessage()    {}
+func (*Extended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{15}
+}
+func (m *Extended) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended.Unmarshal(m, b)
+}
+func (m *Extended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended.Marshal(b, m, deterministic)
+}
+func (dst *Extended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended.Merge(dst, src)
+}
+func (m *Extended) XXX_Size() int {
+	return xxx_messageInfo_Extended.Size(m)
+}
+func (m *Extended) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended proto.InternalMessageInfo
+
+type isExtended_Choice interface {
+	isExtended_Choice()
+}
+
+type Extended_RealChoice struct {
+	RealChoice *Real `protobuf:"bytes,4,opt,name=real_choice,json=realChoice,oneof"`
+}
+type Extended_Choicegroup struct {
+	Choicegroup *Extended_ChoiceGroup `protobuf:"group,5,opt,name=ChoiceGroup,json=choicegroup,oneof"`
+}
+
+func (*Extended_RealChoice) isExtended_Choice()  {}
+func (*Extended_Choicegroup) isExtended_Choice() {}
+
+func (m *Extended) GetChoice() isExtended_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Extended) GetReal() *Real {
+	if m != nil {
+		return m.Real
+	}
+	return nil
+}
+
+func (m *Extended) GetReals() []*Real {
+	if m != nil {
+		return m.Reals
+	}
+	return nil
+}
+
+func (m *Extended) GetRealMap() map[string]*Real {
+	if m != nil {
+		return m.RealMap
+	}
+	return nil
+}
+
+func (m *Extended) GetRealChoice() *Real {
+	if x, ok := m.GetChoice().(*Extended_RealChoice); ok {
+		return x.RealChoice
+	}
+	return nil
+}
+
+func (m *Extended) GetChoicegroup() *Extended_ChoiceGroup {
+	if x, ok := m.GetChoice().(*Extended_Choicegroup); ok {
+		return x.Choicegroup
+	}
+	return nil
+}
+
+func (m *Extended) GetOptionalgroup() *Extended_OptionalGroup {
+	if m != nil {
+		return m.Optionalgroup
+	}
+	return nil
+}
+
+func (m *Extended) GetRepeatedgroup() []*Extended_RepeatedGroup {
+	if m != nil {
+		return m.Repeatedgroup
+	}
+	return nil
+}
+
+func (m *Extended) GetMsgSet() *MsgSet {
+	if m != nil {
+		return m.MsgSet
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Extended) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Extended_OneofMarshaler, _Extended_OneofUnmarshaler, _Extended_OneofSizer, []interface{}{
+		(*Extended_RealChoice)(nil),
+		(*Extended_Choicegroup)(nil),
+	}
+}
+
+func _Extended_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Extended)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Extended_RealChoice:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RealChoice); err != nil {
+			return err
+		}
+	case *Extended_Choicegroup:
+		b.EncodeVarint(5<<3 | proto.WireStartGroup)
+		if err := b.Marshal(x.Choicegroup); err != nil {
+			return err
+		}
+		b.EncodeVarint(5<<3 | proto.WireEndGroup)
+	case nil:
+	default:
+		return fmt.Errorf("Extended.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Extended_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Extended)
+	switch tag {
+	case 4: // choice.real_choice
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Real)
+		err := b.DecodeMessage(msg)
+		m.Choice = &Extended_RealChoice{msg}
+		return true, err
+	case 5: // choice.choicegroup
+		if wire != proto.WireStartGroup {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Extended_ChoiceGroup)
+		err := b.DecodeGroup(msg)
+		m.Choice = &Extended_Choicegroup{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Extended_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Extended)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Extended_RealChoice:
+		s := proto.Size(x.RealChoice)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Extended_Choicegroup:
+		n += 1 // tag and wire
+		n += proto.Size(x.Choicegroup)
+		n += 1 // tag and wire
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Extended_ChoiceGroup struct {
+	Real                 *Real    `protobuf:"bytes,6,opt,name=real" json:"real,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Extended_ChoiceGroup) Reset()         { *m = Extended_ChoiceGroup{} }
+func (m *Extended_ChoiceGroup) String() string { return proto.CompactTextString(m) }
+func (*Extended_ChoiceGroup) ProtoMessage()    {}
+func (*Extended_ChoiceGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{15, 1}
+}
+func (m *Extended_ChoiceGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended_ChoiceGroup.Unmarshal(m, b)
+}
+func (m *Extended_ChoiceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended_ChoiceGroup.Marshal(b, m, deterministic)
+}
+func (dst *Extended_ChoiceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended_ChoiceGroup.Merge(dst, src)
+}
+func (m *Extended_ChoiceGroup) XXX_Size() int {
+	return xxx_messageInfo_Extended_ChoiceGroup.Size(m)
+}
+func (m *Extended_ChoiceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended_ChoiceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended_ChoiceGroup proto.InternalMessageInfo
+
+func (m *Extended_ChoiceGroup) GetReal() *Real {
+	if m != nil {
+		return m.Real
+	}
+	return nil
+}
+
+type Extended_OptionalGroup struct {
+	Real                 *Real    `protobuf:"bytes,8,opt,name=real" json:"real,omitempty"`
+	Tags                 []string `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Extended_OptionalGroup) Reset()         { *m = Extended_OptionalGroup{} }
+func (m *Extended_OptionalGroup) String() string { return proto.CompactTextString(m) }
+func (*Extended_OptionalGroup) ProtoMessage()    {}
+func (*Extended_OptionalGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{15, 2}
+}
+func (m *Extended_OptionalGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended_OptionalGroup.Unmarshal(m, b)
+}
+func (m *Extended_OptionalGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended_OptionalGroup.Marshal(b, m, deterministic)
+}
+func (dst *Extended_OptionalGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended_OptionalGroup.Merge(dst, src)
+}
+func (m *Extended_OptionalGroup) XXX_Size() int {
+	return xxx_messageInfo_Extended_OptionalGroup.Size(m)
+}
+func (m *Extended_OptionalGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended_OptionalGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended_OptionalGroup proto.InternalMessageInfo
+
+func (m *Extended_OptionalGroup) GetReal() *Real {
+	if m != nil {
+		return m.Real
+	}
+	return nil
+}
+
+func (m *Extended_OptionalGroup) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type Extended_RepeatedGroup struct {
+	N                    *int32   `protobuf:"varint,11,opt,name=n" json:"n,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Extended_RepeatedGroup) Reset()         { *m = Extended_RepeatedGroup{} }
+func (m *Extended_RepeatedGroup) String() string { return proto.CompactTextString(m) }
+func (*Extended_RepeatedGroup) ProtoMessage()    {}
+func (*Extended_RepeatedGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{15, 3}
+}
+func (m *Extended_RepeatedGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Extended_RepeatedGroup.Unmarshal(m, b)
+}
+func (m *Extended_RepeatedGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Extended_RepeatedGroup.Marshal(b, m, deterministic)
+}
+func (dst *Extended_RepeatedGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Extended_RepeatedGroup.Merge(dst, src)
+}
+func (m *Extended_RepeatedGroup) XXX_Size() int {
+	return xxx_messageInfo_Extended_RepeatedGroup.Size(m)
+}
+func (m *Extended_RepeatedGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Extended_RepeatedGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Extended_RepeatedGroup proto.InternalMessageInfo
+
+func (m *Extended_RepeatedGroup) GetN() int32 {
+	if m != nil && m.N != nil {
+		return *m.N
+	}
+	return 0
+}
+
+type ExtGroup struct {
+	Label                *string  `protobuf:"bytes,130,opt,name=label" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtGroup) Reset()         { *m = ExtGroup{} }
+func (m *ExtGroup) String() string { return proto.CompactTextString(m) }
+func (*ExtGroup) ProtoMessage()    {}
+func (*ExtGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_test_objects_333c256d5d6b5414, []int{16}
+}
+func (m *ExtGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtGroup.Unmarshal(m, b)
+}
+func (m *ExtGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtGroup.Marshal(b, m, deterministic)
+}
+func (dst *ExtGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtGroup.Merge(dst, src)
+}
+func (m *ExtGroup) XXX_Size() int {
+	return xxx_messageInfo_ExtGroup.Size(m)
+}
+func (m *ExtGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtGroup proto.InternalMessageInfo
+
+func (m *ExtGroup) GetLabel() string {
+	if m != nil && m.Label != nil {
+		return *m.Label
+	}
+	return ""
+}
+
 var E_Name = &proto.ExtensionDesc{
 	ExtendedType:  (*Real)(nil),
 	ExtensionType: (*string)(nil),
@@ -1227,6 +1673,51 @@ var E_Extm = &proto.ExtensionDesc{
 	Filename:      "test_objects.proto",
 }
 
+var E_RealItem = &proto.ExtensionDesc{
+	ExtendedType:  (*MsgSet)(nil),
+	ExtensionType: (*Real)(nil),
+	Field:         101,
+	Name:          "jsonpb.real_item",
+	Tag:           "bytes,101,opt,name=real_item,json=realItem",
+	Filename:      "test_objects.proto",
+}
+
+var E_Reals = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: ([]*Real)(nil),
+	Field:         126,
+	Name:          "jsonpb.reals",
+	Tag:           "bytes,126,rep,name=reals",
+	Filename:      "test_objects.proto",
+}
+
+var E_Color = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*Widget_Color)(nil),
+	Field:         127,
+	Name:          "jsonpb.color",
+	Tag:           "varint,127,opt,name=color,enum=jsonpb.Widget_Color",
+	Filename:      "test_objects.proto",
+}
+
+var E_Counts = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: ([]int64)(nil),
+	Field:         128,
+	Name:          "jsonpb.counts",
+	Tag:           "varint,128,rep,name=counts",
+	Filename:      "test_objects.proto",
+}
+
+var E_Extgroup = &proto.ExtensionDesc{
+	ExtendedType:  (*Real)(nil),
+	ExtensionType: (*ExtGroup)(nil),
+	Field:         129,
+	Name:          "jsonpb.extgroup",
+	Tag:           "group,129,opt,name=ExtGroup,json=extgroup",
+	Filename:      "test_objects.proto",
+}
+
 func init() {
 	proto.RegisterType((*Simple)(nil), "jsonpb.Simple")
 	proto.RegisterType((*NonFinites)(nil), "jsonpb.NonFinites")
@@ -1244,106 +1735,145 @@ func init() {
 	proto.RegisterMapType((map[string]*MsgWithRequired)(nil), "jsonpb.MsgWithIndirectRequired.MapFieldEntry")
 	proto.RegisterType((*MsgWithRequiredBytes)(nil), "jsonpb.MsgWithRequiredBytes")
 	proto.RegisterType((*MsgWithRequiredWKT)(nil), "jsonpb.MsgWithRequiredWKT")
+	proto.RegisterType((*MsgSet)(nil), "jsonpb.MsgSet")
+	proto.RegisterMessageSetType((*MsgSetItem)(nil), 100, "jsonpb.MsgSetItem")
+	proto.RegisterType((*MsgSetItem)(nil), "jsonpb.MsgSetItem")
+	proto.RegisterType((*Extended)(nil), "jsonpb.Extended")
+	proto.RegisterMapType((map[string]*Real)(nil), "jsonpb.Extended.RealMapEntry")
+	proto.RegisterType((*Extended_ChoiceGroup)(nil), "jsonpb.Extended.ChoiceGroup")
+	proto.RegisterType((*Extended_OptionalGroup)(nil), "jsonpb.Extended.OptionalGroup")
+	proto.RegisterType((*Extended_RepeatedGroup)(nil), "jsonpb.Extended.RepeatedGroup")
+	proto.RegisterType((*ExtGroup)(nil), "jsonpb.ExtGroup")
 	proto.RegisterEnum("jsonpb.Widget_Color", Widget_Color_name, Widget_Color_value)
 	proto.RegisterExtension(E_Complex_RealExtension)
+	proto.RegisterExtension(E_MsgSetItem_MessageSetExtension)
 	proto.RegisterExtension(E_Name)
 	proto.RegisterExtension(E_Extm)
-}
-
-func init() { proto.RegisterFile("test_objects.proto", fileDescriptor_test_objects_a4d3e593ea3c686f) }
-
-var fileDescriptor_test_objects_a4d3e593ea3c686f = []byte{
-	// 1460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0x8e, 0x24, 0xcb, 0xb6, 0x8e, 0xf3, 0xd7, 0x6d, 0xda, 0x2a, 0xa1, 0x14, 0x8d, 0x5b, 0x8a,
-	0x69, 0x89, 0x3b, 0x38, 0x1e, 0x4f, 0x29, 0xdc, 0x34, 0x4d, 0x4a, 0x4b, 0xdb, 0xc0, 0x6c, 0x52,
-	0x7a, 0xe9, 0x91, 0x23, 0x39, 0x55, 0x91, 0xb4, 0x66, 0x77, 0x9d, 0xd4, 0x03, 0xcc, 0xe4, 0x19,
-	0x18, 0x9e, 0x80, 0x0b, 0x6e, 0xb9, 0xe3, 0x82, 0xb7, 0xe0, 0x8d, 0x98, 0x3d, 0xbb, 0xf2, 0x5f,
-	0xe2, 0x81, 0x2b, 0x7b, 0xf7, 0xfb, 0xd9, 0xd5, 0x9e, 0x4f, 0x67, 0x05, 0x44, 0xc6, 0x42, 0x76,
-	0x59, 0xef, 0x5d, 0x7c, 0x2c, 0x45, 0x73, 0xc0, 0x99, 0x64, 0xa4, 0xfc, 0x4e, 0xb0, 0x7c, 0xd0,
-	0xdb, 0xda, 0x3c, 0x61, 0xec, 0x24, 0x8d, 0x1f, 0xe0, 0x6c, 0x6f, 0xd8, 0x7f, 0x10, 0xe6, 0x23,
-	0x4d, 0xd9, 0xba, 0x35, 0x0f, 0x45, 0x43, 0x1e, 0xca, 0x84, 0xe5, 0x06, 0xbf, 0x39, 0x8f, 0x0b,
-	0xc9, 0x87, 0xc7, 0xd2, 0xa0, 0x1f, 0xcd, 0xa3, 0x32, 0xc9, 0x62, 0x21, 0xc3, 0x6c, 0xb0, 0xc8,
-	0xfe, 0x8c, 0x87, 0x83, 0x41, 0xcc, 0xcd, 0x0e, 0xeb, 0x7f, 0x96, 0xa0, 0x7c, 0x98, 0x64, 0x83,
-	0x34, 0x26, 0xd7, 0xa0, 0xcc, 0xba, 0x3d, 0xc6, 0x52, 0xdf, 0x0a, 0xac, 0x46, 0x95, 0xba, 0x6c,
-	0x97, 0xb1, 0x94, 0xdc, 0x80, 0x0a, 0xeb, 0x26, 0xb9, 0xdc, 0x69, 0xf9, 0x76, 0x60, 0x35, 0x5c,
-	0x5a, 0x66, 0xcf, 0xd5, 0x88, 0xdc, 0x82, 0x9a, 0x01, 0xba, 0x42, 0x72, 0xdf, 0x41, 0xd0, 0xd3,
-	0xe0, 0xa1, 0xe4, 0x63, 0x61, 0xa7, 0xed, 0x97, 0x02, 0xab, 0xe1, 0x68, 0x61, 0xa7, 0x3d, 0x16,
-	0x76, 0xda, 0x28, 0x74, 0x11, 0xf4, 0x34, 0xa8, 0x84, 0x9b, 0x50, 0x65, 0xdd, 0xa1, 0x5e, 0xb2,
-	0x1c, 0x58, 0x8d, 0x15, 0x5a, 0x61, 0xaf, 0x71, 0x48, 0x02, 0x58, 0x2e, 0x20, 0xd4, 0x56, 0x10,
-	0x06, 0x03, 0xcf, 0x88, 0x3b, 0x6d, 0xbf, 0x1a, 0x58, 0x8d, 0x92, 0x11, 0x77, 0xda, 0x13, 0xb1,
-	0x59, 0xd8, 0x43, 0x18, 0x0c, 0x3c, 0x16, 0x0b, 0xbd, 0x32, 0x04, 0x56, 0xe3, 0x0a, 0xad, 0xb0,
-	0xc3, 0xa9, 0x95, 0xc5, 0x64, 0xe5, 0x1a, 0xc2, 0x60, 0xe0, 0x19, 0x71, 0xa7, 0xed, 0x2f, 0x07,
-	0x56, 0x83, 0x18, 0x71, 0xb1, 0xb2, 0x98, 0xac, 0xbc, 0x82, 0x30, 0x18, 0x78, 0x7c, 0x58, 0xfd,
-	0x94, 0x85, 0xd2, 0x5f, 0x0d, 0xac, 0x86, 0x4d, 0xcb, 0xec, 0xa9, 0x1a, 0xe9, 0xc3, 0x42, 0x00,
-	0x95, 0x6b, 0x08, 0x7a, 0x1a, 0x1c, 0xaf, 0x1a, 0xb1, 0x61, 0x2f, 0x8d, 0xfd, 0xf5, 0xc0, 0x6a,
-	0x58, 0xb4, 0xc2, 0xf6, 0x70, 0xa8, 0x57, 0xd5, 0x10, 0x6a, 0xaf, 0x20, 0x0c, 0x06, 0x9e, 0x6c,
-	0x59, 0xf2, 0x24, 0x3f, 0xf1, 0x49, 0x60, 0x35, 0x3c, 0xb5, 0x65, 0x1c, 0xea, 0x0d, 0xf5, 0x46,
-	0x32, 0x16, 0xfe, 0xd5, 0xc0, 0x6a, 0x2c, 0xd3, 0x32, 0xdb, 0x55, 0xa3, 0xfa, 0xaf, 0x16, 0xc0,
-	0x01, 0xcb, 0x9f, 0x26, 0x79, 0x22, 0x63, 0x41, 0xae, 0x82, 0xdb, 0xef, 0xe6, 0x61, 0x8e, 0xa1,
-	0xb1, 0x69, 0xa9, 0x7f, 0x10, 0xe6, 0x2a, 0x4a, 0xfd, 0xee, 0x20, 0xc9, 0xfb, 0x18, 0x19, 0x9b,
-	0xba, 0xfd, 0xef, 0x92, 0xbc, 0xaf, 0xa7, 0x73, 0x35, 0xed, 0x98, 0xe9, 0x03, 0x35, 0x7d, 0x15,
-	0xdc, 0x08, 0x2d, 0x4a, 0xb8, 0xc1, 0x52, 0x64, 0x2c, 0x22, 0x6d, 0xe1, 0xe2, 0xac, 0x1b, 0x15,
-	0x16, 0x91, 0xb6, 0x28, 0x9b, 0x69, 0x65, 0x51, 0xff, 0xc3, 0x86, 0x0a, 0x8d, 0x07, 0x71, 0x28,
-	0x85, 0xa2, 0xf0, 0x22, 0xc7, 0x8e, 0xca, 0x31, 0x2f, 0x72, 0xcc, 0xc7, 0x39, 0x76, 0x54, 0x8e,
-	0xb9, 0xce, 0x71, 0x01, 0x74, 0xda, 0xbe, 0x13, 0x38, 0x2a, 0xa7, 0x5c, 0xe7, 0x74, 0x13, 0xaa,
-	0xbc, 0xc8, 0x61, 0x29, 0x70, 0x54, 0x0e, 0xb9, 0xc9, 0xe1, 0x18, 0xea, 0xb4, 0x7d, 0x37, 0x70,
-	0x54, 0xca, 0xb8, 0x49, 0x19, 0x42, 0xa2, 0x48, 0xaf, 0xa3, 0x32, 0xc4, 0x0f, 0xa7, 0x54, 0x26,
-	0x21, 0x95, 0xc0, 0x51, 0x09, 0xe1, 0x26, 0x21, 0xb8, 0x09, 0x5d, 0xff, 0x6a, 0xe0, 0xa8, 0xfa,
-	0x73, 0x5d, 0x7f, 0xd4, 0x98, 0xfa, 0x7a, 0x81, 0xa3, 0xea, 0xcb, 0x4d, 0x7d, 0xb5, 0x9d, 0xae,
-	0x1e, 0x04, 0x8e, 0xaa, 0x1e, 0x9f, 0x54, 0x8f, 0x9b, 0xea, 0xd5, 0x02, 0x47, 0x55, 0x8f, 0xeb,
-	0xea, 0xfd, 0x65, 0x43, 0xf9, 0x4d, 0x12, 0x9d, 0xc4, 0x92, 0xdc, 0x03, 0xf7, 0x98, 0xa5, 0x8c,
-	0x63, 0xe5, 0x56, 0x5b, 0x1b, 0x4d, 0xdd, 0xac, 0x9a, 0x1a, 0x6e, 0x3e, 0x51, 0x18, 0xd5, 0x14,
-	0xb2, 0xad, 0xfc, 0x34, 0x5b, 0x1d, 0xde, 0x22, 0x76, 0x99, 0xe3, 0x2f, 0xb9, 0x0b, 0x65, 0x81,
-	0x4d, 0x05, 0xdf, 0xa2, 0x5a, 0x6b, 0xb5, 0x60, 0xeb, 0x56, 0x43, 0x0d, 0x4a, 0x3e, 0xd5, 0x07,
-	0x82, 0x4c, 0xb5, 0xcf, 0x8b, 0x4c, 0x75, 0x40, 0x86, 0x5a, 0xe1, 0xba, 0xc0, 0xfe, 0x06, 0x7a,
-	0xae, 0x15, 0x4c, 0x53, 0x77, 0x5a, 0xe0, 0xe4, 0x33, 0xf0, 0x78, 0xb7, 0x20, 0x5f, 0x43, 0xdb,
-	0x0b, 0xe4, 0x2a, 0x37, 0xff, 0xea, 0x1f, 0x83, 0xab, 0x37, 0x5d, 0x01, 0x87, 0xee, 0xef, 0xad,
-	0x2f, 0x11, 0x0f, 0xdc, 0xaf, 0xe9, 0xfe, 0xfe, 0xc1, 0xba, 0x45, 0xaa, 0x50, 0xda, 0x7d, 0xf9,
-	0x7a, 0x7f, 0xdd, 0xae, 0xff, 0x66, 0x43, 0xe9, 0x55, 0x38, 0x10, 0xe4, 0x4b, 0xa8, 0x65, 0x53,
-	0xdd, 0xcb, 0x42, 0xff, 0x0f, 0x0a, 0x7f, 0x45, 0x69, 0xbe, 0x2a, 0x5a, 0xd9, 0x7e, 0x2e, 0xf9,
-	0x88, 0x7a, 0xd9, 0xb8, 0xb5, 0x3d, 0x86, 0x95, 0x0c, 0xb3, 0x59, 0x3c, 0xb5, 0x8d, 0xf2, 0x0f,
-	0x67, 0xe5, 0x2a, 0xaf, 0xfa, 0xb1, 0xb5, 0x41, 0x2d, 0x9b, 0xcc, 0x6c, 0x7d, 0x05, 0xab, 0xb3,
-	0xfe, 0x64, 0x1d, 0x9c, 0x1f, 0xe2, 0x11, 0x96, 0xd1, 0xa1, 0xea, 0x2f, 0xd9, 0x00, 0xf7, 0x34,
-	0x4c, 0x87, 0x31, 0xbe, 0x7e, 0x1e, 0xd5, 0x83, 0x47, 0xf6, 0x43, 0x6b, 0xeb, 0x00, 0xd6, 0xe7,
-	0xed, 0xa7, 0xf5, 0x55, 0xad, 0xbf, 0x33, 0xad, 0xbf, 0x58, 0x94, 0x89, 0x5f, 0xfd, 0x1f, 0x0b,
-	0x96, 0x5f, 0x89, 0x93, 0x37, 0x89, 0x7c, 0xfb, 0x6d, 0x1e, 0xb3, 0x3e, 0xb9, 0x0e, 0xae, 0x4c,
-	0x64, 0x1a, 0xa3, 0x9d, 0xf7, 0x6c, 0x89, 0xea, 0x21, 0xf1, 0xa1, 0x2c, 0xc2, 0x34, 0xe4, 0x23,
-	0xf4, 0x74, 0x9e, 0x2d, 0x51, 0x33, 0x26, 0x5b, 0x50, 0x79, 0xc2, 0x86, 0x6a, 0x27, 0xd8, 0x16,
-	0x94, 0xa6, 0x98, 0x20, 0xb7, 0x61, 0xf9, 0x2d, 0xcb, 0xe2, 0x6e, 0x18, 0x45, 0x3c, 0x16, 0x02,
-	0x3b, 0x84, 0x22, 0xd4, 0xd4, 0xec, 0x63, 0x3d, 0x49, 0xf6, 0xe1, 0x4a, 0x26, 0x4e, 0xba, 0x67,
-	0x89, 0x7c, 0xdb, 0xe5, 0xf1, 0x8f, 0xc3, 0x84, 0xc7, 0x11, 0x76, 0x8d, 0x5a, 0xeb, 0xc6, 0xf8,
-	0x60, 0xf5, 0x1e, 0xa9, 0x81, 0x9f, 0x2d, 0xd1, 0xb5, 0x6c, 0x76, 0x6a, 0xb7, 0x02, 0xee, 0x30,
-	0x4f, 0x58, 0x5e, 0xbf, 0x0b, 0x25, 0x1a, 0x87, 0xe9, 0xe4, 0x14, 0x2d, 0xdd, 0x6a, 0x70, 0x70,
-	0xaf, 0x5a, 0x8d, 0xd6, 0xcf, 0xcf, 0xcf, 0xcf, 0xed, 0xfa, 0x99, 0xda, 0xb8, 0x3a, 0x90, 0xf7,
-	0xe4, 0x26, 0x78, 0x49, 0x16, 0x9e, 0x24, 0xb9, 0x7a, 0x40, 0x4d, 0x9f, 0x4c, 0x4c, 0x24, 0xad,
-	0x3d, 0x58, 0xe5, 0x71, 0x98, 0x76, 0xe3, 0xf7, 0x32, 0xce, 0x45, 0xc2, 0x72, 0xb2, 0x3c, 0x49,
-	0x66, 0x98, 0xfa, 0x3f, 0xcd, 0x46, 0xdb, 0xd8, 0xd3, 0x15, 0x25, 0xda, 0x2f, 0x34, 0xf5, 0xbf,
-	0x5d, 0x80, 0x17, 0x39, 0x3b, 0xcb, 0x8f, 0x46, 0x83, 0x58, 0x90, 0x3b, 0x60, 0x87, 0x39, 0x5e,
-	0x1b, 0xb5, 0xd6, 0x46, 0x53, 0x5f, 0xf8, 0xcd, 0xe2, 0xc2, 0x6f, 0x3e, 0xce, 0x47, 0xd4, 0x0e,
-	0x73, 0x72, 0x1f, 0x9c, 0x68, 0xa8, 0x5f, 0xf6, 0x5a, 0x6b, 0xf3, 0x02, 0x6d, 0xcf, 0x7c, 0x76,
-	0x50, 0xc5, 0x22, 0x9f, 0x80, 0x2d, 0x24, 0xde, 0x62, 0xea, 0x0c, 0xe7, 0xb9, 0x87, 0xf8, 0x09,
-	0x42, 0x6d, 0xa1, 0x9a, 0x88, 0x2d, 0x85, 0x89, 0xc9, 0xd6, 0x05, 0xe2, 0x51, 0xf1, 0x35, 0x42,
-	0x6d, 0x29, 0x14, 0x37, 0x3d, 0xc5, 0x1b, 0xec, 0x32, 0xee, 0xcb, 0x44, 0xc8, 0xef, 0xd5, 0x09,
-	0x53, 0x3b, 0x3d, 0x25, 0x0d, 0x70, 0x4e, 0xc3, 0x14, 0x6f, 0xb4, 0x5a, 0xeb, 0xfa, 0x05, 0xb2,
-	0x26, 0x2a, 0x0a, 0x69, 0x82, 0x13, 0xf5, 0x52, 0x8c, 0x4e, 0xad, 0x75, 0xf3, 0xe2, 0x73, 0x61,
-	0xaf, 0x34, 0xfc, 0xa8, 0x97, 0x92, 0x6d, 0x70, 0xfa, 0xa9, 0xc4, 0x24, 0xa9, 0xf7, 0x76, 0x9e,
-	0x8f, 0x5d, 0xd7, 0xd0, 0xfb, 0xa9, 0x54, 0xf4, 0x04, 0x9b, 0xfc, 0xe5, 0x74, 0x7c, 0x13, 0x0d,
-	0x3d, 0xe9, 0xb4, 0xd5, 0x6e, 0x86, 0x9d, 0x36, 0x5e, 0x4e, 0x97, 0xed, 0xe6, 0xf5, 0x34, 0x7f,
-	0xd8, 0x69, 0xa3, 0xfd, 0x4e, 0x0b, 0xbf, 0x63, 0x16, 0xd8, 0xef, 0xb4, 0x0a, 0xfb, 0x9d, 0x16,
-	0xda, 0xef, 0xb4, 0xf0, 0xc3, 0x66, 0x91, 0xfd, 0x98, 0x3f, 0x44, 0x7e, 0x09, 0x6f, 0x42, 0x6f,
-	0xc1, 0xa1, 0xab, 0x56, 0xa0, 0xe9, 0xc8, 0x53, 0xfe, 0xaa, 0xa9, 0xc1, 0x02, 0x7f, 0x7d, 0xbb,
-	0x18, 0x7f, 0x21, 0x39, 0xf9, 0x1c, 0xdc, 0xe2, 0x96, 0xb9, 0xfc, 0x01, 0xf0, 0xd6, 0xd1, 0x02,
-	0xcd, 0xac, 0xdf, 0x86, 0xb5, 0xb9, 0x97, 0x51, 0x35, 0x20, 0xdd, 0x4a, 0xed, 0x86, 0x87, 0xbe,
-	0xf5, 0xdf, 0x6d, 0xb8, 0x61, 0x58, 0xcf, 0xf3, 0x28, 0xe1, 0xf1, 0xb1, 0x1c, 0xb3, 0xef, 0x43,
-	0x49, 0x0c, 0x7b, 0x99, 0x49, 0xf2, 0xa2, 0x37, 0x9c, 0x22, 0x89, 0x7c, 0x03, 0x5e, 0x16, 0x0e,
-	0xba, 0xfd, 0x24, 0x4e, 0x23, 0xd3, 0x6c, 0xb7, 0xe7, 0x14, 0xf3, 0x0b, 0xa8, 0x26, 0xfc, 0x54,
-	0xf1, 0x75, 0xf3, 0xad, 0x66, 0x66, 0x48, 0x1e, 0x42, 0x4d, 0xa4, 0xc9, 0x71, 0x6c, 0xdc, 0x1c,
-	0x74, 0x5b, 0xb8, 0x3e, 0x20, 0x17, 0x95, 0x5b, 0x47, 0xb0, 0x32, 0x63, 0x3a, 0xdd, 0x72, 0x3d,
-	0xdd, 0x72, 0xb7, 0x67, 0x5b, 0xee, 0x42, 0xdb, 0xa9, 0xde, 0x7b, 0x0f, 0x36, 0xe6, 0x50, 0x3c,
-	0x6d, 0x42, 0xa0, 0xd4, 0x1b, 0x49, 0x81, 0xe7, 0xb9, 0x4c, 0xf1, 0x7f, 0x7d, 0x0f, 0xc8, 0x1c,
-	0xf7, 0xcd, 0x8b, 0xa3, 0xa2, 0xdc, 0x8a, 0xf8, 0x7f, 0xca, 0xfd, 0x28, 0x80, 0x52, 0x1e, 0x66,
-	0xf1, 0x5c, 0xd3, 0xfa, 0x19, 0x9f, 0x02, 0x91, 0x47, 0x5f, 0x40, 0x29, 0x7e, 0x2f, 0xb3, 0x39,
-	0xc6, 0x2f, 0xff, 0x51, 0x2a, 0x25, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xd4, 0xfd, 0x2f,
-	0x41, 0x0d, 0x00, 0x00,
+	proto.RegisterExtension(E_RealItem)
+	proto.RegisterExtension(E_Reals)
+	proto.RegisterExtension(E_Color)
+	proto.RegisterExtension(E_Counts)
+	proto.RegisterExtension(E_Extgroup)
+}
+
+func init() { proto.RegisterFile("test_objects.proto", fileDescriptor_test_objects_333c256d5d6b5414) }
+
+var fileDescriptor_test_objects_333c256d5d6b5414 = []byte{
+	// 1843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4b, 0x77, 0xdb, 0xb8,
+	0x15, 0x0e, 0x49, 0x51, 0x8f, 0x2b, 0xf9, 0x11, 0xc4, 0x49, 0x18, 0x35, 0x93, 0xb2, 0x9a, 0x97,
+	0x26, 0xd3, 0x38, 0xad, 0xac, 0xa3, 0x93, 0xd1, 0x74, 0xd1, 0x38, 0x76, 0xc6, 0xe9, 0x8c, 0x3d,
+	0x3d, 0x70, 0x32, 0x59, 0xea, 0x50, 0x26, 0xa4, 0x70, 0xca, 0x87, 0x0a, 0x40, 0x89, 0x7d, 0xfa,
+	0x72, 0xfb, 0x13, 0x7a, 0xba, 0xed, 0xa6, 0x8b, 0x6e, 0xbb, 0xeb, 0xa2, 0xff, 0xa2, 0xff, 0xa8,
+	0x07, 0x17, 0xa0, 0x24, 0xd2, 0x56, 0xa6, 0x2b, 0x11, 0xf8, 0x1e, 0x17, 0xc4, 0xbd, 0xb8, 0xa0,
+	0x80, 0x48, 0x26, 0xe4, 0x28, 0x1b, 0x7f, 0xcf, 0xce, 0xa4, 0xd8, 0x9d, 0xf1, 0x4c, 0x66, 0xa4,
+	0xfa, 0xbd, 0xc8, 0xd2, 0xd9, 0xb8, 0x7d, 0x6f, 0x9a, 0x65, 0xd3, 0x98, 0x3d, 0xc6, 0xd9, 0xf1,
+	0x7c, 0xf2, 0x38, 0x48, 0x2f, 0x34, 0xa5, 0xfd, 0xa0, 0x0c, 0x85, 0x73, 0x1e, 0xc8, 0x28, 0x4b,
+	0x0d, 0x7e, 0xbf, 0x8c, 0x0b, 0xc9, 0xe7, 0x67, 0xd2, 0xa0, 0x3f, 0x2e, 0xa3, 0x32, 0x4a, 0x98,
+	0x90, 0x41, 0x32, 0x5b, 0x67, 0xff, 0x8e, 0x07, 0xb3, 0x19, 0xe3, 0x66, 0x85, 0x9d, 0x7f, 0x55,
+	0xa0, 0x7a, 0x1a, 0x25, 0xb3, 0x98, 0x91, 0xdb, 0x50, 0xcd, 0x46, 0xe3, 0x2c, 0x8b, 0x3d, 0xcb,
+	0xb7, 0xba, 0x75, 0xea, 0x66, 0xfb, 0x59, 0x16, 0x93, 0xbb, 0x50, 0xcb, 0x46, 0x51, 0x2a, 0xf7,
+	0x7a, 0x9e, 0xed, 0x5b, 0x5d, 0x97, 0x56, 0xb3, 0x17, 0x6a, 0x44, 0x1e, 0x40, 0xd3, 0x00, 0x23,
+	0x21, 0xb9, 0xe7, 0x20, 0xd8, 0xd0, 0xe0, 0xa9, 0xe4, 0x0b, 0xe1, 0xa0, 0xef, 0x55, 0x7c, 0xab,
+	0xeb, 0x68, 0xe1, 0xa0, 0xbf, 0x10, 0x0e, 0xfa, 0x28, 0x74, 0x11, 0x6c, 0x68, 0x50, 0x09, 0xef,
+	0x41, 0x3d, 0x1b, 0xcd, 0x75, 0xc8, 0xaa, 0x6f, 0x75, 0x37, 0x68, 0x2d, 0x7b, 0x85, 0x43, 0xe2,
+	0x43, 0x2b, 0x87, 0x50, 0x5b, 0x43, 0x18, 0x0c, 0x5c, 0x10, 0x0f, 0xfa, 0x5e, 0xdd, 0xb7, 0xba,
+	0x15, 0x23, 0x1e, 0xf4, 0x97, 0x62, 0x13, 0xb8, 0x81, 0x30, 0x18, 0x78, 0x21, 0x16, 0x3a, 0x32,
+	0xf8, 0x56, 0xf7, 0x26, 0xad, 0x65, 0xa7, 0x2b, 0x91, 0xc5, 0x32, 0x72, 0x13, 0x61, 0x30, 0x70,
+	0x41, 0x3c, 0xe8, 0x7b, 0x2d, 0xdf, 0xea, 0x12, 0x23, 0xce, 0x23, 0x8b, 0x65, 0xe4, 0x0d, 0x84,
+	0xc1, 0xc0, 0x8b, 0xcd, 0x9a, 0xc4, 0x59, 0x20, 0xbd, 0x4d, 0xdf, 0xea, 0xda, 0xb4, 0x9a, 0x3d,
+	0x57, 0x23, 0xbd, 0x59, 0x08, 0xa0, 0x72, 0x0b, 0xc1, 0x86, 0x06, 0x17, 0x51, 0xc3, 0x6c, 0x3e,
+	0x8e, 0x99, 0xb7, 0xed, 0x5b, 0x5d, 0x8b, 0xd6, 0xb2, 0x03, 0x1c, 0xea, 0xa8, 0x1a, 0x42, 0xed,
+	0x4d, 0x84, 0xc1, 0xc0, 0xcb, 0x25, 0x4b, 0x1e, 0xa5, 0x53, 0x8f, 0xf8, 0x56, 0xb7, 0xa1, 0x96,
+	0x8c, 0x43, 0xbd, 0xa0, 0xf1, 0x85, 0x64, 0xc2, 0xbb, 0xe5, 0x5b, 0xdd, 0x16, 0xad, 0x66, 0xfb,
+	0x6a, 0xd4, 0xf9, 0xab, 0x05, 0x70, 0x92, 0xa5, 0xcf, 0xa3, 0x34, 0x92, 0x4c, 0x90, 0x5b, 0xe0,
+	0x4e, 0x46, 0x69, 0x90, 0x62, 0xd1, 0xd8, 0xb4, 0x32, 0x39, 0x09, 0x52, 0x55, 0x4a, 0x93, 0xd1,
+	0x2c, 0x4a, 0x27, 0x58, 0x32, 0x36, 0x75, 0x27, 0xbf, 0x8e, 0xd2, 0x89, 0x9e, 0x4e, 0xd5, 0xb4,
+	0x63, 0xa6, 0x4f, 0xd4, 0xf4, 0x2d, 0x70, 0x43, 0xb4, 0xa8, 0xe0, 0x02, 0x2b, 0xa1, 0xb1, 0x08,
+	0xb5, 0x85, 0x8b, 0xb3, 0x6e, 0x98, 0x5b, 0x84, 0xda, 0xa2, 0x6a, 0xa6, 0x95, 0x45, 0xe7, 0x9f,
+	0x36, 0xd4, 0x28, 0x9b, 0xb1, 0x40, 0x0a, 0x45, 0xe1, 0x79, 0x1d, 0x3b, 0xaa, 0x8e, 0x79, 0x5e,
+	0xc7, 0x7c, 0x51, 0xc7, 0x8e, 0xaa, 0x63, 0xae, 0xeb, 0x38, 0x07, 0x06, 0x7d, 0xcf, 0xf1, 0x9d,
+	0xae, 0xa3, 0x81, 0x41, 0x5f, 0xed, 0x0e, 0xcf, 0xeb, 0xb0, 0xe2, 0x3b, 0xaa, 0x0e, 0xb9, 0xa9,
+	0xc3, 0x05, 0x34, 0xe8, 0x7b, 0xae, 0xef, 0x74, 0x2b, 0x06, 0xca, 0x55, 0x22, 0xaf, 0x5e, 0x47,
+	0xd5, 0x10, 0x3f, 0x5d, 0x51, 0x99, 0x0a, 0xa9, 0xf9, 0x4e, 0x97, 0x18, 0x68, 0xd0, 0xd7, 0x8b,
+	0xd0, 0xf9, 0xaf, 0xfb, 0x8e, 0xca, 0x3f, 0xd7, 0xf9, 0x47, 0x8d, 0xc9, 0x6f, 0xc3, 0x77, 0x54,
+	0x7e, 0xb9, 0xc9, 0xaf, 0xb6, 0xd3, 0xd9, 0x03, 0xdf, 0x51, 0xd9, 0xe3, 0xcb, 0xec, 0x71, 0x93,
+	0xbd, 0xa6, 0xef, 0xa8, 0xec, 0x71, 0x9d, 0xbd, 0x7f, 0xdb, 0x50, 0x7d, 0x1d, 0x85, 0x53, 0x26,
+	0xc9, 0x43, 0x70, 0xcf, 0xb2, 0x38, 0xe3, 0x98, 0xb9, 0xcd, 0xde, 0xce, 0xae, 0x6e, 0x56, 0xbb,
+	0x1a, 0xde, 0x7d, 0xa6, 0x30, 0xaa, 0x29, 0xe4, 0x91, 0xf2, 0xd3, 0x6c, 0xb5, 0x79, 0xeb, 0xd8,
+	0x55, 0x8e, 0xbf, 0xe4, 0x13, 0xa8, 0x0a, 0x6c, 0x2a, 0x78, 0x8a, 0x9a, 0xbd, 0xcd, 0x9c, 0xad,
+	0x5b, 0x0d, 0x35, 0x28, 0xf9, 0x4c, 0x6f, 0x08, 0x32, 0xd5, 0x3a, 0xaf, 0x32, 0x6b, 0x5c, 0x3f,
+	0x90, 0xcf, 0xa0, 0xc6, 0x75, 0x82, 0xbd, 0x1d, 0xf4, 0xdc, 0xca, 0x99, 0x26, 0xef, 0x34, 0xc7,
+	0xc9, 0x4f, 0xa1, 0xc1, 0x47, 0x39, 0xf9, 0xb6, 0xef, 0x5c, 0x47, 0xae, 0x73, 0xf3, 0xd4, 0xf9,
+	0x18, 0x5c, 0xbd, 0xe8, 0x1a, 0x38, 0xf4, 0xf0, 0x60, 0xfb, 0x06, 0x69, 0x80, 0xfb, 0x15, 0x3d,
+	0x3c, 0x3c, 0xd9, 0xb6, 0x48, 0x1d, 0x2a, 0xfb, 0xdf, 0xbc, 0x3a, 0xdc, 0xb6, 0x3b, 0x7f, 0xb3,
+	0xa1, 0x72, 0x1c, 0xcc, 0x04, 0xf9, 0x12, 0x9a, 0xc9, 0x4a, 0xf7, 0xb2, 0xd0, 0xff, 0x47, 0xb9,
+	0xbf, 0xa2, 0xec, 0x1e, 0xe7, 0xad, 0xec, 0x30, 0x95, 0xfc, 0x82, 0x36, 0x92, 0x7c, 0x4c, 0x9e,
+	0xc2, 0x46, 0x82, 0xb5, 0x99, 0xbf, 0xb5, 0x8d, 0xf2, 0x0f, 0x8a, 0x72, 0x55, 0xaf, 0xfa, 0xb5,
+	0xb5, 0x41, 0x33, 0x59, 0xce, 0xb4, 0x7f, 0x01, 0x9b, 0x45, 0x7f, 0xb2, 0x0d, 0xce, 0x6f, 0xd8,
+	0x05, 0xa6, 0xd1, 0xa1, 0xea, 0x91, 0xec, 0x80, 0xfb, 0x36, 0x88, 0xe7, 0x0c, 0x8f, 0x5f, 0x83,
+	0xea, 0xc1, 0xd0, 0x7e, 0x62, 0xb5, 0x4f, 0x60, 0xbb, 0x6c, 0xbf, 0xaa, 0xaf, 0x6b, 0xfd, 0x47,
+	0xab, 0xfa, 0xab, 0x49, 0x59, 0xfa, 0x75, 0xfe, 0x6b, 0x41, 0xeb, 0x58, 0x4c, 0x5f, 0x47, 0xf2,
+	0xcd, 0xb7, 0x29, 0xcb, 0x26, 0xe4, 0x0e, 0xb8, 0x32, 0x92, 0x31, 0x43, 0xbb, 0xc6, 0xd1, 0x0d,
+	0xaa, 0x87, 0xc4, 0x83, 0xaa, 0x08, 0xe2, 0x80, 0x5f, 0xa0, 0xa7, 0x73, 0x74, 0x83, 0x9a, 0x31,
+	0x69, 0x43, 0xed, 0x59, 0x36, 0x57, 0x2b, 0xf1, 0x1c, 0xa3, 0xc9, 0x27, 0xc8, 0x87, 0xd0, 0x7a,
+	0x93, 0x25, 0x6c, 0x14, 0x84, 0x21, 0x67, 0x42, 0x78, 0x15, 0x43, 0x68, 0xaa, 0xd9, 0xa7, 0x7a,
+	0x92, 0x1c, 0xc2, 0xcd, 0x44, 0x4c, 0x47, 0xef, 0x22, 0xf9, 0x66, 0xc4, 0xd9, 0x6f, 0xe7, 0x11,
+	0x67, 0x21, 0x76, 0x8d, 0x66, 0xef, 0xee, 0x62, 0x63, 0xf5, 0x1a, 0xa9, 0x81, 0x8f, 0x6e, 0xd0,
+	0xad, 0xa4, 0x38, 0xb5, 0x5f, 0x03, 0x77, 0x9e, 0x46, 0x59, 0xda, 0xf9, 0x04, 0x2a, 0x94, 0x05,
+	0xf1, 0x72, 0x17, 0x2d, 0xdd, 0x6a, 0x70, 0xf0, 0xb0, 0x5e, 0x0f, 0xb7, 0x2f, 0x2f, 0x2f, 0x2f,
+	0xed, 0xce, 0x3b, 0xb5, 0x70, 0xb5, 0x21, 0xe7, 0xe4, 0x3e, 0x34, 0xa2, 0x24, 0x98, 0x46, 0xa9,
+	0x7a, 0x41, 0x4d, 0x5f, 0x4e, 0x2c, 0x25, 0xbd, 0x03, 0xd8, 0xe4, 0x2c, 0x88, 0x47, 0xec, 0x5c,
+	0xb2, 0x54, 0x44, 0x59, 0x4a, 0x5a, 0xcb, 0xca, 0x0c, 0x62, 0xef, 0x77, 0xc5, 0xd2, 0x36, 0xf6,
+	0x74, 0x43, 0x89, 0x0e, 0x73, 0x4d, 0xe7, 0x3f, 0x2e, 0xc0, 0xd7, 0x69, 0xf6, 0x2e, 0x7d, 0x79,
+	0x31, 0x63, 0x82, 0x7c, 0x04, 0x76, 0x90, 0xe2, 0xb5, 0xd1, 0xec, 0xed, 0xec, 0xea, 0x0b, 0x7f,
+	0x37, 0xbf, 0xf0, 0x77, 0x9f, 0xa6, 0x17, 0xd4, 0x0e, 0x52, 0xf2, 0x39, 0x38, 0xe1, 0x5c, 0x1f,
+	0xf6, 0x66, 0xef, 0xde, 0x15, 0xda, 0x81, 0xf9, 0xec, 0xa0, 0x8a, 0x45, 0x3e, 0x05, 0x5b, 0x48,
+	0xaf, 0x65, 0xf6, 0xb0, 0xcc, 0x3d, 0xc5, 0x4f, 0x10, 0x6a, 0x0b, 0xd5, 0x44, 0x6c, 0x29, 0x4c,
+	0x99, 0xb4, 0xaf, 0x10, 0x5f, 0xe6, 0x5f, 0x23, 0xd4, 0x96, 0x42, 0x71, 0xe3, 0xb7, 0xde, 0xd6,
+	0x1a, 0xee, 0x37, 0x91, 0x90, 0xdf, 0xa9, 0x1d, 0xa6, 0x76, 0xfc, 0x96, 0x74, 0xc1, 0x79, 0x1b,
+	0xc4, 0x78, 0xa3, 0x35, 0x7b, 0x77, 0xae, 0x90, 0x35, 0x51, 0x51, 0xc8, 0x2e, 0x38, 0xe1, 0x38,
+	0xc6, 0xd2, 0x69, 0xf6, 0xee, 0x5f, 0x7d, 0x2f, 0xec, 0x95, 0x86, 0x1f, 0x8e, 0x63, 0xf2, 0x08,
+	0x9c, 0x49, 0x2c, 0xb1, 0x92, 0xd4, 0xb9, 0x2d, 0xf3, 0xb1, 0xeb, 0x1a, 0xfa, 0x24, 0x96, 0x8a,
+	0x1e, 0x61, 0x93, 0xbf, 0x9e, 0x8e, 0x27, 0xd1, 0xd0, 0xa3, 0x41, 0x5f, 0xad, 0x66, 0x3e, 0xe8,
+	0x7b, 0xd5, 0x35, 0xab, 0x79, 0xb5, 0xca, 0x9f, 0x0f, 0xfa, 0x68, 0xbf, 0xd7, 0xf3, 0x6a, 0xeb,
+	0xed, 0xf7, 0x7a, 0xb9, 0xfd, 0x5e, 0x0f, 0xed, 0xf7, 0x7a, 0x5e, 0xfd, 0x3d, 0xf6, 0x0b, 0xfe,
+	0x1c, 0xf9, 0x15, 0xbc, 0x09, 0x1b, 0x6b, 0x36, 0x5d, 0xb5, 0x02, 0x4d, 0x47, 0x9e, 0xf2, 0x57,
+	0x4d, 0x0d, 0xd6, 0xf8, 0xeb, 0xdb, 0xc5, 0xf8, 0x0b, 0xc9, 0xc9, 0xcf, 0xc1, 0xcd, 0x6f, 0x99,
+	0xeb, 0x5f, 0x00, 0x6f, 0x1d, 0x2d, 0xd0, 0xcc, 0xce, 0x87, 0xb0, 0x55, 0x3a, 0x8c, 0x64, 0x5b,
+	0x47, 0xb5, 0x7c, 0xbb, 0xdb, 0x40, 0xdf, 0xce, 0x3f, 0x6c, 0xb8, 0x6b, 0x58, 0x2f, 0xd2, 0x30,
+	0xe2, 0xec, 0x4c, 0x2e, 0xd8, 0x9f, 0x43, 0x45, 0xcc, 0xc7, 0x89, 0x67, 0xbd, 0xf7, 0x84, 0x53,
+	0x24, 0x91, 0x5f, 0x41, 0x23, 0x09, 0x66, 0xa3, 0x49, 0xc4, 0xe2, 0xd0, 0x34, 0xdb, 0x47, 0x25,
+	0x45, 0x39, 0x80, 0x6a, 0xc2, 0xcf, 0x15, 0x5f, 0x37, 0xdf, 0x7a, 0x62, 0x86, 0xe4, 0x09, 0x34,
+	0x45, 0x1c, 0x9d, 0x31, 0xe3, 0xe6, 0xf8, 0xce, 0xfb, 0xe2, 0x03, 0x72, 0x51, 0xd9, 0x7e, 0x09,
+	0x1b, 0x05, 0xd3, 0xd5, 0x96, 0xdb, 0xd0, 0x2d, 0xf7, 0x51, 0xb1, 0xe5, 0xae, 0xb5, 0x5d, 0xe9,
+	0xbd, 0x0f, 0x61, 0xa7, 0x84, 0xe2, 0x6e, 0x13, 0x02, 0x95, 0xf1, 0x85, 0x14, 0xb8, 0x9f, 0x2d,
+	0x8a, 0xcf, 0x9d, 0x03, 0x20, 0x25, 0xee, 0xeb, 0xaf, 0x5f, 0xe6, 0xe9, 0x56, 0xc4, 0xff, 0x27,
+	0xdd, 0x9d, 0x3b, 0x50, 0x3d, 0x16, 0xd3, 0x53, 0x26, 0x97, 0x2d, 0x6d, 0x68, 0xd7, 0xad, 0xce,
+	0x39, 0x80, 0x9e, 0x7f, 0x21, 0x59, 0xa2, 0xe2, 0xa7, 0x41, 0x62, 0x6e, 0x00, 0x8a, 0xcf, 0xbd,
+	0xef, 0xe0, 0x76, 0xc2, 0x84, 0x08, 0xa6, 0x6c, 0x24, 0x98, 0x5c, 0xe9, 0x7f, 0x9b, 0x2b, 0x2f,
+	0x7a, 0xca, 0xa4, 0x17, 0xe2, 0xeb, 0x93, 0xe2, 0xac, 0xb2, 0xa5, 0xb7, 0x8c, 0xc1, 0x29, 0x93,
+	0xcb, 0x56, 0xf8, 0x77, 0x17, 0xea, 0x38, 0x0a, 0x59, 0x48, 0x7c, 0xa8, 0xa8, 0x46, 0x69, 0x2a,
+	0xa3, 0xd0, 0x59, 0x29, 0x22, 0xa4, 0x03, 0xae, 0xfa, 0x15, 0xa6, 0x14, 0x8a, 0x14, 0x0d, 0x91,
+	0x27, 0x50, 0x57, 0x0f, 0xa3, 0x24, 0x98, 0x79, 0x4e, 0xf1, 0x7a, 0xce, 0x23, 0x21, 0xff, 0x38,
+	0x98, 0xe9, 0x0a, 0xa9, 0x71, 0x3d, 0x22, 0x8f, 0xa1, 0x89, 0xca, 0xb3, 0x37, 0x59, 0x74, 0xc6,
+	0x4c, 0x8b, 0x29, 0xc4, 0x38, 0xba, 0x41, 0x41, 0x51, 0x9e, 0x21, 0x83, 0xfc, 0x12, 0x9a, 0x9a,
+	0x3b, 0xe5, 0xd9, 0x7c, 0x86, 0x4d, 0x06, 0x7a, 0xf7, 0xaf, 0x44, 0xd3, 0xec, 0xaf, 0x14, 0x47,
+	0xdd, 0x7d, 0x2b, 0x12, 0x72, 0x00, 0x1b, 0xd9, 0x4c, 0xf5, 0xed, 0x20, 0xd6, 0x1e, 0x35, 0xf4,
+	0x78, 0x70, 0xc5, 0xe3, 0x5b, 0xc3, 0x42, 0x17, 0x5a, 0x14, 0x29, 0x17, 0xfd, 0xbd, 0xc4, 0x42,
+	0xed, 0xa2, 0x3e, 0x27, 0xaf, 0x73, 0xa1, 0x86, 0x65, 0x5c, 0x0a, 0x22, 0xf2, 0x29, 0xd4, 0xd4,
+	0x3d, 0x2c, 0x58, 0x7e, 0x73, 0x94, 0x72, 0x4b, 0xab, 0x09, 0xfe, 0xb6, 0x8f, 0xa0, 0xb5, 0xba,
+	0x81, 0xd7, 0x9c, 0x86, 0x4e, 0xf1, 0x34, 0x94, 0xf2, 0xb4, 0xfc, 0x9c, 0x79, 0x0c, 0xcd, 0x95,
+	0xcd, 0x59, 0x14, 0x40, 0x75, 0x5d, 0x01, 0xb4, 0x0f, 0x61, 0xa3, 0xb0, 0x13, 0x0b, 0x49, 0x7d,
+	0x6d, 0xcd, 0x10, 0xa8, 0xc8, 0x60, 0x2a, 0xf0, 0xeb, 0xbb, 0x41, 0xf1, 0xb9, 0xfd, 0x01, 0x6c,
+	0x14, 0xb6, 0x82, 0xb4, 0xc0, 0x4a, 0xb1, 0x09, 0xba, 0xd4, 0x4a, 0xf7, 0xeb, 0x50, 0xd5, 0x49,
+	0xea, 0xfc, 0x04, 0xcb, 0x53, 0x73, 0x6e, 0x83, 0x1b, 0x07, 0x63, 0x16, 0x7b, 0x7f, 0xd1, 0x6f,
+	0xaa, 0x47, 0x43, 0x5f, 0x1f, 0x97, 0xd2, 0x97, 0xc0, 0xef, 0x97, 0x87, 0x67, 0xf8, 0x05, 0x54,
+	0xd8, 0xb9, 0x4c, 0x4a, 0x8c, 0x3f, 0xfc, 0x40, 0xff, 0x53, 0x92, 0xe1, 0x17, 0xd0, 0xc0, 0x92,
+	0x8c, 0xd4, 0xc1, 0x2c, 0x9f, 0x35, 0x76, 0xcd, 0x3b, 0x63, 0xed, 0xab, 0xf3, 0x36, 0xfc, 0x99,
+	0x39, 0x2b, 0xa5, 0xb0, 0x7f, 0x5c, 0x7b, 0x72, 0x86, 0x4f, 0xcc, 0x3f, 0x8a, 0x92, 0xe2, 0x4f,
+	0x3f, 0xf8, 0xff, 0x62, 0xf8, 0x31, 0x54, 0xcf, 0xd4, 0x27, 0x5f, 0x39, 0xd8, 0xa5, 0xa5, 0xff,
+	0x91, 0x69, 0x70, 0xf8, 0x25, 0xd4, 0xd9, 0xb9, 0xd4, 0xd5, 0x56, 0x24, 0xfe, 0xd9, 0xc2, 0xba,
+	0xdf, 0x5e, 0xa9, 0x58, 0x5d, 0xa3, 0x0b, 0xc1, 0xff, 0x06, 0x00, 0x84, 0x1f, 0xd4, 0x78, 0xa1,
+	0x11, 0x00, 0x00,
 }
@@ -0,0 +1,112 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"unicode"
+)
+
+// A NamingStrategy returns the JSON name of a field from its original
+// (.proto) name. It is not used for fields whose json_name option sets
+// a name other than the default one, which is that of LowerCamelCase.
+type NamingStrategy func(name string) string
+
+// LowerCamelCase returns the default JSON name of the field with the
+// given original name, as protoc computes it: underscores are dropped
+// and the letters that follow them are capitalized, e.g. fooBar for
+// foo_bar.
+func LowerCamelCase(name string) string {
+	var b bytes.Buffer
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		b.WriteRune(c)
+		upper = false
+	}
+	return b.String()
+}
+
+// UpperCamelCase returns the name of LowerCamelCase with its first
+// letter capitalized, e.g. FooBar for foo_bar.
+func UpperCamelCase(name string) string {
+	name = LowerCamelCase(name)
+	if name != "" && 'a' <= name[0] && name[0] <= 'z' {
+		name = string(name[0]-('a'-'A')) + name[1:]
+	}
+	return name
+}
+
+// SnakeCase returns the name in lower case, with words separated by
+// underscores, e.g. foo_bar for fooBar or foo_bar, and http_server
+// for HTTPServer.
+func SnakeCase(name string) string {
+	rs := []rune(name)
+	var b bytes.Buffer
+	for i, c := range rs {
+		if unicode.IsUpper(c) && i > 0 && rs[i-1] != '_' {
+			prev := rs[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				unicode.IsUpper(prev) && i+1 < len(rs) && unicode.IsLower(rs[i+1]) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return b.String()
+}
+
+// customJSONName reports whether jsonName, the JSON name of the field
+// with the given original name, is set by the json_name option.
+func customJSONName(name, jsonName string) bool {
+	return jsonName != "" && jsonName != LowerCamelCase(name)
+}
+
+// jsonName returns the name in the output of m of the field with the
+// given original and JSON names.
+func (m *Marshaler) jsonName(name, jsonName string) string {
+	switch {
+	case m.OrigName:
+		return name
+	case m.Naming != nil && !customJSONName(name, jsonName):
+		return m.Naming(name)
+	case jsonName == "":
+		return name
+	}
+	return jsonName
+}
@@ -0,0 +1,147 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+)
+
+// fieldOptions are the network.api.json options of a field. Those that
+// concern enums or 64-bit integers are only set for fields of those types.
+type fieldOptions struct {
+	enumAsInt     bool
+	int64AsNumber bool
+	omitDefault   bool
+}
+
+var fieldOptionsCache struct {
+	sync.RWMutex
+	m map[reflect.Type]map[string]fieldOptions
+}
+
+// jsonFieldOptions returns the options of the fields of the message type t,
+// keyed by their original names, or nil if none of them has options.
+// They are read from the descriptor of the message the first time.
+func jsonFieldOptions(t reflect.Type) map[string]fieldOptions {
+	fieldOptionsCache.RLock()
+	opts, ok := fieldOptionsCache.m[t]
+	fieldOptionsCache.RUnlock()
+	if ok {
+		return opts
+	}
+
+	if msg, ok := reflect.Zero(t).Interface().(descriptor.Message); ok {
+		_, md := descriptor.ForMessage(msg)
+		opts = messageFieldOptions(md)
+	}
+
+	fieldOptionsCache.Lock()
+	if fieldOptionsCache.m == nil {
+		fieldOptionsCache.m = make(map[reflect.Type]map[string]fieldOptions)
+	}
+	fieldOptionsCache.m[t] = opts
+	fieldOptionsCache.Unlock()
+	return opts
+}
+
+// messageFieldOptions returns the options of the fields of the message,
+// or nil if none of them has options.
+func messageFieldOptions(md *pb.DescriptorProto) map[string]fieldOptions {
+	var opts map[string]fieldOptions
+	for _, field := range md.Field {
+		if field.Options == nil || !proto.HasExtension(field.Options, network_api.E_Json) {
+			continue
+		}
+		ext, err := proto.GetExtension(field.Options, network_api.E_Json)
+		if err != nil {
+			continue
+		}
+		json := ext.(*network_api.JsonFieldOptions)
+		// The options of a map apply to its values.
+		valueType := field.GetType()
+		if entry := mapEntry(md, field); entry != nil {
+			valueType = entry.Field[1].GetType()
+		}
+		var o fieldOptions
+		switch valueType {
+		case pb.FieldDescriptorProto_TYPE_ENUM:
+			o.enumAsInt = json.GetEnumAsInt()
+		case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64,
+			pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+			o.int64AsNumber = json.GetInt64AsNumber()
+		}
+		o.omitDefault = json.GetOmitDefault()
+		if o == (fieldOptions{}) {
+			continue
+		}
+		if opts == nil {
+			opts = make(map[string]fieldOptions)
+		}
+		opts[field.GetName()] = o
+	}
+	return opts
+}
+
+// mapEntry returns the map entry message of the field, or nil if the field
+// is not a map. Map entries are nested in the message holding the map.
+func mapEntry(md *pb.DescriptorProto, field *pb.FieldDescriptorProto) *pb.DescriptorProto {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	name := field.GetTypeName()
+	name = name[strings.LastIndex(name, ".")+1:]
+	for _, nested := range md.NestedType {
+		if nested.GetName() == name && nested.GetOptions().GetMapEntry() {
+			return nested
+		}
+	}
+	return nil
+}
+
+// withFieldOptions returns the Marshaler that writes the value of a field
+// with the given options.
+func (m *Marshaler) withFieldOptions(o fieldOptions) *Marshaler {
+	if (!o.enumAsInt || m.EnumsAsInts) && (!o.int64AsNumber || m.Int64sAsNumbers) {
+		return m
+	}
+	fm := *m
+	fm.EnumsAsInts = m.EnumsAsInts || o.enumAsInt
+	fm.Int64sAsNumbers = m.Int64sAsNumbers || o.int64AsNumber
+	return &fm
+}
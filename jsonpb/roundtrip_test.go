@@ -0,0 +1,266 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	_ "github.com/golang/protobuf/proto/test_proto"
+)
+
+// fileDescriptor returns the descriptor of the registered proto file.
+func fileDescriptor(t *testing.T, file string) *descpb.FileDescriptorProto {
+	r, err := gzip.NewReader(bytes.NewReader(proto.FileDescriptor(file)))
+	if err != nil {
+		t.Fatalf("reading the descriptor of %s: %v", file, err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading the descriptor of %s: %v", file, err)
+	}
+	fd := new(descpb.FileDescriptorProto)
+	if err := proto.Unmarshal(b, fd); err != nil {
+		t.Fatalf("reading the descriptor of %s: %v", file, err)
+	}
+	return fd
+}
+
+// fileMessages returns the names of the messages of the proto file,
+// except for map entries.
+func fileMessages(fd *descpb.FileDescriptorProto) []string {
+	var names []string
+	var walk func(prefix string, msgs []*descpb.DescriptorProto)
+	walk = func(prefix string, msgs []*descpb.DescriptorProto) {
+		for _, msg := range msgs {
+			if msg.GetOptions().GetMapEntry() {
+				continue
+			}
+			names = append(names, prefix+msg.GetName())
+			walk(prefix+msg.GetName()+".", msg.NestedType)
+		}
+	}
+	walk(fd.GetPackage()+".", fd.MessageType)
+	return names
+}
+
+// A populator sets every field and every registered extension of
+// messages, down to a maximum depth below which only required fields
+// are set. Its variant selects the field set in each oneof.
+type populator struct {
+	variant  int
+	maxDepth int
+}
+
+func (p populator) message(pm proto.Message, depth int) {
+	s := reflect.ValueOf(pm).Elem()
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		if f.Tag.Get("protobuf_oneof") != "" {
+			p.oneof(pm, s.Field(i), depth)
+			continue
+		}
+		if depth == p.maxDepth && strings.Contains(f.Tag.Get("protobuf"), ",req,") {
+			// Required fields are set at any depth.
+			p.value(s.Field(i), depth-1)
+			continue
+		}
+		p.value(s.Field(i), depth)
+	}
+	for _, desc := range proto.RegisteredExtensions(pm) {
+		v := reflect.New(reflect.TypeOf(desc.ExtensionType)).Elem()
+		p.value(v, depth)
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Slice) && v.IsNil() {
+			continue
+		}
+		if err := proto.SetExtension(pm, desc, v.Interface()); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (p populator) oneof(pm proto.Message, v reflect.Value, depth int) {
+	_, _, _, wrappers := pm.(interface {
+		XXX_OneofFuncs() (func(proto.Message, *proto.Buffer) error, func(proto.Message, int, int, *proto.Buffer) (bool, error), func(proto.Message) int, []interface{})
+	}).XXX_OneofFuncs()
+	var types []reflect.Type
+	for _, w := range wrappers {
+		if t := reflect.TypeOf(w); t.Implements(v.Type()) {
+			types = append(types, t)
+		}
+	}
+	w := reflect.New(types[p.variant%len(types)].Elem())
+	p.value(w.Elem().Field(0), depth)
+	v.Set(w)
+}
+
+func (p populator) value(v reflect.Value, depth int) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.Type().Elem().Kind() == reflect.Struct {
+			if depth == p.maxDepth {
+				return
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+			p.message(v.Interface().(proto.Message), depth+1)
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		p.value(v.Elem(), depth)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte("b\x00\xff"))
+			return
+		}
+		if depth == p.maxDepth && v.Type().Elem().Kind() == reflect.Ptr {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		p.value(v.Index(0), depth)
+		p.value(v.Index(1), depth)
+	case reflect.Map:
+		if depth == p.maxDepth && v.Type().Elem().Kind() == reflect.Ptr {
+			return
+		}
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		p.value(key, depth)
+		elem := reflect.New(v.Type().Elem()).Elem()
+		p.value(elem, depth)
+		v.SetMapIndex(key, elem)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint32, reflect.Uint64:
+		v.SetUint(1 << 31)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(-2.5)
+	case reflect.String:
+		v.SetString("s\u00e9")
+	}
+}
+
+func TestRoundTripTestProto(t *testing.T) {
+	names := fileMessages(fileDescriptor(t, "test_proto/test.proto"))
+	names = append(names, "jsonpb.MsgSet", "jsonpb.MsgSetItem", "jsonpb.Extended", "jsonpb.Real")
+	marshalers := []*Marshaler{
+		{},
+		{OrigName: true, EnumsAsInts: true},
+		{EmitDefaults: true, Indent: "  "},
+		{Int64sAsNumbers: true, Naming: SnakeCase},
+	}
+	for _, name := range names {
+		mt := proto.MessageType(name)
+		if mt == nil {
+			t.Errorf("message %s is not registered", name)
+			continue
+		}
+		for variant := 0; variant < 20; variant++ {
+			want := reflect.New(mt.Elem()).Interface().(proto.Message)
+			populator{variant: variant, maxDepth: 1 + variant%2}.message(want, 0)
+			for _, m := range marshalers {
+				js, err := m.MarshalToString(want)
+				if err != nil {
+					t.Errorf("marshaling %v with %+v: %v", want, *m, err)
+					continue
+				}
+				got := reflect.New(mt.Elem()).Interface().(proto.Message)
+				u := &Unmarshaler{Strict: true, Naming: m.Naming}
+				if err := u.Unmarshal(strings.NewReader(js), got); err != nil {
+					t.Errorf("unmarshaling %s: %v", js, err)
+					continue
+				}
+				if !proto.Equal(got, want) {
+					t.Errorf("round trip of %s with %+v:\n got %v\nwant %v", name, *m, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestMessageSetAndGroups(t *testing.T) {
+	set := &pb.MsgSet{}
+	if err := proto.SetExtension(set, pb.E_MsgSetItem_MessageSetExtension, &pb.MsgSetItem{Name: proto.String("item")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(set, pb.E_RealItem, &pb.Real{Value: proto.Float64(1)}); err != nil {
+		t.Fatal(err)
+	}
+	real := &pb.Real{}
+	if err := proto.SetExtension(real, pb.E_Extgroup, &pb.ExtGroup{Label: proto.String("l")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(real, pb.E_Color, pb.Widget_GREEN.Enum()); err != nil {
+		t.Fatal(err)
+	}
+	msg := &pb.Extended{
+		Choice:        &pb.Extended_Choicegroup{Choicegroup: &pb.Extended_ChoiceGroup{Real: real}},
+		Optionalgroup: &pb.Extended_OptionalGroup{Tags: []string{"a"}},
+		Repeatedgroup: []*pb.Extended_RepeatedGroup{{N: proto.Int32(1)}, {}},
+		MsgSet:        set,
+	}
+	want := `{"choicegroup":{"real":{"[jsonpb.color]":"GREEN","[jsonpb.extgroup]":{"label":"l"}}},` +
+		`"optionalgroup":{"tags":["a"]},"repeatedgroup":[{"n":1},{}],` +
+		`"msgSet":{"[jsonpb.MsgSetItem]":{"name":"item"},"[jsonpb.real_item]":{"value":1}}}`
+	got, err := (&Marshaler{}).MarshalToString(msg)
+	if err != nil || got != want {
+		t.Errorf("marshaling %v:\n got %s, %v\nwant %s", msg, got, err, want)
+	}
+
+	// Groups are also accepted with the names of their types, which the
+	// text format uses.
+	in := `{"ChoiceGroup":{"real":{"[jsonpb.color]":1}},"OptionalGroup":{"tags":["a"]}}`
+	wantMsg := &pb.Extended{
+		Choice:        &pb.Extended_Choicegroup{Choicegroup: &pb.Extended_ChoiceGroup{Real: &pb.Real{}}},
+		Optionalgroup: &pb.Extended_OptionalGroup{Tags: []string{"a"}},
+	}
+	if err := proto.SetExtension(wantMsg.GetChoicegroup().Real, pb.E_Color, pb.Widget_GREEN.Enum()); err != nil {
+		t.Fatal(err)
+	}
+	gotMsg := new(pb.Extended)
+	if err := UnmarshalString(in, gotMsg); err != nil || !proto.Equal(gotMsg, wantMsg) {
+		t.Errorf("unmarshaling %s: got %v, %v; want %v", in, gotMsg, err, wantMsg)
+	}
+}
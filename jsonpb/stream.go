@@ -0,0 +1,411 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// streamedField returns the index in the struct of pb and the properties
+// of its repeated message field whose original name is name, and the Go
+// type of its elements.
+func streamedField(pb proto.Message, name string) (int, *proto.Properties, reflect.Type, error) {
+	v := reflect.ValueOf(pb)
+	if pb == nil || v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0, nil, nil, errors.New("jsonpb: nil or invalid header message")
+	}
+	st := v.Elem().Type()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") || f.Tag.Get("protobuf") == "" {
+			continue
+		}
+		prop := new(proto.Properties)
+		prop.Init(f.Type, f.Name, f.Tag.Get("protobuf"), &f)
+		if prop.OrigName != name {
+			continue
+		}
+		if t := f.Type; t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Ptr || t.Elem().Elem().Kind() != reflect.Struct {
+			return 0, nil, nil, fmt.Errorf("jsonpb: field %s of %T is not a repeated message field", name, pb)
+		}
+		return i, prop, f.Type.Elem(), nil
+	}
+	return 0, nil, nil, fmt.Errorf("jsonpb: no field %s in %T", name, pb)
+}
+
+// A FieldWriter writes a message whose repeated message field holds
+// too many elements to be kept in memory, such as the rows of an export.
+// The elements of the field are written one by one, and the result is
+// the same as that of Marshal for the message holding all of them.
+//
+// The writes to the underlying io.Writer are not buffered.
+type FieldWriter struct {
+	m        *Marshaler
+	out      *errWriter
+	header   proto.Message
+	index    int               // the index of the field in the struct
+	prop     *proto.Properties // the properties of the field
+	elemType reflect.Type
+	empty    bool // whether the field is written without elements
+	first    bool // whether no field has been written yet
+	n        int  // the number of elements written
+	closed   bool
+}
+
+// NewFieldWriter returns a FieldWriter that writes header to w with m,
+// except for its field with the given original name, whose elements are
+// given to Write. The fields of header that precede the field are
+// written at once, and those that follow it are written by Close.
+// If m is nil, the default Marshaler is used.
+func NewFieldWriter(w io.Writer, m *Marshaler, header proto.Message, field string) (*FieldWriter, error) {
+	if m == nil {
+		m = new(Marshaler)
+	}
+	index, _, elemType, err := streamedField(header, field)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRequiredFields(header); err != nil {
+		return nil, err
+	}
+	s := reflect.ValueOf(header).Elem()
+	prop := m.jsonProperties(s.Type().Field(index))
+	fw := &FieldWriter{
+		m:        m,
+		out:      &errWriter{writer: w},
+		header:   header,
+		index:    index,
+		prop:     prop,
+		elemType: elemType,
+		empty:    m.EmitDefaults && !jsonFieldOptions(reflect.TypeOf(header))[prop.OrigName].omitDefault,
+	}
+	fw.out.write("{")
+	if m.Indent != "" {
+		fw.out.write("\n")
+	}
+	if fw.first, err = m.marshalFields(fw.out, s, 0, index, "", true); err != nil {
+		return nil, err
+	}
+	return fw, fw.out.err
+}
+
+// redacted reports whether the field is left out, as a sensitive
+// message field is when RedactSensitive is set.
+func (w *FieldWriter) redacted() bool {
+	return w.m.RedactSensitive && w.prop.Sensitive
+}
+
+// Write writes elem as the next element of the field.
+func (w *FieldWriter) Write(elem proto.Message) error {
+	if w.closed {
+		return errors.New("jsonpb: Write after Close")
+	}
+	if reflect.TypeOf(elem) != w.elemType {
+		return fmt.Errorf("jsonpb: %T written to field %s of type []%v", elem, w.prop.OrigName, w.elemType)
+	}
+	if w.n == 0 {
+		w.begin()
+	}
+	w.n++
+	if w.redacted() {
+		return w.out.err
+	}
+	if w.n > 1 {
+		w.out.write(",")
+	}
+	if w.m.Indent != "" {
+		w.out.write("\n")
+		w.out.write(w.m.Indent)
+		w.out.write(w.m.Indent)
+	}
+	if reflect.ValueOf(elem).IsNil() {
+		w.out.write("null")
+		return w.out.err
+	}
+	if err := checkRequiredFields(elem); err != nil {
+		return err
+	}
+	return w.m.marshalObject(w.out, elem, w.m.Indent+w.m.Indent, "")
+}
+
+// begin writes the name of the field and starts its value.
+func (w *FieldWriter) begin() {
+	if w.redacted() {
+		return
+	}
+	if !w.first {
+		w.m.writeSep(w.out)
+	}
+	w.first = false
+	w.m.marshalFieldName(w.out, w.prop.JSONName, "")
+	w.out.write("[")
+}
+
+// Close ends the field, and writes the fields of the header that follow
+// it and the extensions of the header. It does not close the underlying
+// io.Writer.
+func (w *FieldWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.n == 0 && w.empty {
+		w.begin()
+	}
+	if (w.n > 0 || w.empty) && !w.redacted() {
+		if w.m.Indent != "" {
+			w.out.write("\n")
+			w.out.write(w.m.Indent)
+		}
+		w.out.write("]")
+	}
+	s := reflect.ValueOf(w.header).Elem()
+	first, err := w.m.marshalFields(w.out, s, w.index+1, s.NumField(), "", w.first)
+	if err != nil {
+		return err
+	}
+	if first, err = w.m.marshalExtensions(w.out, w.header, "", first); err != nil {
+		return err
+	}
+	if _, err := w.m.marshalUnknown(w.out, w.header, "", first); err != nil {
+		return err
+	}
+	if w.m.Indent != "" {
+		w.out.write("\n")
+	}
+	w.out.write("}")
+	return w.out.err
+}
+
+// The states of a FieldReader.
+const (
+	readStart  = iota // before the object
+	readFields        // in the fields that precede the streamed field
+	readElems         // in the elements of the streamed field
+	readRest          // in the fields that follow the streamed field
+	readDone          // after the object
+)
+
+// A FieldReader reads a message whose repeated message field holds
+// too many elements to be kept in memory, such as the rows of an export.
+// The elements of the field are read one by one, while the other fields
+// are kept until Close unmarshals them into the header.
+type FieldReader struct {
+	u        Unmarshaler
+	dec      *json.Decoder
+	header   proto.Message
+	prop     *proto.Properties // the properties of the field
+	elemType reflect.Type
+	fields   map[string]json.RawMessage // the other fields of the object
+	key      string                     // the key of the field in the object
+	state    int
+	n        int // the number of elements read
+}
+
+// NewFieldReader returns a FieldReader that reads a JSON object from r
+// with u, whose field with the given original name is read by Read and
+// whose other fields are unmarshaled into header by Close. If u is nil,
+// the default Unmarshaler is used.
+func NewFieldReader(r io.Reader, u *Unmarshaler, header proto.Message, field string) (*FieldReader, error) {
+	if u == nil {
+		u = new(Unmarshaler)
+	}
+	_, prop, elemType, err := streamedField(header, field)
+	if err != nil {
+		return nil, err
+	}
+	if u.Options.MaxBytes > 0 {
+		// Stop reading as soon as the input is known to be too large.
+		r = &limitReader{r: r, opts: &u.Options}
+	}
+	return &FieldReader{
+		u:        *u,
+		dec:      json.NewDecoder(r),
+		header:   header,
+		prop:     prop,
+		elemType: elemType,
+		fields:   make(map[string]json.RawMessage),
+	}, nil
+}
+
+// Read reads the next element of the field into elem, which is reset
+// first. A null element reads as an empty message. It returns io.EOF
+// after the last element, or if the field is absent.
+func (r *FieldReader) Read(elem proto.Message) error {
+	if reflect.TypeOf(elem) != r.elemType || reflect.ValueOf(elem).IsNil() {
+		return fmt.Errorf("jsonpb: %T read from field %s of type []%v", elem, r.prop.OrigName, r.elemType)
+	}
+	return r.advance(elem)
+}
+
+// Close reads the rest of the object, skipping the elements of the field
+// that have not been read, and unmarshals the other fields into the header.
+func (r *FieldReader) Close() error {
+	for r.state != readDone {
+		if err := r.advance(nil); err != nil && err != io.EOF {
+			return err
+		}
+	}
+	if r.fields == nil {
+		return nil
+	}
+	b, err := json.Marshal(r.fields)
+	if err != nil {
+		return err
+	}
+	r.fields = nil
+	return (&unmarshalState{Unmarshaler: &r.u}).unmarshalNext(b, r.header)
+}
+
+// advance reads the input up to the next element of the field, which it
+// unmarshals into elem unless elem is nil, or up to the end of the object.
+// It returns io.EOF when the elements of the field have all been read.
+func (r *FieldReader) advance(elem proto.Message) error {
+	for {
+		switch r.state {
+		case readStart:
+			if err := r.expect(json.Delim('{')); err != nil {
+				return err
+			}
+			r.state = readFields
+		case readFields, readRest:
+			if !r.dec.More() {
+				if err := r.expect(json.Delim('}')); err != nil {
+					return err
+				}
+				r.state = readDone
+				continue
+			}
+			tok, err := r.token()
+			if err != nil {
+				return err
+			}
+			key, _ := tok.(string)
+			if names := acceptedJSONFieldNames(r.prop, r.u.Naming); key != names.orig && key != names.named && key != names.camel {
+				var raw json.RawMessage
+				if err := r.decode(&raw); err != nil {
+					return err
+				}
+				if _, ok := r.fields[key]; ok && r.u.Strict {
+					return &PathError{Path: "$", Err: fmt.Errorf("duplicate key %q", key)}
+				}
+				r.fields[key] = raw
+				continue
+			}
+			if r.state == readRest {
+				return fmt.Errorf("jsonpb: field %s appears more than once", r.prop.OrigName)
+			}
+			r.key = key
+			if tok, err = r.token(); err != nil {
+				return err
+			}
+			switch tok {
+			case json.Delim('['):
+				r.state = readElems
+			case nil:
+				r.state = readRest
+			default:
+				return fmt.Errorf("jsonpb: field %s is not a list", r.prop.OrigName)
+			}
+		case readElems:
+			if !r.dec.More() {
+				if err := r.expect(json.Delim(']')); err != nil {
+					return err
+				}
+				r.state = readRest
+				return io.EOF
+			}
+			var raw json.RawMessage
+			if err := r.decode(&raw); err != nil {
+				return err
+			}
+			r.n++
+			if err := checkElements(&r.u.Options, r.n, r.prop.OrigName); err != nil {
+				return err
+			}
+			if elem == nil {
+				continue
+			}
+			// Decode as an element of the header, so that depth
+			// limits are the same as for Unmarshal.
+			u := &unmarshalState{Unmarshaler: &r.u, depth: 1}
+			path := "$" + memberPath(r.key) + indexPath(r.n-1)
+			if err := u.checkElement(raw, r.elemType); err != nil {
+				return u.atPath(err, path)
+			}
+			elem.Reset()
+			if err := u.unmarshalValue(reflect.ValueOf(elem).Elem(), raw, r.prop); err != nil {
+				return u.atPath(err, path)
+			}
+			return checkRequiredFields(elem)
+		case readDone:
+			return io.EOF
+		}
+	}
+}
+
+// token reads the next token. The input must not end before the object.
+func (r *FieldReader) token() (json.Token, error) {
+	tok, err := r.dec.Token()
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return tok, err
+}
+
+// decode reads the next value into raw.
+func (r *FieldReader) decode(raw *json.RawMessage) error {
+	err := r.dec.Decode(raw)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// expect reads the next token, which must be want.
+func (r *FieldReader) expect(want json.Delim) error {
+	tok, err := r.token()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return fmt.Errorf("jsonpb: unexpected %v, want %v", tok, want)
+	}
+	return nil
+}
@@ -0,0 +1,1442 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+	stpb "github.com/golang/protobuf/ptypes/struct"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// A Transcoder converts messages between JSON and the wire format
+// without their generated Go types, using the descriptors of a
+// FileDescriptorSet. The JSON is the one written by Marshaler and read by
+// Unmarshaler, with the options set in the Transcoder, including the
+// mappings of the well-known types. The type URLs of Any messages are
+// resolved among the messages of the set, whatever the AnyResolver.
+// There are no messages to keep unknown fields for: an UnknownFields
+// set in the Unmarshaler only lets JSONToWire accept and drop them.
+//
+// The set must hold the files that the messages depend on, including the
+// files of the well-known types they use, such as google/protobuf/any.proto.
+// A Transcoder may be used concurrently once its options are set.
+type Transcoder struct {
+	Marshaler   Marshaler
+	Unmarshaler Unmarshaler
+
+	messages   map[string]*messageDesc
+	enums      map[string]*enumDesc
+	extensions map[string]*fieldDesc // by full name
+}
+
+// A messageDesc describes a message of the set of a Transcoder.
+type messageDesc struct {
+	name       string // the full name, without a leading dot
+	desc       *pb.DescriptorProto
+	proto3     bool
+	messageSet bool
+	mapEntry   bool
+
+	fields     []*fieldDesc // in declaration order
+	sorted     []*fieldDesc // in the order of their numbers
+	byNumber   map[int32]*fieldDesc
+	extensions map[int32]*fieldDesc // the extensions of the message
+	props      []*proto.Properties  // the names of the fields
+}
+
+// A fieldDesc describes a field or an extension.
+type fieldDesc struct {
+	name     string // the original name, or the type name for groups
+	jsonName string
+	fullName string // the full name of an extension
+	number   int32
+	kind     pb.FieldDescriptorProto_Type
+	proto3   bool
+	repeated bool
+	required bool
+	packed   bool
+	oneof    int32 // 1 + the index of the oneof holding the field, or 0
+
+	sensitive bool
+	options   fieldOptions
+
+	message *messageDesc
+	enum    *enumDesc
+	prop    *proto.Properties
+}
+
+// An enumDesc describes an enum of the set of a Transcoder.
+type enumDesc struct {
+	name   string
+	names  map[int32]string
+	values map[string]int32
+}
+
+// An extensionDecl is an extension waiting for the messages of the set
+// to be known.
+type extensionDecl struct {
+	scope  string       // the full name of the scope of the extension
+	parent *messageDesc // the message it is declared in, if any
+	proto3 bool
+	desc   *pb.FieldDescriptorProto
+}
+
+const (
+	anyName       = "google.protobuf.Any"
+	valueName     = "google.protobuf.Value"
+	nullValueName = "google.protobuf.NullValue"
+)
+
+// wellKnownMessages are the well-known types whose JSON mapping is
+// special, except for Any, with their generated types. They are converted
+// through these types, so that their mapping is exactly the one of
+// Marshaler and Unmarshaler.
+var wellKnownMessages = map[string]func() proto.Message{
+	"google.protobuf.Duration":    func() proto.Message { return new(durpb.Duration) },
+	"google.protobuf.Empty":       func() proto.Message { return new(emptypb.Empty) },
+	"google.protobuf.Struct":      func() proto.Message { return new(stpb.Struct) },
+	"google.protobuf.Value":       func() proto.Message { return new(stpb.Value) },
+	"google.protobuf.ListValue":   func() proto.Message { return new(stpb.ListValue) },
+	"google.protobuf.Timestamp":   func() proto.Message { return new(tspb.Timestamp) },
+	"google.protobuf.DoubleValue": func() proto.Message { return new(wpb.DoubleValue) },
+	"google.protobuf.FloatValue":  func() proto.Message { return new(wpb.FloatValue) },
+	"google.protobuf.Int64Value":  func() proto.Message { return new(wpb.Int64Value) },
+	"google.protobuf.UInt64Value": func() proto.Message { return new(wpb.UInt64Value) },
+	"google.protobuf.Int32Value":  func() proto.Message { return new(wpb.Int32Value) },
+	"google.protobuf.UInt32Value": func() proto.Message { return new(wpb.UInt32Value) },
+	"google.protobuf.BoolValue":   func() proto.Message { return new(wpb.BoolValue) },
+	"google.protobuf.StringValue": func() proto.Message { return new(wpb.StringValue) },
+	"google.protobuf.BytesValue":  func() proto.Message { return new(wpb.BytesValue) },
+}
+
+// isWellKnown reports whether md is a well-known type, which is held
+// in the "value" field of the JSON of an Any.
+func isWellKnown(md *messageDesc) bool {
+	return md.name == anyName || wellKnownMessages[md.name] != nil
+}
+
+// NewTranscoder returns a Transcoder for the messages of the set.
+func NewTranscoder(set *pb.FileDescriptorSet) (*Transcoder, error) {
+	t := &Transcoder{
+		messages:   make(map[string]*messageDesc),
+		enums:      make(map[string]*enumDesc),
+		extensions: make(map[string]*fieldDesc),
+	}
+	var decls []extensionDecl
+	for _, fd := range set.GetFile() {
+		proto3 := fd.GetSyntax() == "proto3"
+		t.addEnums(fd.GetPackage(), fd.EnumType)
+		t.addMessages(fd.GetPackage(), fd.MessageType, proto3)
+		for _, ext := range fd.Extension {
+			decls = append(decls, extensionDecl{scope: fd.GetPackage(), proto3: proto3, desc: ext})
+		}
+	}
+
+	for _, md := range t.messages {
+		for _, ext := range md.desc.Extension {
+			decls = append(decls, extensionDecl{scope: md.name, parent: md, proto3: md.proto3, desc: ext})
+		}
+		md.byNumber = make(map[int32]*fieldDesc)
+		options := messageFieldOptions(md.desc)
+		for _, field := range md.desc.Field {
+			f, err := t.newField(field, md.proto3)
+			if err != nil {
+				return nil, fmt.Errorf("field %s of %s: %v", field.GetName(), md.name, err)
+			}
+			f.options = options[field.GetName()]
+			md.fields = append(md.fields, f)
+			md.byNumber[f.number] = f
+			md.props = append(md.props, f.prop)
+		}
+		md.sorted = append([]*fieldDesc(nil), md.fields...)
+		sort.Sort(byNumber(md.sorted))
+	}
+
+	for _, decl := range decls {
+		f, err := t.newField(decl.desc, decl.proto3)
+		if err != nil {
+			return nil, fmt.Errorf("extension %s of %s: %v", decl.desc.GetName(), decl.scope, err)
+		}
+		extendee := t.messages[strings.TrimPrefix(decl.desc.GetExtendee(), ".")]
+		if extendee == nil {
+			return nil, fmt.Errorf("extension %s of %s: unknown message %q", decl.desc.GetName(), decl.scope, decl.desc.GetExtendee())
+		}
+		// As in generated code, the extensions of a MessageSet named
+		// message_set_extension are named by the message they are
+		// declared in.
+		f.fullName = fullName(decl.scope, decl.desc.GetName())
+		if extendee.messageSet && decl.parent != nil && decl.desc.GetName() == "message_set_extension" {
+			f.fullName = decl.parent.name
+		}
+		if extendee.extensions == nil {
+			extendee.extensions = make(map[int32]*fieldDesc)
+		}
+		extendee.extensions[f.number] = f
+		t.extensions[f.fullName] = f
+	}
+	return t, nil
+}
+
+// addMessages adds the messages and their nested types, which are
+// declared in the given scope.
+func (t *Transcoder) addMessages(scope string, messages []*pb.DescriptorProto, proto3 bool) {
+	for _, desc := range messages {
+		md := &messageDesc{
+			name:       fullName(scope, desc.GetName()),
+			desc:       desc,
+			proto3:     proto3,
+			messageSet: desc.GetOptions().GetMessageSetWireFormat(),
+			mapEntry:   desc.GetOptions().GetMapEntry(),
+		}
+		t.messages[md.name] = md
+		t.addEnums(md.name, desc.EnumType)
+		t.addMessages(md.name, desc.NestedType, proto3)
+	}
+}
+
+// addEnums adds the enums, which are declared in the given scope.
+func (t *Transcoder) addEnums(scope string, enums []*pb.EnumDescriptorProto) {
+	for _, desc := range enums {
+		ed := &enumDesc{
+			name:   fullName(scope, desc.GetName()),
+			names:  make(map[int32]string),
+			values: make(map[string]int32),
+		}
+		for _, v := range desc.Value {
+			// The first of the aliases of a value names it.
+			if _, ok := ed.names[v.GetNumber()]; !ok {
+				ed.names[v.GetNumber()] = v.GetName()
+			}
+			ed.values[v.GetName()] = v.GetNumber()
+		}
+		t.enums[ed.name] = ed
+	}
+}
+
+func fullName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// newField returns the description of a field of a message declared in
+// a proto3 file if proto3 is set.
+func (t *Transcoder) newField(field *pb.FieldDescriptorProto, proto3 bool) (*fieldDesc, error) {
+	f := &fieldDesc{
+		name:     field.GetName(),
+		jsonName: field.GetJsonName(),
+		number:   field.GetNumber(),
+		kind:     field.GetType(),
+		proto3:   proto3,
+		repeated: field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED,
+		required: field.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED,
+	}
+	if f.jsonName == "" {
+		// Descriptor sets written by protoc leave out the JSON names
+		// it gives to plugins.
+		f.jsonName = LowerCamelCase(f.name)
+	}
+	if field.OneofIndex != nil {
+		f.oneof = field.GetOneofIndex() + 1
+	}
+
+	typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+	switch f.kind {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		if f.message = t.messages[typeName]; f.message == nil {
+			return nil, fmt.Errorf("unknown message %q", field.GetTypeName())
+		}
+		if f.kind == pb.FieldDescriptorProto_TYPE_GROUP {
+			// Groups are named by their types, as in generated code.
+			f.name = typeName[strings.LastIndex(typeName, ".")+1:]
+		}
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		if f.enum = t.enums[typeName]; f.enum == nil {
+			return nil, fmt.Errorf("unknown enum %q", field.GetTypeName())
+		}
+	}
+
+	if f.repeated && wireType(f.kind) != proto.WireBytes && wireType(f.kind) != proto.WireStartGroup {
+		f.packed = proto3
+		if opts := field.GetOptions(); opts != nil && opts.Packed != nil {
+			f.packed = opts.GetPacked()
+		}
+	}
+	if opts := field.GetOptions(); opts != nil && proto.HasExtension(opts, network_api.E_Sensitive) {
+		if ext, err := proto.GetExtension(opts, network_api.E_Sensitive); err == nil {
+			f.sensitive = *ext.(*bool)
+		}
+	}
+	f.prop = &proto.Properties{OrigName: f.name, JSONName: f.jsonName}
+	return f, nil
+}
+
+// isMap reports whether f is a map.
+func (f *fieldDesc) isMap() bool {
+	return f.repeated && f.message != nil && f.message.mapEntry
+}
+
+// isValue reports whether f holds google.protobuf.Value messages,
+// for which null is a value.
+func (f *fieldDesc) isValue() bool {
+	return f.message != nil && f.message.name == valueName
+}
+
+type byNumber []*fieldDesc
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Less(i, j int) bool { return s[i].number < s[j].number }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// message returns the message with the given full name.
+func (t *Transcoder) message(name string) (*messageDesc, error) {
+	md := t.messages[strings.TrimPrefix(name, ".")]
+	if md == nil {
+		return nil, fmt.Errorf("unknown message type %q", name)
+	}
+	return md, nil
+}
+
+// resolveAny returns the message of the type URL of an Any, which is
+// named by the part of the URL after the last slash.
+func (t *Transcoder) resolveAny(typeURL string) (*messageDesc, error) {
+	return t.message(typeURL[strings.LastIndex(typeURL, "/")+1:])
+}
+
+// WireToJSON converts the message with the given full name from the
+// wire format to JSON, as Marshaler would.
+func (t *Transcoder) WireToJSON(name string, b []byte) ([]byte, error) {
+	md, err := t.message(name)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	out := &errWriter{writer: &buf}
+	if err := t.writeObject(&t.Marshaler, out, md, b, "", ""); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// JSONToWire converts the message with the given full name from JSON
+// to the wire format, as Unmarshaler would. Fields are written in the
+// order of their numbers, and map entries in the order of their keys.
+func (t *Transcoder) JSONToWire(name string, in []byte) ([]byte, error) {
+	md, err := t.message(name)
+	if err != nil {
+		return nil, err
+	}
+	u := &unmarshalState{Unmarshaler: &t.Unmarshaler}
+	if err := checkBytes(&u.Options, len(in)); err != nil {
+		return nil, err
+	}
+	var raw json.RawMessage
+	if err := json.Unmarshal(in, &raw); err != nil {
+		return nil, err
+	}
+	b, err := t.encodeMessage(u, md, raw, "")
+	if err != nil {
+		return nil, u.atPath(err, "$")
+	}
+	return b, nil
+}
+
+var errMalformedWire = errors.New("jsonpb: malformed wire format")
+
+// A wireValue is a value of a field in the wire format.
+type wireValue struct {
+	wire int
+	x    uint64 // the value of a varint or fixed field
+	b    []byte // the contents of a length-delimited field or a group
+	pos  int    // the position of the field in its message
+}
+
+// parseWire splits the message md encoded as b into the values of its
+// fields, by number. The items of a MessageSet are the values of the
+// extensions they hold.
+func parseWire(md *messageDesc, b []byte) (map[int32][]wireValue, error) {
+	fields := make(map[int32][]wireValue)
+	for pos := 0; len(b) > 0; pos++ {
+		tag, n := proto.DecodeVarint(b)
+		num, payload, end := consumeField(b)
+		if end < 0 || num == 0 || num > math.MaxInt32 {
+			return nil, errMalformedWire
+		}
+		v := wireValue{wire: int(tag & 7), pos: pos}
+		switch v.wire {
+		case proto.WireVarint:
+			v.x, _ = proto.DecodeVarint(b[n:])
+		case proto.WireFixed64:
+			v.x, _ = readFixed(b[n:], 8)
+		case proto.WireFixed32:
+			v.x, _ = readFixed(b[n:], 4)
+		case proto.WireBytes:
+			v.b = payload
+		case proto.WireStartGroup:
+			v.b = b[n : end-proto.SizeVarint(num<<3|proto.WireEndGroup)]
+		}
+		b = b[end:]
+
+		if md.messageSet && num == 1 && v.wire == proto.WireStartGroup {
+			item, err := parseWire(&messageDesc{}, v.b)
+			if err != nil {
+				return nil, err
+			}
+			typeID, message := item[2], item[3]
+			if len(typeID) == 0 || len(message) == 0 || typeID[0].x > math.MaxInt32 {
+				return nil, errMalformedWire
+			}
+			num, v = typeID[0].x, message[len(message)-1]
+			v.pos = pos
+		}
+		fields[int32(num)] = append(fields[int32(num)], v)
+	}
+	return fields, nil
+}
+
+// consumeField returns the number of the first field of b, its contents
+// if it is length-delimited, and its encoded length, which is negative
+// if b does not start with a field.
+func consumeField(b []byte) (num uint64, payload []byte, n int) {
+	tag, n := proto.DecodeVarint(b)
+	if n == 0 {
+		return 0, nil, -1
+	}
+	num = tag >> 3
+	switch tag & 7 {
+	case proto.WireVarint:
+		_, m := proto.DecodeVarint(b[n:])
+		if m == 0 {
+			return 0, nil, -1
+		}
+		n += m
+	case proto.WireFixed64:
+		n += 8
+	case proto.WireFixed32:
+		n += 4
+	case proto.WireBytes:
+		l, m := proto.DecodeVarint(b[n:])
+		if m == 0 || l > uint64(len(b)-n-m) {
+			return 0, nil, -1
+		}
+		n += m
+		payload = b[n : n+int(l)]
+		n += int(l)
+	case proto.WireStartGroup:
+		for {
+			tag, m := proto.DecodeVarint(b[n:])
+			if m == 0 {
+				return 0, nil, -1
+			}
+			if tag == num<<3|proto.WireEndGroup {
+				n += m
+				break
+			}
+			if _, _, m = consumeField(b[n:]); m < 0 {
+				return 0, nil, -1
+			}
+			n += m
+		}
+	default:
+		return 0, nil, -1
+	}
+	if n > len(b) {
+		return 0, nil, -1
+	}
+	return num, payload, n
+}
+
+// readFixed returns the little-endian integer of size bytes at the
+// start of b and its size, or 0 if b is too short.
+func readFixed(b []byte, size int) (uint64, int) {
+	if len(b) < size {
+		return 0, 0
+	}
+	var x uint64
+	for i := size - 1; i >= 0; i-- {
+		x = x<<8 | uint64(b[i])
+	}
+	return x, size
+}
+
+// wireType returns the wire type of the values of the given kind.
+func wireType(kind pb.FieldDescriptorProto_Type) int {
+	switch kind {
+	case pb.FieldDescriptorProto_TYPE_FIXED64, pb.FieldDescriptorProto_TYPE_SFIXED64, pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return proto.WireFixed64
+	case pb.FieldDescriptorProto_TYPE_FIXED32, pb.FieldDescriptorProto_TYPE_SFIXED32, pb.FieldDescriptorProto_TYPE_FLOAT:
+		return proto.WireFixed32
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES, pb.FieldDescriptorProto_TYPE_MESSAGE:
+		return proto.WireBytes
+	case pb.FieldDescriptorProto_TYPE_GROUP:
+		return proto.WireStartGroup
+	}
+	return proto.WireVarint
+}
+
+// elements returns the values of f among vals, unpacking packed ones.
+func (f *fieldDesc) elements(vals []wireValue) ([]wireValue, error) {
+	want := wireType(f.kind)
+	var elems []wireValue
+	for _, v := range vals {
+		if v.wire == want {
+			elems = append(elems, v)
+			continue
+		}
+		if v.wire != proto.WireBytes || want == proto.WireBytes || want == proto.WireStartGroup {
+			return nil, fmt.Errorf("jsonpb: bad wire type %d for field %s", v.wire, f.name)
+		}
+		for p := v.b; len(p) > 0; {
+			var x uint64
+			n := 0
+			switch want {
+			case proto.WireVarint:
+				x, n = proto.DecodeVarint(p)
+			case proto.WireFixed32:
+				x, n = readFixed(p, 4)
+			case proto.WireFixed64:
+				x, n = readFixed(p, 8)
+			}
+			if n == 0 {
+				return nil, errMalformedWire
+			}
+			elems = append(elems, wireValue{wire: want, x: x, pos: v.pos})
+			p = p[n:]
+		}
+	}
+	return elems, nil
+}
+
+// value returns the value of the singular field f among vals: the last
+// one, or for messages the merge of all of them.
+func (f *fieldDesc) value(vals []wireValue) (wireValue, error) {
+	elems, err := f.elements(vals)
+	if err != nil {
+		return wireValue{}, err
+	}
+	if len(elems) == 0 {
+		// An empty packed value holds no value for a singular field.
+		return wireValue{}, errMalformedWire
+	}
+	v := elems[len(elems)-1]
+	if f.message != nil && len(elems) > 1 {
+		// Merging encoded messages is concatenating them.
+		var b []byte
+		for _, e := range elems {
+			b = append(b, e.b...)
+		}
+		v.b = b
+	}
+	return v, nil
+}
+
+// oneofValues returns the field of the oneof with the given index that
+// is set among fields, if any, and its values. It is the last one in
+// the wire format; values of the field that precede other fields of
+// the oneof are overwritten by them.
+func (md *messageDesc) oneofValues(oneof int32, fields map[int32][]wireValue) (*fieldDesc, []wireValue) {
+	var set *fieldDesc
+	last, other := -1, -1
+	for _, f := range md.fields {
+		vals := fields[f.number]
+		if f.oneof != oneof || len(vals) == 0 {
+			continue
+		}
+		pos := vals[len(vals)-1].pos
+		if pos > last {
+			set, last, other = f, pos, last
+		} else if pos > other {
+			other = pos
+		}
+	}
+	if set == nil {
+		return nil, nil
+	}
+	var vals []wireValue
+	for _, v := range fields[set.number] {
+		if v.pos > other {
+			vals = append(vals, v)
+		}
+	}
+	return set, vals
+}
+
+// checkRequired returns an error if a required field of md is not set,
+// either among the values of fields or among the JSON values given by
+// set.
+func (md *messageDesc) checkRequired(set func(f *fieldDesc) bool) error {
+	for _, f := range md.fields {
+		if f.required && !set(f) {
+			return fmt.Errorf("required field %q is not set", f.name)
+		}
+	}
+	return nil
+}
+
+// writeObject writes the message md encoded as b, as marshalObject does.
+func (t *Transcoder) writeObject(m *Marshaler, out *errWriter, md *messageDesc, b []byte, indent, typeURL string) error {
+	if md.name == anyName {
+		return t.writeAny(m, out, b, indent)
+	}
+	if newMessage, ok := wellKnownMessages[md.name]; ok {
+		msg := newMessage()
+		if err := proto.Unmarshal(b, msg); err != nil {
+			return err
+		}
+		return m.marshalObject(out, msg, indent, "")
+	}
+
+	fields, err := parseWire(md, b)
+	if err != nil {
+		return err
+	}
+	if err := md.checkRequired(func(f *fieldDesc) bool { return len(fields[f.number]) > 0 }); err != nil {
+		return err
+	}
+
+	out.write("{")
+	if m.Indent != "" {
+		out.write("\n")
+	}
+
+	firstField := true
+
+	if typeURL != "" {
+		if err := m.marshalTypeURL(out, indent, typeURL); err != nil {
+			return err
+		}
+		firstField = false
+	}
+
+	if firstField, err = t.writeFields(m, out, md, fields, indent, firstField); err != nil {
+		return err
+	}
+	if firstField, err = t.writeExtensions(m, out, md, fields, indent, firstField); err != nil {
+		return err
+	}
+
+	if m.Indent != "" {
+		out.write("\n")
+		out.write(indent)
+	}
+	out.write("}")
+	return out.err
+}
+
+// writeAny writes the Any encoded as b, as marshalAny does.
+func (t *Transcoder) writeAny(m *Marshaler, out *errWriter, b []byte, indent string) error {
+	fields, err := parseWire(&messageDesc{}, b)
+	if err != nil {
+		return err
+	}
+	var turl string
+	var val []byte
+	if vals := fields[1]; len(vals) > 0 {
+		turl = string(vals[len(vals)-1].b)
+	}
+	if vals := fields[2]; len(vals) > 0 {
+		val = vals[len(vals)-1].b
+	}
+	md, err := t.resolveAny(turl)
+	if err != nil {
+		return err
+	}
+
+	if isWellKnown(md) {
+		out.write("{")
+		if m.Indent != "" {
+			out.write("\n")
+		}
+		if err := m.marshalTypeURL(out, indent, turl); err != nil {
+			return err
+		}
+		m.writeSep(out)
+		if m.Indent != "" {
+			out.write(indent)
+			out.write(m.Indent)
+			out.write(`"value": `)
+		} else {
+			out.write(`"value":`)
+		}
+		if err := t.writeObject(m, out, md, val, indent+m.Indent, ""); err != nil {
+			return err
+		}
+		if m.Indent != "" {
+			out.write("\n")
+			out.write(indent)
+		}
+		out.write("}")
+		return out.err
+	}
+
+	return t.writeObject(m, out, md, val, indent, turl)
+}
+
+// writeFields writes the fields of md among fields in declaration order,
+// as marshalFields does. A oneof is written at the position of its first
+// field, where generated structs hold it.
+func (t *Transcoder) writeFields(m *Marshaler, out *errWriter, md *messageDesc, fields map[int32][]wireValue, indent string, firstField bool) (bool, error) {
+	oneofs := make(map[int32]bool)
+	for _, f := range md.fields {
+		vals := fields[f.number]
+		if f.oneof > 0 {
+			if oneofs[f.oneof] {
+				continue
+			}
+			oneofs[f.oneof] = true
+			if f, vals = md.oneofValues(f.oneof, fields); f == nil {
+				continue
+			}
+		}
+
+		zero := len(vals) == 0
+		if !zero && f.proto3 && !f.repeated && f.oneof == 0 && f.message == nil {
+			v, err := f.value(vals)
+			if err != nil {
+				return false, err
+			}
+			zero = isZeroWire(f, v)
+		}
+		if zero && (!m.EmitDefaults || f.options.omitDefault) {
+			continue
+		}
+		if m.RedactSensitive && f.sensitive {
+			var ok bool
+			if vals, ok = redactedWire(f, vals); !ok {
+				continue
+			}
+		}
+		if !firstField {
+			m.writeSep(out)
+		}
+		firstField = false
+		m.marshalFieldName(out, m.jsonName(f.name, f.jsonName), indent)
+		if err := t.writeValue(m.withFieldOptions(f.options), out, f, vals, indent); err != nil {
+			return false, err
+		}
+	}
+	return firstField, out.err
+}
+
+// writeExtensions writes the extensions of md among fields, in the order
+// of their numbers, as marshalExtensions does.
+func (t *Transcoder) writeExtensions(m *Marshaler, out *errWriter, md *messageDesc, fields map[int32][]wireValue, indent string, firstField bool) (bool, error) {
+	var ids []int32
+	for num := range fields {
+		if md.extensions[num] != nil {
+			ids = append(ids, num)
+		}
+	}
+	sort.Sort(int32Slice(ids))
+	for _, id := range ids {
+		f, vals := md.extensions[id], fields[id]
+		if m.RedactSensitive && f.sensitive {
+			var ok bool
+			if vals, ok = redactedWire(f, vals); !ok {
+				continue
+			}
+		}
+		if !firstField {
+			m.writeSep(out)
+		}
+		firstField = false
+		m.marshalFieldName(out, "["+f.fullName+"]", indent)
+		if err := t.writeValue(m, out, f, vals, indent); err != nil {
+			return false, err
+		}
+	}
+	return firstField, out.err
+}
+
+// redactedWire returns the values written for the sensitive field f,
+// as redactedValue does for generated structs. It reports false if
+// the field is left out.
+func redactedWire(f *fieldDesc, vals []wireValue) ([]wireValue, bool) {
+	if f.kind != pb.FieldDescriptorProto_TYPE_STRING && f.kind != pb.FieldDescriptorProto_TYPE_BYTES {
+		return nil, false
+	}
+	r := make([]wireValue, len(vals))
+	for i, v := range vals {
+		r[i] = v
+		if len(v.b) > 0 {
+			r[i].b = []byte(proto.RedactedValue)
+		}
+	}
+	return r, true
+}
+
+// isZeroWire reports whether v is the zero value of the scalar field f.
+// Bytes that are set are not zero, as in generated structs.
+func isZeroWire(f *fieldDesc, v wireValue) bool {
+	switch f.kind {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return len(v.b) == 0
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return false
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return math.Float32frombits(uint32(v.x)) == 0
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return math.Float64frombits(v.x) == 0
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_SINT32,
+		pb.FieldDescriptorProto_TYPE_FIXED32, pb.FieldDescriptorProto_TYPE_SFIXED32, pb.FieldDescriptorProto_TYPE_ENUM:
+		// Only the low 32 bits of a varint are significant.
+		return uint32(v.x) == 0
+	}
+	return v.x == 0
+}
+
+// writeValue writes the values vals of the field f, as marshalValue does.
+func (t *Transcoder) writeValue(m *Marshaler, out *errWriter, f *fieldDesc, vals []wireValue, indent string) error {
+	e := &encoder{m: m, out: out, indent: indent}
+	switch {
+	case f.isMap():
+		return t.writeMap(e, f, vals)
+	case f.repeated:
+		elems, err := f.elements(vals)
+		if err != nil {
+			return err
+		}
+		e.BeginList()
+		for _, v := range elems {
+			e.Elem()
+			if err := t.writeSingle(m, out, f, v, indent+m.Indent); err != nil {
+				return err
+			}
+		}
+		e.EndList()
+		return out.err
+	case len(vals) == 0:
+		// Fields that are not set are only written if EmitDefaults is set.
+		// Those with presence are null then.
+		if f.message != nil || !f.proto3 {
+			e.Null()
+			return out.err
+		}
+		return t.writeSingle(m, out, f, wireValue{}, indent)
+	}
+	v, err := f.value(vals)
+	if err != nil {
+		return err
+	}
+	return t.writeSingle(m, out, f, v, indent)
+}
+
+// mapFields returns the key and value fields of the entries of the map f.
+// As elsewhere in the package, enum values of maps are plain numbers.
+func (f *fieldDesc) mapFields() (key, value *fieldDesc, err error) {
+	key, value = f.message.byNumber[1], f.message.byNumber[2]
+	if key == nil || value == nil {
+		return nil, nil, fmt.Errorf("jsonpb: bad map entry %s", f.message.name)
+	}
+	if value.enum != nil && value.enum.name != nullValueName {
+		number := *value
+		number.kind, number.enum = pb.FieldDescriptorProto_TYPE_INT32, nil
+		value = &number
+	}
+	return key, value, nil
+}
+
+// A wireEntry is an entry of a map in the wire format.
+type wireEntry struct {
+	key, value wireValue
+	hasValue   bool
+}
+
+// writeMap writes the map f whose entries are vals, sorted by key
+// as marshalValue sorts them.
+func (t *Transcoder) writeMap(e *encoder, f *fieldDesc, vals []wireValue) error {
+	keyField, valueField, err := f.mapFields()
+	if err != nil {
+		return err
+	}
+	entries := make(map[string]wireEntry)
+	var keys []string
+	for _, v := range vals {
+		if v.wire != proto.WireBytes {
+			return fmt.Errorf("jsonpb: bad wire type %d for field %s", v.wire, f.name)
+		}
+		fields, err := parseWire(f.message, v.b)
+		if err != nil {
+			return err
+		}
+		var entry wireEntry
+		if kv := fields[1]; len(kv) > 0 {
+			if entry.key, err = keyField.value(kv); err != nil {
+				return err
+			}
+		}
+		if vv := fields[2]; len(vv) > 0 {
+			if entry.value, err = valueField.value(vv); err != nil {
+				return err
+			}
+			entry.hasValue = true
+		}
+		key := mapKey(keyField, entry.key)
+		if _, ok := entries[key]; !ok {
+			keys = append(keys, key)
+		}
+		entries[key] = entry
+	}
+	sort.Sort(&sortedKeys{keys: keys, kind: keyField.kind})
+
+	e.BeginMap()
+	for _, key := range keys {
+		e.Key(key)
+		entry := entries[key]
+		if !entry.hasValue && valueField.message != nil {
+			// Generated code leaves the messages of such entries nil.
+			e.Null()
+			continue
+		}
+		if err := t.writeSingle(e.m, e.out, valueField, entry.value, e.indent+e.m.Indent); err != nil {
+			return err
+		}
+	}
+	e.EndMap()
+	return e.out.err
+}
+
+// mapKey returns the JSON key of the value v of the map key field f.
+func mapKey(f *fieldDesc, v wireValue) string {
+	switch f.kind {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return string(v.b)
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return strconv.FormatBool(v.x != 0)
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return strconv.FormatUint(uint64(uint32(v.x)), 10)
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return strconv.FormatUint(v.x, 10)
+	}
+	return strconv.FormatInt(signedWire(f.kind, v.x), 10)
+}
+
+// sortedKeys sorts the JSON keys of a map whose keys are of the given
+// kind, numerically for integers, as mapKeys does.
+type sortedKeys struct {
+	keys []string
+	kind pb.FieldDescriptorProto_Type
+}
+
+func (s *sortedKeys) Len() int      { return len(s.keys) }
+func (s *sortedKeys) Swap(i, j int) { s.keys[i], s.keys[j] = s.keys[j], s.keys[i] }
+func (s *sortedKeys) Less(i, j int) bool {
+	switch s.kind {
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BOOL:
+		return s.keys[i] < s.keys[j]
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32,
+		pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		a, _ := strconv.ParseUint(s.keys[i], 10, 64)
+		b, _ := strconv.ParseUint(s.keys[j], 10, 64)
+		return a < b
+	}
+	a, _ := strconv.ParseInt(s.keys[i], 10, 64)
+	b, _ := strconv.ParseInt(s.keys[j], 10, 64)
+	return a < b
+}
+
+// signedWire returns the signed integer of the given kind encoded as x.
+func signedWire(kind pb.FieldDescriptorProto_Type, x uint64) int64 {
+	switch kind {
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SFIXED32, pb.FieldDescriptorProto_TYPE_ENUM:
+		return int64(int32(x))
+	case pb.FieldDescriptorProto_TYPE_SINT32:
+		return int64(int32(uint32(x)>>1) ^ -int32(x&1))
+	case pb.FieldDescriptorProto_TYPE_SINT64:
+		return int64(x>>1) ^ -int64(x&1)
+	}
+	return int64(x)
+}
+
+// writeSingle writes the value v of the field f, which is not repeated
+// or is an element of a repeated field or a map.
+func (t *Transcoder) writeSingle(m *Marshaler, out *errWriter, f *fieldDesc, v wireValue, indent string) error {
+	e := &encoder{m: m, out: out, indent: indent}
+	switch f.kind {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return t.writeObject(m, out, f.message, v.b, indent+m.Indent, "")
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		if f.enum.name == nullValueName {
+			e.Null()
+		} else {
+			e.Enum(int32(signedWire(f.kind, v.x)), f.enum.names)
+		}
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		e.Bool(v.x != 0)
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		e.Int32(int32(signedWire(f.kind, v.x)))
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		e.Int64(signedWire(f.kind, v.x))
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		e.Uint32(uint32(v.x))
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		e.Uint64(v.x)
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		e.Float32(math.Float32frombits(uint32(v.x)))
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		e.Float64(math.Float64frombits(v.x))
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		e.String(string(v.b))
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		e.Bytes(v.b)
+	}
+	return out.err
+}
+
+// encodeMessage returns the wire format of the message md given as JSON,
+// as unmarshalValue decodes it. name is the original name of the field
+// holding the message, if any.
+func (t *Transcoder) encodeMessage(u *unmarshalState, md *messageDesc, raw json.RawMessage, name string) ([]byte, error) {
+	if newMessage, ok := wellKnownMessages[md.name]; ok {
+		msg := newMessage()
+		if err := u.unmarshalValue(reflect.ValueOf(msg).Elem(), raw, &proto.Properties{OrigName: name}); err != nil {
+			return nil, err
+		}
+		return proto.Marshal(msg)
+	}
+
+	u.depth++
+	defer func() { u.depth-- }()
+	if err := checkDepth(&u.Options, u.depth, name); err != nil {
+		return nil, err
+	}
+	if md.name == anyName {
+		return t.encodeAny(u, raw)
+	}
+
+	if err := u.checkObject(raw); err != nil {
+		return nil, err
+	}
+	var jsonFields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &jsonFields); err != nil {
+		return nil, err
+	}
+
+	values := make(map[*fieldDesc]json.RawMessage)
+	keys := make(map[*fieldDesc]string)
+	for _, f := range md.fields {
+		v, key, ok, err := u.consumeJSONField(jsonFields, f.prop)
+		if err != nil {
+			return nil, err
+		}
+		// null leaves fields unset, except for google.protobuf.Value.
+		if ok && (string(v) != "null" || f.isValue() && !f.repeated) {
+			values[f], keys[f] = v, key
+		}
+	}
+	var b []byte
+	for _, f := range md.sorted {
+		v, ok := values[f]
+		if !ok {
+			continue
+		}
+		var err error
+		if b, err = t.encodeField(u, b, f, v); err != nil {
+			return nil, u.atPath(err, memberPath(keys[f]))
+		}
+	}
+
+	// Handle proto2 extensions.
+	var exts []*fieldDesc
+	for key, v := range jsonFields {
+		if !strings.HasPrefix(key, "[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		f := t.extensions[key[1:len(key)-1]]
+		if f == nil || md.extensions[f.number] != f {
+			continue
+		}
+		delete(jsonFields, key)
+		values[f], keys[f] = v, key
+		exts = append(exts, f)
+	}
+	sort.Sort(byNumber(exts))
+	for _, f := range exts {
+		var err error
+		if md.messageSet && f.message != nil && !f.repeated {
+			b, err = t.encodeItem(u, b, f, values[f])
+		} else {
+			b, err = t.encodeField(u, b, f, values[f])
+		}
+		if err != nil {
+			return nil, u.atPath(err, memberPath(keys[f]))
+		}
+	}
+
+	if u.Strict && len(jsonFields) > 0 {
+		if err := u.checkFieldNameCase(md.props, jsonFields); err != nil {
+			return nil, err
+		}
+	}
+	if !u.AllowUnknownFields && u.UnknownFields == nil && !u.Options.DiscardUnknown && len(jsonFields) > 0 {
+		// Pick any field to be the scapegoat.
+		var f string
+		for fname := range jsonFields {
+			f = fname
+			break
+		}
+		return nil, u.atPath(fmt.Errorf("unknown field %q in %v", f, md.name), memberPath(f))
+	}
+
+	err := md.checkRequired(func(f *fieldDesc) bool {
+		_, ok := values[f]
+		return ok
+	})
+	return b, err
+}
+
+// encodeAny returns the wire format of the Any given as JSON, as
+// unmarshalValue decodes it.
+func (t *Transcoder) encodeAny(u *unmarshalState, raw json.RawMessage) ([]byte, error) {
+	if err := u.checkObject(raw); err != nil {
+		return nil, err
+	}
+	var jsonFields map[string]*json.RawMessage
+	if err := json.Unmarshal(raw, &jsonFields); err != nil {
+		return nil, err
+	}
+
+	val, ok := jsonFields["@type"]
+	if !ok || val == nil {
+		return nil, errors.New("Any JSON doesn't have '@type'")
+	}
+
+	var turl string
+	if err := json.Unmarshal([]byte(*val), &turl); err != nil {
+		return nil, fmt.Errorf("can't unmarshal Any's '@type': %q", *val)
+	}
+	md, err := t.resolveAny(turl)
+	if err != nil {
+		return nil, err
+	}
+
+	var value []byte
+	if isWellKnown(md) {
+		val, ok := jsonFields["value"]
+		if !ok {
+			return nil, errors.New("Any JSON doesn't have 'value'")
+		}
+
+		if value, err = t.encodeMessage(u, md, *val, ""); err != nil {
+			if _, ok := err.(*proto.LimitError); ok || u.tracksPaths() {
+				return nil, u.atPath(err, ".value")
+			}
+			return nil, fmt.Errorf("can't unmarshal Any nested proto %s: %v", md.name, err)
+		}
+	} else {
+		delete(jsonFields, "@type")
+		nestedProto, err := json.Marshal(jsonFields)
+		if err != nil {
+			return nil, fmt.Errorf("can't generate JSON for Any's nested proto to be unmarshaled: %v", err)
+		}
+
+		if value, err = t.encodeMessage(u, md, nestedProto, ""); err != nil {
+			if _, ok := err.(*proto.LimitError); ok || u.tracksPaths() {
+				return nil, err
+			}
+			return nil, fmt.Errorf("can't unmarshal Any nested proto %s: %v", md.name, err)
+		}
+	}
+
+	var b []byte
+	if turl != "" {
+		b = appendVarint(b, 1<<3|proto.WireBytes)
+		b = appendBytes(b, []byte(turl))
+	}
+	if len(value) > 0 {
+		b = appendVarint(b, 2<<3|proto.WireBytes)
+		b = appendBytes(b, value)
+	}
+	return b, nil
+}
+
+// encodeItem appends the message extension f of a MessageSet, given as
+// JSON, to b as an item of the set.
+func (t *Transcoder) encodeItem(u *unmarshalState, b []byte, f *fieldDesc, raw json.RawMessage) ([]byte, error) {
+	msg, err := t.encodeMessage(u, f.message, raw, f.name)
+	if err != nil {
+		return nil, err
+	}
+	b = appendVarint(b, 1<<3|proto.WireStartGroup)
+	b = appendVarint(b, 2<<3|proto.WireVarint)
+	b = appendVarint(b, uint64(f.number))
+	b = appendVarint(b, 3<<3|proto.WireBytes)
+	b = appendBytes(b, msg)
+	return appendVarint(b, 1<<3|proto.WireEndGroup), nil
+}
+
+// encodeField appends the field f, given as JSON, to b.
+func (t *Transcoder) encodeField(u *unmarshalState, b []byte, f *fieldDesc, raw json.RawMessage) ([]byte, error) {
+	switch {
+	case f.isMap():
+		return t.encodeMap(u, b, f, raw)
+	case f.repeated:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return nil, err
+		}
+		if err := checkElements(&u.Options, len(elems), f.name); err != nil {
+			return nil, err
+		}
+		var packed []byte
+		for i, elem := range elems {
+			if u.Strict && string(elem) == "null" && !f.isValue() {
+				return nil, u.atPath(errors.New("null element"), indexPath(i))
+			}
+			var err error
+			if f.packed {
+				packed, err = t.encodeValue(u, packed, f, elem, true)
+			} else {
+				b, err = t.encodeSingle(u, b, f, elem, true)
+			}
+			if err != nil {
+				return nil, u.atPath(err, indexPath(i))
+			}
+		}
+		if len(packed) > 0 {
+			b = appendVarint(b, uint64(f.number)<<3|proto.WireBytes)
+			b = appendBytes(b, packed)
+		}
+		return b, nil
+	}
+	// Proto3 scalars are not written if they are zero, unless they
+	// are in a oneof.
+	return t.encodeSingle(u, b, f, raw, !f.proto3 || f.oneof > 0)
+}
+
+// encodeMap appends the entries of the map f, given as a JSON object,
+// to b, in the order of their keys.
+func (t *Transcoder) encodeMap(u *unmarshalState, b []byte, f *fieldDesc, raw json.RawMessage) ([]byte, error) {
+	keyField, valueField, err := f.mapFields()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkObject(raw); err != nil {
+		return nil, err
+	}
+	var mp map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &mp); err != nil {
+		return nil, err
+	}
+	if err := checkElements(&u.Options, len(mp), f.name); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(mp))
+	for k := range mp {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var entry []byte
+		var err error
+		if keyField.kind == pb.FieldDescriptorProto_TYPE_STRING {
+			entry = appendVarint(entry, 1<<3|proto.WireBytes)
+			entry = appendBytes(entry, []byte(k))
+		} else if entry, err = t.encodeSingle(u, entry, keyField, json.RawMessage(k), true); err != nil {
+			return nil, u.atPath(err, memberPath(k))
+		}
+		v := mp[k]
+		if u.Strict && string(v) == "null" && !valueField.isValue() {
+			return nil, u.atPath(errors.New("null element"), memberPath(k))
+		}
+		if entry, err = t.encodeSingle(u, entry, valueField, v, true); err != nil {
+			return nil, u.atPath(err, memberPath(k))
+		}
+		b = appendVarint(b, uint64(f.number)<<3|proto.WireBytes)
+		b = appendBytes(b, entry)
+	}
+	return b, nil
+}
+
+// encodeSingle appends the value of the field f, given as JSON, to b
+// with its tag. Zero scalars are only written if force is set.
+func (t *Transcoder) encodeSingle(u *unmarshalState, b []byte, f *fieldDesc, raw json.RawMessage, force bool) ([]byte, error) {
+	switch f.kind {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE:
+		msg, err := t.encodeMessage(u, f.message, raw, f.name)
+		if err != nil {
+			return nil, err
+		}
+		b = appendVarint(b, uint64(f.number)<<3|proto.WireBytes)
+		return appendBytes(b, msg), nil
+	case pb.FieldDescriptorProto_TYPE_GROUP:
+		msg, err := t.encodeMessage(u, f.message, raw, f.name)
+		if err != nil {
+			return nil, err
+		}
+		b = appendVarint(b, uint64(f.number)<<3|proto.WireStartGroup)
+		b = append(b, msg...)
+		return appendVarint(b, uint64(f.number)<<3|proto.WireEndGroup), nil
+	}
+	v, err := t.encodeValue(u, nil, f, raw, force)
+	if err != nil || len(v) == 0 {
+		return b, err
+	}
+	b = appendVarint(b, uint64(f.number)<<3|uint64(wireType(f.kind)))
+	return append(b, v...), nil
+}
+
+// encodeValue appends the scalar value of the field f, given as JSON,
+// to b without a tag. Zero values are only written if force is set.
+func (t *Transcoder) encodeValue(u *unmarshalState, b []byte, f *fieldDesc, raw json.RawMessage, force bool) ([]byte, error) {
+	d := &decoder{u: u}
+	var x uint64
+	switch f.kind {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		var s string
+		if err := d.String(raw, &s); err != nil {
+			return nil, err
+		}
+		if s == "" && !force {
+			return b, nil
+		}
+		return appendBytes(b, []byte(s)), nil
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		var s []byte
+		if err := d.Bytes(raw, &s); err != nil {
+			return nil, err
+		}
+		if len(s) == 0 && !force {
+			return b, nil
+		}
+		return appendBytes(b, s), nil
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		var v bool
+		if err := d.Bool(raw, &v); err != nil {
+			return nil, err
+		}
+		if v {
+			x = 1
+		}
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		var v int32
+		if err := d.Enum(raw, &v, f.enum.values, f.enum.name); err != nil {
+			return nil, err
+		}
+		x = uint64(v)
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		var v int32
+		if err := d.Int32(raw, &v); err != nil {
+			return nil, err
+		}
+		x = uint64(v)
+		if f.kind == pb.FieldDescriptorProto_TYPE_SFIXED32 {
+			x = uint64(uint32(v))
+		}
+	case pb.FieldDescriptorProto_TYPE_SINT32:
+		var v int32
+		if err := d.Int32(raw, &v); err != nil {
+			return nil, err
+		}
+		x = uint64(uint32(v<<1) ^ uint32(v>>31))
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		var v int64
+		if err := d.Int64(raw, &v); err != nil {
+			return nil, err
+		}
+		x = uint64(v)
+	case pb.FieldDescriptorProto_TYPE_SINT64:
+		var v int64
+		if err := d.Int64(raw, &v); err != nil {
+			return nil, err
+		}
+		x = uint64(v<<1) ^ uint64(v>>63)
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		var v uint32
+		if err := d.Uint32(raw, &v); err != nil {
+			return nil, err
+		}
+		x = uint64(v)
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		if err := d.Uint64(raw, &x); err != nil {
+			return nil, err
+		}
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		var v float32
+		if err := d.Float32(raw, &v); err != nil {
+			return nil, err
+		}
+		x = uint64(math.Float32bits(v))
+		if v == 0 && !force {
+			return b, nil
+		}
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		var v float64
+		if err := d.Float64(raw, &v); err != nil {
+			return nil, err
+		}
+		x = math.Float64bits(v)
+		if v == 0 && !force {
+			return b, nil
+		}
+	default:
+		return nil, fmt.Errorf("jsonpb: bad type %v of field %s", f.kind, f.name)
+	}
+	if x == 0 && !force {
+		return b, nil
+	}
+
+	switch wireType(f.kind) {
+	case proto.WireFixed32:
+		return append(b, byte(x), byte(x>>8), byte(x>>16), byte(x>>24)), nil
+	case proto.WireFixed64:
+		return append(b, byte(x), byte(x>>8), byte(x>>16), byte(x>>24),
+			byte(x>>32), byte(x>>40), byte(x>>48), byte(x>>56)), nil
+	}
+	return appendVarint(b, x), nil
+}
+
+func appendVarint(b []byte, x uint64) []byte {
+	return append(b, proto.EncodeVarint(x)...)
+}
+
+// appendBytes appends the length-delimited contents v to b.
+func appendBytes(b, v []byte) []byte {
+	b = appendVarint(b, uint64(len(v)))
+	return append(b, v...)
+}
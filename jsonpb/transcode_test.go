@@ -0,0 +1,234 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// newTestTranscoder returns a Transcoder for the files of the messages
+// and the files they depend on.
+func newTestTranscoder(t *testing.T, msgs ...descriptor.Message) *Transcoder {
+	set := new(descpb.FileDescriptorSet)
+	seen := make(map[string]bool)
+	var add func(fd *descpb.FileDescriptorProto)
+	add = func(fd *descpb.FileDescriptorProto) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		set.File = append(set.File, fd)
+		for _, dep := range fd.Dependency {
+			add(fileDescriptor(t, dep))
+		}
+	}
+	for _, msg := range msgs {
+		fd, _ := descriptor.ForMessage(msg)
+		add(fd)
+	}
+	tc, err := NewTranscoder(set)
+	if err != nil {
+		t.Fatalf("NewTranscoder: %v", err)
+	}
+	return tc
+}
+
+func TestTranscoderMarshaling(t *testing.T) {
+	tc := newTestTranscoder(t, &pb.Widget{}, &pb.Simple3{}, &proto3pb.Message{})
+	for _, tt := range marshalingTests {
+		b, err := proto.Marshal(tt.pb)
+		if err != nil {
+			t.Errorf("%s: proto.Marshal: %v", tt.desc, err)
+			continue
+		}
+		tc.Marshaler = tt.marshaler
+		js, err := tc.WireToJSON(proto.MessageName(tt.pb), b)
+		if err != nil {
+			t.Errorf("%s: WireToJSON: %v", tt.desc, err)
+		} else if string(js) != tt.json {
+			t.Errorf("%s: got [%s] want [%s]", tt.desc, js, tt.json)
+		}
+	}
+}
+
+func TestTranscoderUnmarshaling(t *testing.T) {
+	tc := newTestTranscoder(t, &pb.Widget{}, &pb.Simple3{}, &proto3pb.Message{})
+	for _, tt := range unmarshalingTests {
+		tc.Unmarshaler = tt.unmarshaler
+		b, err := tc.JSONToWire(proto.MessageName(tt.pb), []byte(tt.json))
+		if err != nil {
+			t.Errorf("%s: JSONToWire: %v", tt.desc, err)
+			continue
+		}
+		p := reflect.New(reflect.TypeOf(tt.pb).Elem()).Interface().(proto.Message)
+		if err := proto.Unmarshal(b, p); err != nil {
+			t.Errorf("%s: proto.Unmarshal: %v", tt.desc, err)
+			continue
+		}
+		// For easier diffs, compare text strings of the protos.
+		exp := proto.MarshalTextString(tt.pb)
+		act := proto.MarshalTextString(p)
+		if exp != act {
+			t.Errorf("%s: got [%s] want [%s]", tt.desc, act, exp)
+		}
+	}
+}
+
+func TestTranscoderRoundTrip(t *testing.T) {
+	tc := newTestTranscoder(t, &pb.Widget{}, &pb.Simple3{}, &proto3pb.Message{})
+	var names []string
+	for _, msg := range []descriptor.Message{&pb.Widget{}, &pb.Simple3{}, &proto3pb.Message{}} {
+		fd, _ := descriptor.ForMessage(msg)
+		names = append(names, fileMessages(fd)...)
+	}
+	names = append(names, fileMessages(fileDescriptor(t, "test_proto/test.proto"))...)
+	marshalers := []Marshaler{
+		{},
+		{OrigName: true, EnumsAsInts: true},
+		{EmitDefaults: true, Indent: "  "},
+		{Int64sAsNumbers: true, Naming: SnakeCase},
+		{RedactSensitive: true},
+	}
+	for _, name := range names {
+		mt := proto.MessageType(name)
+		if mt == nil {
+			t.Errorf("message %s is not registered", name)
+			continue
+		}
+		for variant := 0; variant < 10; variant++ {
+			want := reflect.New(mt.Elem()).Interface().(proto.Message)
+			populator{variant: variant, maxDepth: 1 + variant%2}.message(want, 0)
+			b, err := proto.Marshal(want)
+			if err != nil {
+				// Some messages, such as those holding a Value, are
+				// not valid when populated.
+				continue
+			}
+			for _, m := range marshalers {
+				wantJS, wantErr := m.MarshalToString(want)
+				tc.Marshaler = m
+				js, err := tc.WireToJSON(name, b)
+				if (err != nil) != (wantErr != nil) || string(js) != wantJS {
+					t.Errorf("WireToJSON(%s) with %+v:\n got %s, %v\nwant %s, %v", name, m, js, err, wantJS, wantErr)
+				}
+				if err != nil || m.RedactSensitive {
+					continue
+				}
+
+				tc.Unmarshaler = Unmarshaler{Strict: true, Naming: m.Naming}
+				wire, err := tc.JSONToWire(name, js)
+				if err != nil {
+					t.Errorf("JSONToWire(%s, %s): %v", name, js, err)
+					continue
+				}
+				got := reflect.New(mt.Elem()).Interface().(proto.Message)
+				if err := proto.Unmarshal(wire, got); err != nil {
+					t.Errorf("proto.Unmarshal(JSONToWire(%s, %s)): %v", name, js, err)
+				} else if !proto.Equal(got, want) {
+					t.Errorf("round trip of %s with %+v:\n got %v\nwant %v", name, m, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTranscoderErrors(t *testing.T) {
+	tc := newTestTranscoder(t, &pb.Widget{}, &proto3pb.Message{})
+	tests := []struct {
+		desc string
+		u    Unmarshaler
+		name string
+		in   string
+		err  string
+	}{
+		{"unknown message", Unmarshaler{}, "jsonpb.Bogus", `{}`, `unknown message type "jsonpb.Bogus"`},
+		{"unknown field", Unmarshaler{}, "jsonpb.Simple", `{"bogus":1}`, `unknown field "bogus" in jsonpb.Simple`},
+		{"unset required field", Unmarshaler{}, "jsonpb.MsgWithRequired", `{}`, `required field "str" is not set`},
+		{"unknown Any type", Unmarshaler{}, "jsonpb.KnownTypes", `{"an":{"@type":"example.com/x.Y"}}`, `unknown message type "x.Y"`},
+		{"bad enum", Unmarshaler{}, "jsonpb.Widget", `{"color":"PINK"}`, `unknown value "PINK" for enum jsonpb.Widget.Color`},
+		{"strict path", Unmarshaler{Strict: true}, "jsonpb.Widget", `{"rSimple":[{},{"oInt32":"x"}]}`, "$.rSimple[1].oInt32: "},
+		{"strict null element", Unmarshaler{Strict: true}, "jsonpb.Maps", `{"mBoolSimple":{"true":null}}`, "$.mBoolSimple.true: null element"},
+		{"depth limit", Unmarshaler{Options: proto.UnmarshalOptions{MaxDepth: 2}}, "proto3_proto.Message", `{"submessage":{"submessage":{"name":"x"}}}`, "depth"},
+		{"elements limit", Unmarshaler{Options: proto.UnmarshalOptions{MaxElements: 1}}, "proto3_proto.Message", `{"stringMap":{"a":"b","c":"d"}}`, "elements"},
+	}
+	for _, tt := range tests {
+		tc.Unmarshaler = tt.u
+		_, err := tc.JSONToWire(tt.name, []byte(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: JSONToWire: got error %v, want %q", tt.desc, err, tt.err)
+		}
+	}
+
+	wireTests := []struct {
+		desc string
+		name string
+		in   []byte
+	}{
+		{"unset required field", "jsonpb.MsgWithRequired", nil},
+		{"malformed tag", "jsonpb.Simple", []byte{0xff}},
+		{"empty packed singular field", "jsonpb.Simple3", []byte{0x0a, 0x00}},
+		{"truncated packed field", "jsonpb.Repeats", []byte{0x12, 0x01, 0x80}},
+		{"truncated length", "jsonpb.Repeats", []byte{0x12, 0x02, 0x01}},
+		{"unterminated group", "jsonpb.Extended", []byte{0x3b}},
+		{"truncated group", "jsonpb.Extended", []byte{0x3b, 0x42, 0x05, 0x3c}},
+		{"MessageSet item without message", "jsonpb.MsgSet", []byte{0x0b, 0x10, 0x64, 0x0c}},
+		{"truncated MessageSet item", "jsonpb.MsgSet", []byte{0x0b, 0x10, 0x64, 0x1a, 0x02, 0x0a}},
+	}
+	for _, tt := range wireTests {
+		if js, err := tc.WireToJSON(tt.name, tt.in); err == nil {
+			t.Errorf("%s: WireToJSON(%x) = %s, want error", tt.desc, tt.in, js)
+		}
+	}
+}
+
+func TestTranscoderUnknownFields(t *testing.T) {
+	tc := newTestTranscoder(t, &pb.Simple3{})
+	tc.Unmarshaler = Unmarshaler{UnknownFields: new(UnknownFields)}
+	// There is no message to keep the unknown fields for,
+	// so they are dropped.
+	b, err := tc.JSONToWire("jsonpb.Simple3", []byte(`{"dub":1,"extra":{"a":[1,2]}}`))
+	if err != nil {
+		t.Fatalf("JSONToWire: %v", err)
+	}
+	if js, err := tc.WireToJSON("jsonpb.Simple3", b); err != nil || string(js) != `{"dub":1}` {
+		t.Errorf("WireToJSON: got %s, %v; want %s", js, err, `{"dub":1}`)
+	}
+}
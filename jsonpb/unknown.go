@@ -0,0 +1,167 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// UnknownFields keeps the unknown JSON fields of the messages read by an
+// Unmarshaler, by message, so that a Marshaler writes them back. Unlike
+// the unknown fields of the wire format, they are kept outside of the
+// messages: they don't survive the wire format or a copy of a message,
+// and messages held in Any fields don't keep them. An UnknownFields may
+// be used concurrently.
+type UnknownFields struct {
+	mu     sync.Mutex
+	fields map[proto.Message]map[string]json.RawMessage
+}
+
+// Get returns the unknown JSON fields kept for pb, by key.
+func (uf *UnknownFields) Get(pb proto.Message) map[string]json.RawMessage {
+	uf.mu.Lock()
+	defer uf.mu.Unlock()
+	if len(uf.fields[pb]) == 0 {
+		return nil
+	}
+	fields := make(map[string]json.RawMessage, len(uf.fields[pb]))
+	for key, value := range uf.fields[pb] {
+		fields[key] = value
+	}
+	return fields
+}
+
+// Delete drops the unknown JSON fields kept for pb.
+func (uf *UnknownFields) Delete(pb proto.Message) {
+	uf.mu.Lock()
+	defer uf.mu.Unlock()
+	delete(uf.fields, pb)
+}
+
+// keep keeps the JSON fields for pb, replacing those it already has
+// with the same keys.
+func (uf *UnknownFields) keep(pb proto.Message, jsonFields map[string]json.RawMessage) error {
+	values := make(map[string]json.RawMessage, len(jsonFields))
+	for key, raw := range jsonFields {
+		var value bytes.Buffer
+		if err := json.Compact(&value, raw); err != nil {
+			return err
+		}
+		values[key] = value.Bytes()
+	}
+	uf.mu.Lock()
+	defer uf.mu.Unlock()
+	if uf.fields == nil {
+		uf.fields = make(map[proto.Message]map[string]json.RawMessage)
+	}
+	if uf.fields[pb] == nil {
+		uf.fields[pb] = values
+		return nil
+	}
+	for key, value := range values {
+		uf.fields[pb][key] = value
+	}
+	return nil
+}
+
+// marshalUnknown writes the unknown JSON fields kept for the message v
+// in m.UnknownFields, which are not preceded by a field if firstField is
+// set. It reports whether no field has been written after all. Fields
+// whose keys are now names of a field or an extension of the message
+// are not written.
+func (m *Marshaler) marshalUnknown(out *errWriter, v proto.Message, indent string, firstField bool) (bool, error) {
+	if m.UnknownFields == nil {
+		return firstField, nil
+	}
+	fields := m.UnknownFields.Get(v)
+	if len(fields) == 0 {
+		return firstField, nil
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		if !m.knownKey(v, key) {
+			keys = append(keys, key)
+		}
+	}
+	// Sort keys for stable output.
+	sort.Strings(keys)
+	for _, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return false, err
+		}
+		if !firstField {
+			m.writeSep(out)
+		}
+		m.marshalFieldName(out, string(name[1:len(name)-1]), indent)
+		value := []byte(fields[key])
+		if m.Indent != "" {
+			var b bytes.Buffer
+			if err := json.Indent(&b, value, indent+m.Indent, m.Indent); err != nil {
+				return false, err
+			}
+			value = b.Bytes()
+		}
+		out.write(string(value))
+		firstField = false
+	}
+	return firstField, out.err
+}
+
+// knownKey reports whether key is one of the names accepted for a field
+// of the message v, or the name of one of its extensions.
+func (m *Marshaler) knownKey(v proto.Message, key string) bool {
+	if strings.HasPrefix(key, "[") && strings.HasSuffix(key, "]") {
+		ext := proto.FindExtensionByName(key[1 : len(key)-1])
+		return ext != nil && reflect.TypeOf(ext.ExtendedType) == reflect.TypeOf(v)
+	}
+	t := reflect.TypeOf(v).Elem()
+	sprops := proto.GetProperties(t)
+	for i := 0; i < t.NumField(); i++ {
+		if !strings.HasPrefix(t.Field(i).Name, "XXX_") && hasJSONFieldName(sprops.Prop[i], m.Naming, key) {
+			return true
+		}
+	}
+	for _, oop := range sprops.OneofTypes {
+		if hasJSONFieldName(oop.Prop, m.Naming, key) {
+			return true
+		}
+	}
+	return false
+}
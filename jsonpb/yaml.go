@@ -0,0 +1,1266 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// This file converts between YAML and the JSON of Marshaler and
+// Unmarshaler, so that YAML follows the same rules for field names,
+// well-known types, enums and Any.
+//
+// The YAML that is read is a single document of block and flow
+// collections and scalars, which may be plain, quoted or literal and
+// folded block scalars. Anchors, aliases, tags, directives and complex
+// keys are not supported.
+
+// YAMLError is an error about YAML input, with the line it is about.
+type YAMLError struct {
+	Line int // 1-based
+	Err  error
+}
+
+func (e *YAMLError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// MarshalYAML marshals a protocol buffer into YAML, in block style.
+// Indent is not used; collections are indented by two spaces.
+func (m *Marshaler) MarshalYAML(out io.Writer, pb proto.Message) error {
+	jm := *m
+	jm.Indent = ""
+	var b bytes.Buffer
+	if err := jm.Marshal(&b, pb); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(&b)
+	dec.UseNumber()
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return err
+	}
+	w := &errWriter{writer: out}
+	switch v := v.(type) {
+	case *orderedObject:
+		if len(v.keys) > 0 {
+			writeYAMLObject(w, v, "", "")
+			return w.err
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			writeYAMLArray(w, v, "", "")
+			return w.err
+		}
+	}
+	w.write(yamlScalar(v))
+	w.write("\n")
+	return w.err
+}
+
+// MarshalYAMLString converts a protocol buffer object to a YAML string.
+func (m *Marshaler) MarshalYAMLString(pb proto.Message) (string, error) {
+	var buf bytes.Buffer
+	if err := m.MarshalYAML(&buf, pb); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// UnmarshalYAML unmarshals a YAML document into a protocol buffer,
+// with the rules of Unmarshal. Plain scalars that are not strings in
+// YAML, such as 42 or true, are nonetheless accepted for string fields,
+// which get their text as written, so that 0x1F stays 0x1F.
+// Errors about the input are reported as a *YAMLError.
+func (u *Unmarshaler) UnmarshalYAML(r io.Reader, pb proto.Message) error {
+	if u.Options.MaxBytes > 0 {
+		// Stop reading as soon as the input is known to be too large.
+		r = &limitReader{r: r, opts: &u.Options}
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	n, err := parseYAML(b)
+	if err != nil {
+		return err
+	}
+	var js bytes.Buffer
+	lines := make(map[string]int)
+	n.writeJSON(&js, "$", lines, u, reflect.TypeOf(pb))
+
+	s := &unmarshalState{Unmarshaler: u, paths: true}
+	err = s.unmarshalNext(js.Bytes(), pb)
+	if pe, ok := err.(*PathError); ok {
+		// Report the line of the innermost value of the path that is
+		// in the input.
+		for path := pe.Path; path != ""; path = parentPath(path) {
+			if line, ok := lines[path]; ok {
+				return &YAMLError{Line: line, Err: err}
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalYAMLString will populate the fields of a protocol buffer
+// based on a YAML string.
+func UnmarshalYAMLString(str string, pb proto.Message) error {
+	return new(Unmarshaler).UnmarshalYAML(strings.NewReader(str), pb)
+}
+
+// parentPath returns the JSON path without its last element.
+func parentPath(path string) string {
+	if strings.HasSuffix(path, `"]`) {
+		// A quoted key, which may hold any character.
+		for i := len(path) - 3; i > 0; i-- {
+			if path[i] == '"' && path[i-1] == '[' {
+				if _, err := strconv.Unquote(path[i : len(path)-1]); err == nil {
+					return path[:i-1]
+				}
+			}
+		}
+		return ""
+	}
+	if i := strings.LastIndexAny(path, ".["); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+// An orderedObject is a JSON object whose keys keep their order.
+type orderedObject struct {
+	keys   []string
+	values []interface{}
+}
+
+// decodeOrdered decodes the next JSON value of dec, whose objects are
+// returned as *orderedObject.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := new(orderedObject)
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.keys = append(obj.keys, key.(string))
+			obj.values = append(obj.values, v)
+		}
+		_, err := dec.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := []interface{}{}
+		for dec.More() {
+			v, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err := dec.Token()
+		return arr, err
+	}
+	return tok, nil
+}
+
+// writeYAMLObject writes the entries of obj, the first of them preceded
+// by first and the others by rest.
+func writeYAMLObject(w *errWriter, obj *orderedObject, first, rest string) {
+	for i, key := range obj.keys {
+		if i == 0 {
+			w.write(first)
+		} else {
+			w.write(rest)
+		}
+		w.write(yamlScalar(key))
+		w.write(":")
+		switch v := obj.values[i].(type) {
+		case *orderedObject:
+			if len(v.keys) > 0 {
+				w.write("\n")
+				writeYAMLObject(w, v, rest+"  ", rest+"  ")
+				continue
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				w.write("\n")
+				writeYAMLArray(w, v, rest+"  ", rest+"  ")
+				continue
+			}
+		}
+		w.write(" ")
+		w.write(yamlScalar(obj.values[i]))
+		w.write("\n")
+	}
+}
+
+// writeYAMLArray writes the elements of arr, the first of them preceded
+// by first and the others by rest.
+func writeYAMLArray(w *errWriter, arr []interface{}, first, rest string) {
+	for i, v := range arr {
+		if i == 0 {
+			w.write(first)
+		} else {
+			w.write(rest)
+		}
+		w.write("- ")
+		switch v := v.(type) {
+		case *orderedObject:
+			if len(v.keys) > 0 {
+				writeYAMLObject(w, v, "", rest+"  ")
+				continue
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				writeYAMLArray(w, v, "", rest+"  ")
+				continue
+			}
+		}
+		w.write(yamlScalar(v))
+		w.write("\n")
+	}
+}
+
+// yamlScalar returns the YAML of a JSON scalar or empty collection.
+func yamlScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		if plainYAMLString(v) {
+			return v
+		}
+		// Go escapes are all YAML escapes too.
+		return strconv.Quote(v)
+	case *orderedObject:
+		return "{}"
+	}
+	return "[]"
+}
+
+// plainYAMLString reports whether s can be written as a plain scalar.
+func plainYAMLString(s string) bool {
+	if s == "" || plainJSON(s)[0] != '"' || strings.HasPrefix(s, "...") {
+		return false
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@` ") || strings.HasSuffix(s, " ") || strings.HasSuffix(s, ":") {
+		return false
+	}
+	if strings.Contains(s, ": ") || strings.Contains(s, " #") {
+		return false
+	}
+	for _, c := range s {
+		if c < ' ' || c == 0x7f || c == utf8.RuneError || c == '\u0085' || c == '\u2028' || c == '\u2029' || c == '\ufeff' {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	yamlIntRE   = regexp.MustCompile(`^[-+]?[0-9]+$`)
+	yamlFloatRE = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+	jsonNumRE   = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?$`)
+)
+
+// plainJSON returns the JSON of the plain scalar s, following the core
+// schema of YAML 1.2.
+func plainJSON(s string) string {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return "null"
+	case "true", "True", "TRUE":
+		return "true"
+	case "false", "False", "FALSE":
+		return "false"
+	case ".inf", ".Inf", ".INF", "+.inf", "+.Inf", "+.INF":
+		return `"Infinity"`
+	case "-.inf", "-.Inf", "-.INF":
+		return `"-Infinity"`
+	case ".nan", ".NaN", ".NAN":
+		return `"NaN"`
+	}
+	switch {
+	case jsonNumRE.MatchString(s):
+		return s
+	case yamlIntRE.MatchString(s):
+		neg := s[0] == '-'
+		s = strings.TrimLeft(s, "+-0")
+		if s == "" {
+			return "0"
+		}
+		if neg {
+			s = "-" + s
+		}
+		return s
+	case strings.HasPrefix(s, "0o") || strings.HasPrefix(s, "0x"):
+		base := 8
+		if s[1] == 'x' {
+			base = 16
+		}
+		if n, err := strconv.ParseUint(s[2:], base, 64); err == nil {
+			return strconv.FormatUint(n, 10)
+		}
+	case yamlFloatRE.MatchString(s):
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	}
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type yamlKind int
+
+const (
+	yamlScalarNode yamlKind = iota
+	yamlMappingNode
+	yamlSequenceNode
+)
+
+// A yamlNode is a node of a YAML document.
+type yamlNode struct {
+	kind   yamlKind
+	line   int
+	value  string      // of a scalar
+	plain  bool        // whether a scalar is plain, as opposed to quoted or a block scalar
+	keys   []*yamlNode // of a mapping, which are scalars
+	values []*yamlNode // of a mapping or a sequence
+}
+
+// writeJSON writes the JSON of n, which is at the given JSON path,
+// and records the lines of n and the nodes it holds in lines. t is the
+// type of the value that u decodes n into, if known. Plain scalars,
+// such as 42 or true, are written as strings for string fields, with
+// their text as written.
+func (n *yamlNode) writeJSON(b *bytes.Buffer, path string, lines map[string]int, u *Unmarshaler, t reflect.Type) {
+	if _, ok := lines[path]; !ok {
+		lines[path] = n.line
+	}
+	switch n.kind {
+	case yamlMappingNode:
+		b.WriteByte('{')
+		for i, k := range n.keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			key, _ := json.Marshal(k.value)
+			b.Write(key)
+			b.WriteByte(':')
+			// Errors about a member are reported at its key.
+			elem := path + memberPath(k.value)
+			lines[elem] = k.line
+			n.values[i].writeJSON(b, elem, lines, u, n.memberType(u, t, k.value))
+		}
+		b.WriteByte('}')
+	case yamlSequenceNode:
+		var et reflect.Type
+		if t != nil && t.Kind() == reflect.Slice {
+			et = t.Elem()
+		}
+		b.WriteByte('[')
+		for i, v := range n.values {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			v.writeJSON(b, path+indexPath(i), lines, u, et)
+		}
+		b.WriteByte(']')
+	default:
+		if n.plain {
+			if js := plainJSON(n.value); js == "null" || !isStringType(t) {
+				b.WriteString(js)
+				return
+			}
+		}
+		s, _ := json.Marshal(n.value)
+		b.Write(s)
+	}
+}
+
+// memberType returns the type of the value of the member with the given
+// key of the mapping n, which u decodes into a value of type t, or nil
+// if it is not known.
+func (n *yamlNode) memberType(u *Unmarshaler, t reflect.Type, key string) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Elem()
+	case reflect.Struct:
+	default:
+		return nil
+	}
+	if w, ok := reflect.New(t).Interface().(wkt); ok {
+		if w.XXX_WellKnownType() != "Any" || key == "@type" {
+			return nil
+		}
+		m := n.anyMessage(u)
+		if m == nil {
+			return nil
+		}
+		if _, ok := m.(wkt); ok {
+			if key == "value" {
+				return reflect.TypeOf(m)
+			}
+			return nil
+		}
+		return n.memberType(u, reflect.TypeOf(m), key)
+	}
+	if strings.HasPrefix(key, "[") && strings.HasSuffix(key, "]") {
+		ext := proto.FindExtensionByName(key[1 : len(key)-1])
+		if ext == nil || reflect.TypeOf(ext.ExtendedType) != reflect.PtrTo(t) {
+			return nil
+		}
+		return reflect.TypeOf(ext.ExtensionType)
+	}
+	sprops := proto.GetProperties(t)
+	for i := 0; i < t.NumField(); i++ {
+		if !strings.HasPrefix(t.Field(i).Name, "XXX_") && hasJSONFieldName(sprops.Prop[i], u.Naming, key) {
+			return t.Field(i).Type
+		}
+	}
+	for _, oop := range sprops.OneofTypes {
+		if hasJSONFieldName(oop.Prop, u.Naming, key) {
+			return oop.Type.Elem().Field(0).Type
+		}
+	}
+	return nil
+}
+
+// anyMessage returns a message of the type named by the @type member
+// of the mapping n, which holds an Any, or nil if it can't be resolved.
+func (n *yamlNode) anyMessage(u *Unmarshaler) proto.Message {
+	for i, k := range n.keys {
+		if k.value != "@type" || n.values[i].kind != yamlScalarNode {
+			continue
+		}
+		var m proto.Message
+		var err error
+		if u.AnyResolver != nil {
+			m, err = u.AnyResolver.Resolve(n.values[i].value)
+		} else {
+			m, err = defaultResolveAny(n.values[i].value)
+		}
+		if err != nil {
+			return nil
+		}
+		return m
+	}
+	return nil
+}
+
+// isStringType reports whether t is the type of a string field or
+// of a google.protobuf.StringValue.
+func isStringType(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if w, ok := reflect.New(t).Interface().(wkt); ok && w.XXX_WellKnownType() == "StringValue" {
+		return true
+	}
+	return t.Kind() == reflect.String
+}
+
+// yamlParser parses a YAML document.
+type yamlParser struct {
+	s         []byte
+	pos       int
+	line      int // the 1-based line of pos
+	lineStart int // the offset of the line of pos
+}
+
+// parseYAML parses the YAML document s. An empty document is an empty mapping.
+func parseYAML(s []byte) (*yamlNode, error) {
+	s = bytes.TrimPrefix(s, []byte("\ufeff"))
+	p := &yamlParser{s: s, line: 1}
+	if err := p.skipBlank(); err != nil {
+		return nil, err
+	}
+	if p.peek() == '%' {
+		return nil, p.errorf("directives are not supported")
+	}
+	if p.atMarker("---") {
+		p.pos += 3
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+	}
+	n := &yamlNode{kind: yamlMappingNode, line: p.line}
+	if !p.eof() && !p.atMarker("...") && !p.atMarker("---") {
+		var err error
+		if n, err = p.parseNode(-1); err != nil {
+			return nil, err
+		}
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+	}
+	if p.atMarker("...") {
+		p.pos += 3
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+	}
+	if p.atMarker("---") {
+		return nil, p.errorf("multiple documents are not supported")
+	}
+	if !p.eof() {
+		return nil, p.errorf("unexpected %q", p.rest())
+	}
+	return n, nil
+}
+
+func (p *yamlParser) errorf(format string, a ...interface{}) error {
+	return &YAMLError{Line: p.line, Err: fmt.Errorf(format, a...)}
+}
+
+func (p *yamlParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+// peek returns the byte at pos, or 0 at the end of the input.
+func (p *yamlParser) peek() byte {
+	return p.at(0)
+}
+
+// at returns the byte at pos+i, or 0 past the end of the input.
+func (p *yamlParser) at(i int) byte {
+	if p.pos+i >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos+i]
+}
+
+// col returns the 0-based column of pos.
+func (p *yamlParser) col() int {
+	return p.pos - p.lineStart
+}
+
+// rest returns the rest of the line of pos.
+func (p *yamlParser) rest() string {
+	s := p.s[p.pos:]
+	if i := bytes.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(string(s))
+}
+
+// isSpace reports whether the byte at pos+i separates tokens.
+func (p *yamlParser) isSpace(i int) bool {
+	switch p.at(i) {
+	case ' ', '\t', '\r', '\n', 0:
+		return true
+	}
+	return false
+}
+
+// atMarker reports whether pos is at the document marker m.
+func (p *yamlParser) atMarker(m string) bool {
+	return p.col() == 0 && bytes.HasPrefix(p.s[p.pos:], []byte(m)) && p.isSpace(len(m))
+}
+
+// advance moves past the byte at pos.
+func (p *yamlParser) advance() {
+	if p.s[p.pos] == '\n' {
+		p.line++
+		p.lineStart = p.pos + 1
+	}
+	p.pos++
+}
+
+// skipSpace skips the spaces and tabs at pos.
+func (p *yamlParser) skipSpace() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.pos++
+	}
+}
+
+// skipComment skips the spaces and the comment at pos, if any.
+func (p *yamlParser) skipComment() {
+	p.skipSpace()
+	if p.peek() == '#' {
+		for !p.eof() && p.peek() != '\n' {
+			p.pos++
+		}
+	}
+}
+
+// atEOL reports whether only spaces and a comment are left on the line.
+func (p *yamlParser) atEOL() bool {
+	p.skipComment()
+	c := p.peek()
+	return c == '\n' || c == '\r' || c == 0
+}
+
+// endOfLine skips the rest of the line, which must be empty.
+func (p *yamlParser) endOfLine() error {
+	if !p.atEOL() {
+		return p.errorf("unexpected %q", p.rest())
+	}
+	return nil
+}
+
+// skipBlank skips the empty lines and comments at pos, up to the next
+// content, which must not be indented by tabs.
+func (p *yamlParser) skipBlank() error {
+	for {
+		p.skipComment()
+		switch p.peek() {
+		case '\n', '\r':
+			p.advance()
+			continue
+		}
+		if !p.eof() && bytes.IndexByte(p.s[p.lineStart:p.pos], '\t') >= 0 {
+			return p.errorf("tabs are not allowed in indentation")
+		}
+		return nil
+	}
+}
+
+// atSequenceEntry reports whether pos is at a sequence entry.
+func (p *yamlParser) atSequenceEntry() bool {
+	return p.peek() == '-' && p.isSpace(1)
+}
+
+// atMappingColon reports whether pos is at the colon after a key.
+func (p *yamlParser) atMappingColon() bool {
+	return p.peek() == ':' && p.isSpace(1)
+}
+
+// parseNode parses the block node at pos, which is in a collection
+// whose entries are indented by indent columns.
+func (p *yamlParser) parseNode(indent int) (*yamlNode, error) {
+	col := p.col()
+	switch c := p.peek(); {
+	case p.atSequenceEntry():
+		return p.parseSequence(col)
+	case c == '[' || c == '{':
+		n, err := p.parseFlow()
+		if err != nil {
+			return nil, err
+		}
+		return n, p.endOfLine()
+	case c == '|' || c == '>':
+		return p.parseBlockScalar(indent)
+	}
+	n, err := p.parseScalar(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.atMappingColon() {
+		return p.parseMapping(col, n)
+	}
+	return n, p.endScalar(n, indent)
+}
+
+// endScalar skips the rest of the line of the block scalar n, and adds
+// the lines that continue it if it is plain.
+func (p *yamlParser) endScalar(n *yamlNode, indent int) error {
+	if p.atMappingColon() {
+		return p.errorf("mapping values are not allowed here")
+	}
+	if p.peek() == '#' || !n.plain {
+		return p.endOfLine()
+	}
+	if err := p.endOfLine(); err != nil {
+		return err
+	}
+	for {
+		// Look for a line indented further than indent.
+		pos, line, lineStart := p.pos, p.line, p.lineStart
+		breaks := 0
+		for {
+			p.skipSpace()
+			if c := p.peek(); c != '\n' && c != '\r' {
+				break
+			}
+			if p.peek() == '\r' {
+				p.pos++
+			}
+			if p.peek() == '\n' {
+				p.advance()
+				breaks++
+			}
+		}
+		if p.eof() || breaks == 0 || p.col() <= indent || p.peek() == '#' || p.atMarker("---") || p.atMarker("...") {
+			p.pos, p.line, p.lineStart = pos, line, lineStart
+			return nil
+		}
+		more, err := p.parsePlain(false)
+		if err != nil {
+			return err
+		}
+		if breaks == 1 {
+			n.value += " " + more
+		} else {
+			n.value += strings.Repeat("\n", breaks-1) + more
+		}
+		if p.atMappingColon() {
+			return p.errorf("mapping values are not allowed here")
+		}
+		if p.peek() == '#' {
+			return p.endOfLine()
+		}
+		if err := p.endOfLine(); err != nil {
+			return err
+		}
+	}
+}
+
+// parseMapping parses the block mapping at pos, whose keys are indented
+// by col columns and whose first key has been parsed.
+func (p *yamlParser) parseMapping(col int, key *yamlNode) (*yamlNode, error) {
+	n := &yamlNode{kind: yamlMappingNode, line: key.line}
+	seen := make(map[string]bool)
+	for {
+		if seen[key.value] {
+			return nil, &YAMLError{Line: key.line, Err: fmt.Errorf("duplicate key %q", key.value)}
+		}
+		seen[key.value] = true
+		p.pos++ // the colon
+		v, err := p.parseValue(col)
+		if err != nil {
+			return nil, err
+		}
+		n.keys = append(n.keys, key)
+		n.values = append(n.values, v)
+
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+		if p.eof() || p.col() < col || p.atMarker("---") || p.atMarker("...") {
+			return n, nil
+		}
+		if p.col() > col {
+			return nil, p.errorf("bad indentation of a mapping entry")
+		}
+		if p.atSequenceEntry() {
+			return nil, p.errorf("unexpected sequence entry in a mapping")
+		}
+		if key, err = p.parseScalar(false); err != nil {
+			return nil, err
+		}
+		if !p.atMappingColon() {
+			return nil, p.errorf("could not find the colon after key %q", key.value)
+		}
+	}
+}
+
+// parseValue parses the value after the colon of a key indented by
+// col columns.
+func (p *yamlParser) parseValue(col int) (*yamlNode, error) {
+	line := p.line
+	if p.atEOL() {
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+		if !p.eof() && !p.atMarker("---") && !p.atMarker("...") &&
+			(p.col() > col || p.col() == col && p.atSequenceEntry()) {
+			return p.parseNode(col)
+		}
+		return &yamlNode{kind: yamlScalarNode, line: line, plain: true}, nil
+	}
+	switch c := p.peek(); {
+	case p.atSequenceEntry():
+		return nil, p.errorf("sequence entries are not allowed here")
+	case c == '[' || c == '{':
+		n, err := p.parseFlow()
+		if err != nil {
+			return nil, err
+		}
+		return n, p.endOfLine()
+	case c == '|' || c == '>':
+		return p.parseBlockScalar(col)
+	}
+	n, err := p.parseScalar(false)
+	if err != nil {
+		return nil, err
+	}
+	return n, p.endScalar(n, col)
+}
+
+// parseSequence parses the block sequence at pos, whose entries are
+// indented by col columns.
+func (p *yamlParser) parseSequence(col int) (*yamlNode, error) {
+	n := &yamlNode{kind: yamlSequenceNode, line: p.line}
+	for {
+		line := p.line
+		p.pos++ // the dash
+		var v *yamlNode
+		if p.atEOL() {
+			if err := p.skipBlank(); err != nil {
+				return nil, err
+			}
+			if !p.eof() && p.col() > col && !p.atMarker("---") && !p.atMarker("...") {
+				var err error
+				if v, err = p.parseNode(col); err != nil {
+					return nil, err
+				}
+			} else {
+				v = &yamlNode{kind: yamlScalarNode, line: line, plain: true}
+			}
+		} else {
+			var err error
+			if v, err = p.parseNode(col); err != nil {
+				return nil, err
+			}
+		}
+		n.values = append(n.values, v)
+
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+		if p.eof() || p.col() < col || p.atMarker("---") || p.atMarker("...") {
+			return n, nil
+		}
+		if p.col() > col {
+			return nil, p.errorf("bad indentation of a sequence entry")
+		}
+		if !p.atSequenceEntry() {
+			// The sequence is the value of a key with the same indentation.
+			return n, nil
+		}
+	}
+}
+
+// parseBlockScalar parses the literal or folded block scalar at pos,
+// in a collection whose entries are indented by indent columns.
+func (p *yamlParser) parseBlockScalar(indent int) (*yamlNode, error) {
+	n := &yamlNode{kind: yamlScalarNode, line: p.line}
+	folded := p.peek() == '>'
+	p.pos++
+	chomp := byte(0)
+	explicit := 0
+	for i := 0; i < 2; i++ {
+		switch c := p.peek(); {
+		case (c == '-' || c == '+') && chomp == 0:
+			chomp = c
+			p.pos++
+		case '1' <= c && c <= '9' && explicit == 0:
+			explicit = int(c - '0')
+			p.pos++
+		}
+	}
+	if !p.isSpace(0) && p.peek() != '#' {
+		return nil, p.errorf("bad block scalar header")
+	}
+	if err := p.endOfLine(); err != nil {
+		return nil, err
+	}
+	if p.peek() == '\r' {
+		p.pos++
+	}
+	if p.peek() == '\n' {
+		p.advance()
+	}
+
+	// Read the lines of the scalar, which are indented by at least
+	// contentIndent columns.
+	contentIndent := -1
+	if explicit > 0 {
+		contentIndent = indent + explicit
+		if indent < 0 {
+			contentIndent = explicit
+		}
+	}
+	var lines []string
+	for !p.eof() {
+		end := bytes.IndexByte(p.s[p.pos:], '\n')
+		if end < 0 {
+			end = len(p.s) - p.pos
+		}
+		text := strings.TrimSuffix(string(p.s[p.pos:p.pos+end]), "\r")
+		spaces := len(text) - len(strings.TrimLeft(text, " "))
+		if strings.TrimSpace(text) == "" {
+			if contentIndent >= 0 && len(text) > contentIndent {
+				lines = append(lines, text[contentIndent:])
+			} else {
+				lines = append(lines, "")
+			}
+		} else {
+			if contentIndent < 0 {
+				if spaces <= indent {
+					break
+				}
+				contentIndent = spaces
+			}
+			if spaces < contentIndent {
+				break
+			}
+			if p.col() == 0 && (p.atMarker("---") || p.atMarker("...")) {
+				break
+			}
+			lines = append(lines, text[contentIndent:])
+		}
+		p.pos += end
+		if !p.eof() {
+			p.advance()
+		}
+	}
+	if !p.eof() {
+		// Leave pos at the start of the line after the scalar.
+		p.pos = p.lineStart
+	}
+
+	// Trailing empty lines are subject to chomping.
+	trailing := 0
+	for trailing < len(lines) && strings.TrimSpace(lines[len(lines)-1-trailing]) == "" {
+		trailing++
+	}
+	body := lines[:len(lines)-trailing]
+	var b bytes.Buffer
+	for i, l := range body {
+		if i > 0 {
+			prev := body[i-1]
+			switch {
+			case !folded:
+				b.WriteByte('\n')
+			case l == "" || prev == "" || l[0] == ' ' || l[0] == '\t' || prev[0] == ' ' || prev[0] == '\t':
+				// Empty and more indented lines are not folded.
+				if l == "" && prev != "" && prev[0] != ' ' && prev[0] != '\t' {
+					break
+				}
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(l)
+	}
+	if len(body) > 0 {
+		switch chomp {
+		case 0:
+			b.WriteByte('\n')
+		case '+':
+			b.WriteString(strings.Repeat("\n", trailing+1))
+		}
+	} else if chomp == '+' {
+		b.WriteString(strings.Repeat("\n", trailing))
+	}
+	n.value = b.String()
+	return n, nil
+}
+
+// parseScalar parses the quoted or plain scalar at pos, which is in a
+// flow collection if flow is set.
+func (p *yamlParser) parseScalar(flow bool) (*yamlNode, error) {
+	n := &yamlNode{kind: yamlScalarNode, line: p.line}
+	var err error
+	switch p.peek() {
+	case '"', '\'':
+		n.value, err = p.parseQuoted()
+		p.skipSpace()
+	default:
+		n.plain = true
+		n.value, err = p.parsePlain(flow)
+	}
+	return n, err
+}
+
+// parsePlain parses the plain scalar at pos, up to the end of its line.
+func (p *yamlParser) parsePlain(flow bool) (string, error) {
+	switch c := p.peek(); {
+	case c == '&' || c == '*':
+		return "", p.errorf("anchors and aliases are not supported")
+	case c == '!':
+		return "", p.errorf("tags are not supported")
+	case c == '?' && p.isSpace(1):
+		return "", p.errorf("complex keys are not supported")
+	case strings.IndexByte("|>@`%", c) >= 0 && c != 0, c == ',' || c == ']' || c == '}', c == ':' && p.isSpace(1):
+		return "", p.errorf("unexpected %q", p.rest())
+	}
+	start := p.pos
+	end := p.pos
+	for !p.eof() {
+		c := p.peek()
+		if c == '\n' || c == '\r' || c == ':' && (p.isSpace(1) || flow && strings.IndexByte(",[]{}", p.at(1)) >= 0) {
+			break
+		}
+		if flow && strings.IndexByte(",[]{}", c) >= 0 {
+			break
+		}
+		if c == '#' && p.pos > start && (p.s[p.pos-1] == ' ' || p.s[p.pos-1] == '\t') {
+			break
+		}
+		p.pos++
+		if c != ' ' && c != '\t' {
+			end = p.pos
+		}
+	}
+	p.pos = end
+	p.skipSpace()
+	return string(p.s[start:end]), nil
+}
+
+// parseQuoted parses the single or double quoted scalar at pos.
+func (p *yamlParser) parseQuoted() (string, error) {
+	line := p.line
+	q := p.peek()
+	p.pos++
+	var b bytes.Buffer
+	for {
+		if p.eof() {
+			return "", &YAMLError{Line: line, Err: errors.New("unterminated quoted scalar")}
+		}
+		c := p.peek()
+		switch {
+		case c == q && q == '\'' && p.at(1) == '\'':
+			b.WriteByte('\'')
+			p.pos += 2
+		case c == q:
+			p.pos++
+			return b.String(), nil
+		case c == '\\' && q == '"':
+			if p.at(1) == '\n' || p.at(1) == '\r' {
+				// An escaped line break joins the lines.
+				p.pos++
+				p.skipLineBreak()
+				p.skipSpace()
+				continue
+			}
+			if err := p.parseEscape(&b); err != nil {
+				return "", err
+			}
+		case c == '\n' || c == '\r':
+			// Line breaks fold into a space, or into the empty lines
+			// that follow them.
+			trimmed := bytes.TrimRight(b.Bytes(), " \t")
+			b.Truncate(len(trimmed))
+			p.skipLineBreak()
+			breaks := 0
+			for {
+				p.skipSpace()
+				if c := p.peek(); c != '\n' && c != '\r' {
+					break
+				}
+				p.skipLineBreak()
+				breaks++
+			}
+			if breaks == 0 {
+				b.WriteByte(' ')
+			} else {
+				b.WriteString(strings.Repeat("\n", breaks))
+			}
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// skipLineBreak skips the line break at pos.
+func (p *yamlParser) skipLineBreak() {
+	if p.peek() == '\r' {
+		p.pos++
+	}
+	if p.peek() == '\n' {
+		p.advance()
+	}
+}
+
+var yamlEscapes = map[byte]string{
+	'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n", 'v': "\v",
+	'f': "\f", 'r': "\r", 'e': "\x1b", ' ': " ", '"': `"`, '/': "/", '\\': `\`,
+	'N': "\u0085", '_': "\u00a0", 'L': "\u2028", 'P': "\u2029",
+}
+
+// parseEscape parses the escape sequence at pos into b.
+func (p *yamlParser) parseEscape(b *bytes.Buffer) error {
+	c := p.at(1)
+	if s, ok := yamlEscapes[c]; ok {
+		b.WriteString(s)
+		p.pos += 2
+		return nil
+	}
+	digits := map[byte]int{'x': 2, 'u': 4, 'U': 8}[c]
+	if digits == 0 || p.pos+2+digits > len(p.s) {
+		return p.errorf("bad escape sequence %q", string(p.s[p.pos:p.pos+2]))
+	}
+	r, err := strconv.ParseUint(string(p.s[p.pos+2:p.pos+2+digits]), 16, 32)
+	if err != nil {
+		return p.errorf("bad escape sequence %q", string(p.s[p.pos:p.pos+2+digits]))
+	}
+	b.WriteRune(rune(r))
+	p.pos += 2 + digits
+	return nil
+}
+
+// parseFlow parses the flow collection at pos.
+func (p *yamlParser) parseFlow() (*yamlNode, error) {
+	if p.peek() == '[' {
+		n := &yamlNode{kind: yamlSequenceNode, line: p.line}
+		p.pos++
+		for {
+			if err := p.skipFlowBlank(); err != nil {
+				return nil, err
+			}
+			if p.peek() == ']' {
+				p.pos++
+				return n, nil
+			}
+			v, err := p.parseFlowNode()
+			if err != nil {
+				return nil, err
+			}
+			n.values = append(n.values, v)
+			if err := p.skipFlowBlank(); err != nil {
+				return nil, err
+			}
+			switch p.peek() {
+			case ',':
+				p.pos++
+			case ']':
+			default:
+				return nil, p.errorf("expected ',' or ']' in a flow sequence")
+			}
+		}
+	}
+
+	n := &yamlNode{kind: yamlMappingNode, line: p.line}
+	seen := make(map[string]bool)
+	p.pos++
+	for {
+		if err := p.skipFlowBlank(); err != nil {
+			return nil, err
+		}
+		if p.peek() == '}' {
+			p.pos++
+			return n, nil
+		}
+		if c := p.peek(); c == '[' || c == '{' {
+			return nil, p.errorf("complex keys are not supported")
+		}
+		key, err := p.parseScalar(true)
+		if err != nil {
+			return nil, err
+		}
+		if seen[key.value] {
+			return nil, &YAMLError{Line: key.line, Err: fmt.Errorf("duplicate key %q", key.value)}
+		}
+		seen[key.value] = true
+		if err := p.skipFlowBlank(); err != nil {
+			return nil, err
+		}
+		v := &yamlNode{kind: yamlScalarNode, line: key.line, plain: true}
+		if p.peek() == ':' {
+			p.pos++
+			if err := p.skipFlowBlank(); err != nil {
+				return nil, err
+			}
+			if c := p.peek(); c != ',' && c != '}' {
+				if v, err = p.parseFlowNode(); err != nil {
+					return nil, err
+				}
+				if err := p.skipFlowBlank(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		n.keys = append(n.keys, key)
+		n.values = append(n.values, v)
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return nil, p.errorf("expected ',' or '}' in a flow mapping")
+		}
+	}
+}
+
+// parseFlowNode parses the node at pos in a flow collection.
+func (p *yamlParser) parseFlowNode() (*yamlNode, error) {
+	if c := p.peek(); c == '[' || c == '{' {
+		return p.parseFlow()
+	}
+	n, err := p.parseScalar(true)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() == ':' {
+		return nil, p.errorf("mappings in flow sequences are not supported")
+	}
+	return n, nil
+}
+
+// skipFlowBlank skips the spaces, line breaks and comments at pos in a
+// flow collection.
+func (p *yamlParser) skipFlowBlank() error {
+	for {
+		p.skipComment()
+		switch p.peek() {
+		case '\n', '\r':
+			p.advance()
+		case 0:
+			if p.eof() {
+				return p.errorf("unterminated flow collection")
+			}
+			return nil
+		default:
+			return nil
+		}
+	}
+}
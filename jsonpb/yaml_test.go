@@ -0,0 +1,203 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	"github.com/golang/protobuf/ptypes"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	stpb "github.com/golang/protobuf/ptypes/struct"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+func TestMarshalYAML(t *testing.T) {
+	any, err := ptypes.MarshalAny(&pb.Simple{OString: proto.String("x")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &pb.KnownTypes{
+		An:  any,
+		Dur: &durpb.Duration{Seconds: 3},
+		St: &stpb.Struct{Fields: map[string]*stpb.Value{
+			"list":  {Kind: &stpb.Value_ListValue{ListValue: &stpb.ListValue{Values: []*stpb.Value{{Kind: &stpb.Value_StringValue{StringValue: "a: b"}}, {Kind: &stpb.Value_StructValue{StructValue: &stpb.Struct{Fields: map[string]*stpb.Value{"k": {Kind: &stpb.Value_NumberValue{NumberValue: 1}}}}}}}}}},
+			"empty": {Kind: &stpb.Value_StructValue{StructValue: &stpb.Struct{}}},
+			"true":  {Kind: &stpb.Value_StringValue{StringValue: "true"}},
+		}},
+		I64: &wpb.Int64Value{Value: -64},
+		Str: &wpb.StringValue{Value: "multi\nline"},
+	}
+	want := `an:
+  '@type': type.googleapis.com/jsonpb.Simple
+  oString: x
+dur: 3s
+st:
+  empty: {}
+  list:
+    - "a: b"
+    - k: 1
+  "true": "true"
+i64: "-64"
+str: "multi\nline"
+`
+	want = strings.Replace(want, "'@type'", `"@type"`, 1)
+	got, err := (&Marshaler{}).MarshalYAMLString(msg)
+	if err != nil {
+		t.Fatalf("MarshalYAMLString: %v", err)
+	}
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	back := new(pb.KnownTypes)
+	if err := UnmarshalYAMLString(got, back); err != nil {
+		t.Fatalf("UnmarshalYAMLString: %v", err)
+	}
+	if !proto.Equal(back, msg) {
+		t.Errorf("round trip: got %v, want %v", back, msg)
+	}
+
+	nested := &pb.Widget{RSimple: []*pb.Simple{{OInt32: proto.Int32(1), OBool: proto.Bool(true)}}, RColor: []pb.Widget_Color{pb.Widget_RED}}
+	got, err = (&Marshaler{EnumsAsInts: true}).MarshalYAMLString(nested)
+	if want := "rColor:\n  - 0\nrSimple:\n  - oBool: true\n    oInt32: 1\n"; err != nil || got != want {
+		t.Errorf("MarshalYAMLString(%v) = %q, %v; want %q", nested, got, err, want)
+	}
+	got, err = (&Marshaler{}).MarshalYAMLString(&durpb.Duration{Seconds: 1})
+	if want := "1s\n"; err != nil || got != want {
+		t.Errorf("MarshalYAMLString of a Duration = %q, %v; want %q", got, err, want)
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   string
+		want proto.Message
+	}{
+		{"block mapping", "# comment\nname: Frodo\nheight_in_cm: 120 # not tall\nresultCount: 0x10\ntrue_scotsman: True\n",
+			&proto3pb.Message{Name: "Frodo", HeightInCm: 120, ResultCount: 16, TrueScotsman: true}},
+		{"plain scalars in string fields", "name: 42\nstringMap:\n  a: true\n  b: 1.50\n  c: null\n",
+			&proto3pb.Message{Name: "42", StringMap: map[string]string{"a": "true", "b": "1.50", "c": ""}}},
+		{"number-like scalars in string fields", "name: 01234\nstringMap:\n  hex: 0x1F\n  frac: .5\n  plus: +1\n  inf: .inf\n  bool: True\nchildren:\n- name: 007\nterrain:\n  a: {bunny: 1.0}\n",
+			&proto3pb.Message{Name: "01234", StringMap: map[string]string{"hex": "0x1F", "frac": ".5", "plus": "+1", "inf": ".inf", "bool": "True"},
+				Children: []*proto3pb.Message{{Name: "007"}}, Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "1.0"}}}},
+		{"number-like scalars in proto2 string fields", "oString: 01234\n", &pb.Simple{OString: proto.String("01234")}},
+		{"number-like scalars in repeated string fields", "rString: [0o17, -0, 1_000]\n", &pb.Repeats{RString: []string{"0o17", "-0", "1_000"}}},
+		{"number-like scalars in oneof string fields", "home_address: 02139\n", &pb.MsgWithOneof{Union: &pb.MsgWithOneof_HomeAddress{HomeAddress: "02139"}}},
+		{"number-like scalars in string wrappers", "str: 1.10\nan:\n  \"@type\": type.googleapis.com/google.protobuf.StringValue\n  value: 0x10\n",
+			&pb.KnownTypes{Str: &wpb.StringValue{Value: "1.10"}, An: mustMarshalAny(&wpb.StringValue{Value: "0x10"})}},
+		{"number-like scalars in Any string fields", "an:\n  \"@type\": type.googleapis.com/jsonpb.Simple\n  oString: +5\n",
+			&pb.KnownTypes{An: mustMarshalAny(&pb.Simple{OString: proto.String("+5")})}},
+		{"sequences", "key: [1, 2]\nshortKey:\n- 3\n- 4\nrFunny:\n  - PUNS\n  - 2\n",
+			&proto3pb.Message{Key: []uint64{1, 2}, ShortKey: []int32{3, 4}, RFunny: []proto3pb.Message_Humour{proto3pb.Message_PUNS, proto3pb.Message_SLAPSTICK}}},
+		{"nested messages", "---\nchildren:\n  - name: a\n    children: [{name: b}]\n  - {name: 'c''s'}\nterrain:\n  \"x y\": {bunny: z, cute: true}\n...\n",
+			&proto3pb.Message{Children: []*proto3pb.Message{{Name: "a", Children: []*proto3pb.Message{{Name: "b"}}}, {Name: "c's"}},
+				Terrain: map[string]*proto3pb.Nested{"x y": {Bunny: "z", Cute: true}}}},
+		{"block scalars", "name: |\n  line 1\n    line 2\n\nstringMap:\n  folded: >-\n    a\n    b\n\n    c\n  plain: one\n    two\n",
+			&proto3pb.Message{Name: "line 1\n  line 2\n", StringMap: map[string]string{"folded": "a b\nc", "plain": "one two"}}},
+		{"quoted scalars", `name: "tab\there \u00e9"` + "\nstringMap: {'a': \"b\n  c\"}\n",
+			&proto3pb.Message{Name: "tab\there \u00e9", StringMap: map[string]string{"a": "b c"}}},
+		{"JSON", `{"name": "json", "score": "Infinity"}`, &proto3pb.Message{Name: "json", Score: float32(math.Inf(1))}},
+		{"empty document", "# nothing\n", &proto3pb.Message{}},
+		{"well-known types", "dur: 1.5s\nst: {a: [1, x, null]}\nbool: false\ni64: 9007199254740993\n",
+			&pb.KnownTypes{Dur: &durpb.Duration{Seconds: 1, Nanos: 5e8}, Bool: &wpb.BoolValue{},
+				I64: &wpb.Int64Value{Value: 9007199254740993},
+				St: &stpb.Struct{Fields: map[string]*stpb.Value{"a": {Kind: &stpb.Value_ListValue{ListValue: &stpb.ListValue{Values: []*stpb.Value{
+					{Kind: &stpb.Value_NumberValue{NumberValue: 1}}, {Kind: &stpb.Value_StringValue{StringValue: "x"}}, {Kind: &stpb.Value_NullValue{}}}}}}}}}},
+		{"Any", "an:\n  \"@type\": type.googleapis.com/jsonpb.Simple\n  oBool: true\n",
+			&pb.KnownTypes{An: mustMarshalAny(&pb.Simple{OBool: proto.Bool(true)})}},
+	}
+	for _, tt := range tests {
+		got := proto.Clone(tt.want)
+		got.Reset()
+		if err := UnmarshalYAMLString(tt.in, got); err != nil {
+			t.Errorf("%s: UnmarshalYAMLString: %v", tt.desc, err)
+			continue
+		}
+		if !proto.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.desc, got, tt.want)
+		}
+	}
+
+	real := new(pb.Real)
+	if err := UnmarshalYAMLString("value: 1\n\"[jsonpb.name]\": 0x1F\n", real); err != nil {
+		t.Fatalf("UnmarshalYAMLString with an extension: %v", err)
+	}
+	if name, err := proto.GetExtension(real, pb.E_Name); err != nil || *name.(*string) != "0x1F" {
+		t.Errorf("extension of %v = %v, %v; want 0x1F", real, name, err)
+	}
+}
+
+func mustMarshalAny(m proto.Message) *anypb.Any {
+	any, err := ptypes.MarshalAny(m)
+	if err != nil {
+		panic(err)
+	}
+	return any
+}
+
+func TestUnmarshalYAMLErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   string
+		line int
+	}{
+		{"duplicate key", "name: a\nname: b\n", 2},
+		{"bad indentation", "name: a\n  score: 1\n", 2},
+		{"tab indentation", "children:\n\t- name: a\n", 2},
+		{"unterminated quote", "name: a\nbunny: \"b\n\n", 2},
+		{"alias", "name: *a\n", 1},
+		{"unknown field", "name: a\n\nchildren:\n  - name: b\n    bogus: 1\n", 5},
+		{"bad value", "children:\n- {}\n- score: high\n", 3},
+		{"bad nested value", "terrain:\n  k:\n    cute: maybe\n", 3},
+		{"bad enum", "rFunny: [PUNS,\n  BAD]\n", 2},
+		{"bad Struct value", "# x\nst:\n  a:\n    b: [1, {c: d, c: e}]\n", 4},
+	}
+	for _, tt := range tests {
+		var m proto.Message = new(proto3pb.Message)
+		if strings.HasPrefix(tt.in, "# x") {
+			m = new(pb.KnownTypes)
+		}
+		err := UnmarshalYAMLString(tt.in, m)
+		ye, ok := err.(*YAMLError)
+		if !ok || ye.Line != tt.line {
+			t.Errorf("%s: got error %v, want YAMLError on line %d", tt.desc, err, tt.line)
+		}
+	}
+}
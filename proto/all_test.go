@@ -2421,6 +2421,16 @@ func BenchmarkBufferUnmarshalBytes(b *testing.B) {
 	benchmarkBufferUnmarshal(b, bytesMsg())
 }
 
+func BenchmarkUnmarshalAlias(b *testing.B) {
+	opts := UnmarshalOptions{AliasBuffer: true}
+	benchmarkUnmarshal(b, testMsg(), opts.Unmarshal)
+}
+
+func BenchmarkUnmarshalBytesAlias(b *testing.B) {
+	opts := UnmarshalOptions{AliasBuffer: true}
+	benchmarkUnmarshal(b, bytesMsg(), opts.Unmarshal)
+}
+
 func BenchmarkUnmarshalUnrecognizedFields(b *testing.B) {
 	b.StopTimer()
 	pb := initGoTestField()
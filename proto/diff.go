@@ -0,0 +1,589 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Protocol buffer differences.
+
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DiffKind says how a field differs between two messages.
+type DiffKind int
+
+const (
+	DiffChanged DiffKind = iota // set in both messages, to different values
+	DiffAdded                   // set only in the second message
+	DiffRemoved                 // set only in the first message
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case DiffChanged:
+		return "changed"
+	case DiffAdded:
+		return "added"
+	case DiffRemoved:
+		return "removed"
+	}
+	return "DiffKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Difference describes a single difference between two messages.
+type Difference struct {
+	// Path locates the difference, in terms of the original proto names:
+	//	- fields are joined with dots, e.g. "inner.host";
+	//	- elements of repeated fields are indexed, e.g. "children[2]";
+	//	- map entries are keyed, e.g. `terrain["alpine"]`;
+	//	- extensions are bracketed full names, e.g. "[test_proto.ext]";
+	//	- unknown fields are named by field number, e.g. "inner.105".
+	// The path of the messages themselves is empty.
+	Path string
+
+	Kind DiffKind
+
+	// Old and New hold the values in the first and second message.
+	// Old is nil for DiffAdded and New is nil for DiffRemoved.
+	// Scalar fields hold the value rather than a pointer to it,
+	// and unknown fields hold their raw encoding.
+	Old, New interface{}
+}
+
+// String returns the difference in the unified form used by FormatDiff.
+func (d Difference) String() string {
+	var b bytes.Buffer
+	if d.Kind != DiffAdded {
+		writeDiffLine(&b, '-', d.Path, d.Old)
+	}
+	if d.Kind != DiffRemoved {
+		writeDiffLine(&b, '+', d.Path, d.New)
+	}
+	return b.String()
+}
+
+func writeDiffLine(b *bytes.Buffer, sign byte, path string, v interface{}) {
+	b.WriteByte(sign)
+	if path != "" {
+		b.WriteString(path)
+		b.WriteString(": ")
+	}
+	b.WriteString(formatDiffValue(v))
+	b.WriteByte('\n')
+}
+
+// formatDiffValue renders a value reported in a Difference.
+func formatDiffValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "<nil>"
+	case Message:
+		return "{" + strings.TrimSpace(CompactTextString(v)) + "}"
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return fmt.Sprintf("%q", v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// FormatDiff renders differences as text, with the old value of each
+// field on a line starting with '-' and the new value on a line starting
+// with '+', in the manner of a unified diff. For example:
+//
+//	-name: "Kirk"
+//	+name: "Picard"
+//	+children[1]: {name:"Wesley"}
+func FormatDiff(diffs []Difference) string {
+	var b bytes.Buffer
+	for _, d := range diffs {
+		b.WriteString(d.String())
+	}
+	return b.String()
+}
+
+// Diff reports the differences between protocol buffers a and b,
+// which must both be pointers to protocol buffer structs, in field order.
+// It compares the messages the same way Equal does, so it returns no
+// differences exactly when Equal(a, b) is true.
+// Messages of different types produce a single DiffChanged with an empty path.
+func Diff(a, b Message) []Difference {
+	return new(differ).diff(a, b)
+}
+
+// differ walks two messages, collecting their differences.
+type differ struct {
+	opts   *EqualOptions   // nil for plain Diff
+	ignore map[string]bool // opts.IgnorePaths as a set
+	first  bool            // stop at the first difference
+	diffs  []Difference
+}
+
+func newDiffer(opts *EqualOptions, first bool) *differ {
+	d := &differ{opts: opts, first: first}
+	if opts != nil && len(opts.IgnorePaths) > 0 {
+		d.ignore = make(map[string]bool, len(opts.IgnorePaths))
+		for _, p := range opts.IgnorePaths {
+			d.ignore[p] = true
+		}
+	}
+	return d
+}
+
+func (d *differ) diff(a, b Message) []Difference {
+	if a == nil || b == nil {
+		switch {
+		case a != nil:
+			d.report(DiffRemoved, "", a, nil)
+		case b != nil:
+			d.report(DiffAdded, "", nil, b)
+		}
+		return d.diffs
+	}
+	v1, v2 := reflect.ValueOf(a), reflect.ValueOf(b)
+	if v1.Type() != v2.Type() {
+		d.report(DiffChanged, "", a, b)
+		return d.diffs
+	}
+	if v1.Kind() == reflect.Ptr {
+		if n1, n2 := v1.IsNil(), v2.IsNil(); n1 || n2 {
+			if n1 != n2 {
+				d.report(DiffChanged, "", a, b)
+			}
+			return d.diffs
+		}
+		v1, v2 = v1.Elem(), v2.Elem()
+	}
+	if v1.Kind() != reflect.Struct {
+		d.report(DiffChanged, "", a, b)
+		return d.diffs
+	}
+	d.diffStruct("", "", v1, v2)
+	return d.diffs
+}
+
+func (d *differ) report(kind DiffKind, path string, old, new interface{}) {
+	d.diffs = append(d.diffs, Difference{Path: path, Kind: kind, Old: old, New: new})
+}
+
+// done reports whether the walk can stop.
+func (d *differ) done() bool {
+	return d.first && len(d.diffs) > 0
+}
+
+// ignored reports whether the field at fpath is excluded from comparison.
+func (d *differ) ignored(fpath string) bool {
+	return d.ignore[fpath]
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// Each of the walking functions takes two paths: path, which locates
+// the values for reporting, and fpath, which is the same path without
+// indexes and map keys, for matching EqualOptions.IgnorePaths.
+
+// v1 and v2 are known to have the same type.
+func (d *differ) diffStruct(path, fpath string, v1, v2 reflect.Value) {
+	if d.opts != nil && d.opts.UnpackAny && v1.CanAddr() && isAny(v1) && d.diffAny(path, fpath, v1, v2) {
+		return
+	}
+	sprop := GetProperties(v1.Type())
+	for i := 0; i < v1.NumField() && !d.done(); i++ {
+		f := v1.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		name := sprop.Prop[i].OrigName
+		if d.ignored(joinPath(fpath, name)) {
+			continue
+		}
+		f1, f2 := v1.Field(i), v2.Field(i)
+		if f.Type.Kind() == reflect.Interface {
+			d.diffOneof(path, fpath, f1, f2)
+			continue
+		}
+		d.diffField(joinPath(path, name), joinPath(fpath, name), f1, f2, sprop.Prop[i])
+	}
+
+	if em1 := v1.FieldByName("XXX_InternalExtensions"); em1.IsValid() {
+		em2 := v2.FieldByName("XXX_InternalExtensions")
+		x1, x2 := em1.Interface().(XXX_InternalExtensions), em2.Interface().(XXX_InternalExtensions)
+		m1, _ := x1.extensionsRead()
+		m2, _ := x2.extensionsRead()
+		d.diffExtensions(path, fpath, v1.Type(), m1, m2)
+	}
+
+	if em1 := v1.FieldByName("XXX_extensions"); em1.IsValid() {
+		em2 := v2.FieldByName("XXX_extensions")
+		d.diffExtensions(path, fpath, v1.Type(), em1.Interface().(map[int32]Extension), em2.Interface().(map[int32]Extension))
+	}
+
+	if d.opts != nil && d.opts.IgnoreUnknown {
+		return
+	}
+	if uf := v1.FieldByName("XXX_unrecognized"); uf.IsValid() && !d.done() {
+		d.diffUnknown(path, uf.Bytes(), v2.FieldByName("XXX_unrecognized").Bytes())
+	}
+}
+
+// diffOneof compares two oneof fields, which hold pointers to wrapper
+// structs whose single field is the selected case.
+func (d *differ) diffOneof(path, fpath string, v1, v2 reflect.Value) {
+	var c1, c2 reflect.Value
+	var p1, p2 *Properties
+	if !v1.IsNil() {
+		c1, p1 = oneofCase(v1.Elem())
+		if d.ignored(joinPath(fpath, p1.OrigName)) {
+			c1, p1 = reflect.Value{}, nil
+		}
+	}
+	if !v2.IsNil() {
+		c2, p2 = oneofCase(v2.Elem())
+		if d.ignored(joinPath(fpath, p2.OrigName)) {
+			c2, p2 = reflect.Value{}, nil
+		}
+	}
+	switch {
+	case p1 == nil && p2 == nil:
+	case p1 == nil:
+		d.report(DiffAdded, joinPath(path, p2.OrigName), nil, diffValue(c2))
+	case p2 == nil:
+		d.report(DiffRemoved, joinPath(path, p1.OrigName), diffValue(c1), nil)
+	case v1.Elem().Type() != v2.Elem().Type():
+		d.report(DiffRemoved, joinPath(path, p1.OrigName), diffValue(c1), nil)
+		d.report(DiffAdded, joinPath(path, p2.OrigName), nil, diffValue(c2))
+	default:
+		d.diffElem(joinPath(path, p1.OrigName), joinPath(fpath, p1.OrigName), c1, c2, p1)
+	}
+}
+
+// oneofCase returns the value and properties of the field
+// in the oneof wrapper w (a pointer to a struct).
+func oneofCase(w reflect.Value) (reflect.Value, *Properties) {
+	p := new(Properties)
+	p.Parse(w.Elem().Type().Field(0).Tag.Get("protobuf"))
+	return w.Elem().Field(0), p
+}
+
+// diffValue returns the value to report for v, a field or element.
+// Pointers to scalars are dereferenced.
+func diffValue(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() != reflect.Struct {
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+// diffField compares field values f1 and f2,
+// distinguishing set fields from unset ones.
+// f1 and f2 are known to have the same type.
+// prop may be nil.
+func (d *differ) diffField(path, fpath string, f1, f2 reflect.Value, prop *Properties) {
+	switch f1.Kind() {
+	case reflect.Ptr:
+		n1, n2 := f1.IsNil(), f2.IsNil()
+		switch {
+		case n1 && n2:
+		case n1:
+			d.report(DiffAdded, path, nil, diffValue(f2))
+		case n2:
+			d.report(DiffRemoved, path, diffValue(f1), nil)
+		default:
+			d.diffElem(path, fpath, f1, f2, prop)
+		}
+	case reflect.Slice:
+		if f1.Type().Elem().Kind() == reflect.Uint8 {
+			if equalAny(f1, f2, prop) {
+				return
+			}
+			// A proto3 bytes field is unset when empty, other bytes fields when nil.
+			proto3 := prop != nil && prop.proto3
+			u1 := f1.IsNil() || proto3 && f1.Len() == 0
+			u2 := f2.IsNil() || proto3 && f2.Len() == 0
+			switch {
+			case u1 && !u2:
+				d.report(DiffAdded, path, nil, f2.Interface())
+			case u2 && !u1:
+				d.report(DiffRemoved, path, f1.Interface(), nil)
+			default:
+				d.report(DiffChanged, path, f1.Interface(), f2.Interface())
+			}
+			return
+		}
+		if d.opts != nil && d.opts.RepeatedAsSets {
+			d.diffSet(path, fpath, f1, f2, prop)
+			return
+		}
+		n := f1.Len()
+		if f2.Len() > n {
+			n = f2.Len()
+		}
+		for i := 0; i < n && !d.done(); i++ {
+			ipath := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= f1.Len():
+				d.report(DiffAdded, ipath, nil, diffValue(f2.Index(i)))
+			case i >= f2.Len():
+				d.report(DiffRemoved, ipath, diffValue(f1.Index(i)), nil)
+			default:
+				d.diffElem(ipath, fpath, f1.Index(i), f2.Index(i), prop)
+			}
+		}
+	case reflect.Map:
+		keys := f1.MapKeys()
+		for _, k := range f2.MapKeys() {
+			if !f1.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		sort.Sort(mapKeys(keys))
+		for _, k := range keys {
+			if d.done() {
+				break
+			}
+			kpath := path + "[" + formatDiffValue(k.Interface()) + "]"
+			e1, e2 := f1.MapIndex(k), f2.MapIndex(k)
+			switch {
+			case !e1.IsValid():
+				d.report(DiffAdded, kpath, nil, diffValue(e2))
+			case !e2.IsValid():
+				d.report(DiffRemoved, kpath, diffValue(e1), nil)
+			default:
+				d.diffElem(kpath, fpath, e1, e2, nil)
+			}
+		}
+	default:
+		// A proto3 scalar, which is unset when zero.
+		if d.equalScalar(f1, f2, prop) {
+			return
+		}
+		switch {
+		case isProto3Zero(f1):
+			d.report(DiffAdded, path, nil, f2.Interface())
+		case isProto3Zero(f2):
+			d.report(DiffRemoved, path, f1.Interface(), nil)
+		default:
+			d.report(DiffChanged, path, f1.Interface(), f2.Interface())
+		}
+	}
+}
+
+// diffElem compares two values that are both present:
+// set fields, or elements of repeated fields or maps.
+// Messages are compared field by field.
+func (d *differ) diffElem(path, fpath string, v1, v2 reflect.Value, prop *Properties) {
+	switch {
+	case v1.Kind() == reflect.Ptr && !v1.IsNil() && !v2.IsNil():
+		if v1.Elem().Kind() == reflect.Struct {
+			d.diffStruct(path, fpath, v1.Elem(), v2.Elem())
+			return
+		}
+		if !d.equalScalar(v1.Elem(), v2.Elem(), prop) {
+			d.report(DiffChanged, path, diffValue(v1), diffValue(v2))
+		}
+		return
+	case v1.Kind() == reflect.Slice && v1.Type().Elem().Kind() != reflect.Uint8:
+		// A repeated extension.
+		d.diffField(path, fpath, v1, v2, prop)
+		return
+	}
+	if !d.equalScalar(v1, v2, prop) {
+		d.report(DiffChanged, path, diffValue(v1), diffValue(v2))
+	}
+}
+
+// equalScalar compares two values that are not messages,
+// applying the float comparison options.
+func (d *differ) equalScalar(v1, v2 reflect.Value, prop *Properties) bool {
+	if d.opts != nil && (v1.Kind() == reflect.Float32 || v1.Kind() == reflect.Float64) {
+		return d.opts.equalFloat(v1.Float(), v2.Float())
+	}
+	return equalAny(v1, v2, prop)
+}
+
+// diffExtensions compares extension maps the way equalExtMap does.
+// base is the struct type that the extensions are based on.
+func (d *differ) diffExtensions(path, fpath string, base reflect.Type, em1, em2 map[int32]Extension) {
+	var nums []int
+	for n := range em1 {
+		nums = append(nums, int(n))
+	}
+	for n := range em2 {
+		if _, ok := em1[n]; !ok {
+			nums = append(nums, int(n))
+		}
+	}
+	sort.Ints(nums)
+
+	for _, n := range nums {
+		if d.done() {
+			return
+		}
+		extNum := int32(n)
+		desc := registeredExtension(base, extNum)
+		name := "[" + strconv.Itoa(n) + "]"
+		if desc != nil {
+			name = "[" + desc.Name + "]"
+		}
+		if d.ignored(joinPath(fpath, name)) {
+			continue
+		}
+		epath, efpath := joinPath(path, name), joinPath(fpath, name)
+
+		e1, ok1 := em1[extNum]
+		e2, ok2 := em2[extNum]
+		switch {
+		case !ok1:
+			d.report(DiffAdded, epath, nil, extensionDiffValue(e2, desc))
+			continue
+		case !ok2:
+			d.report(DiffRemoved, epath, extensionDiffValue(e1, desc), nil)
+			continue
+		}
+
+		m1, m2 := e1.value, e2.value
+		if m1 == nil && m2 == nil && bytes.Equal(e1.enc, e2.enc) {
+			continue
+		}
+		if desc == nil {
+			// Same as equalExtMap: without a descriptor, different
+			// encodings can only be reported as different.
+			d.report(DiffChanged, epath, extensionDiffValue(e1, desc), extensionDiffValue(e2, desc))
+			continue
+		}
+		var err error
+		if m1 == nil {
+			m1, err = decodeExtension(e1.enc, desc)
+		}
+		if m2 == nil && err == nil {
+			m2, err = decodeExtension(e2.enc, desc)
+		}
+		if err != nil {
+			d.report(DiffChanged, epath, e1.enc, e2.enc)
+			continue
+		}
+		d.diffElem(epath, efpath, reflect.ValueOf(m1), reflect.ValueOf(m2), nil)
+	}
+}
+
+// extensionDiffValue returns the value to report for extension e,
+// decoding it if necessary and possible, or else its raw encoding.
+func extensionDiffValue(e Extension, desc *ExtensionDesc) interface{} {
+	v := e.value
+	if v == nil && desc != nil {
+		var err error
+		if v, err = decodeExtension(e.enc, desc); err != nil {
+			return e.enc
+		}
+	}
+	if v == nil {
+		return e.enc
+	}
+	return diffValue(reflect.ValueOf(v))
+}
+
+// diffUnknown compares the unknown fields of two messages.
+// Fields are grouped by field number, so that the reported
+// differences are not larger than necessary. Like Equal, it is
+// sensitive to the order of the fields: if the groups match but
+// are interleaved differently, the fields are compared as a whole.
+func (d *differ) diffUnknown(path string, u1, u2 []byte) {
+	if bytes.Equal(u1, u2) {
+		return
+	}
+	g1, ok1 := groupUnknown(u1)
+	g2, ok2 := groupUnknown(u2)
+	if !ok1 || !ok2 {
+		// Malformed unknown fields; compare them as a whole.
+		d.report(DiffChanged, joinPath(path, "XXX_unrecognized"), u1, u2)
+		return
+	}
+	n := len(d.diffs)
+	var tags []int
+	for t := range g1 {
+		tags = append(tags, int(t))
+	}
+	for t := range g2 {
+		if _, ok := g1[t]; !ok {
+			tags = append(tags, int(t))
+		}
+	}
+	sort.Ints(tags)
+	for _, t := range tags {
+		b1, ok1 := g1[uint64(t)]
+		b2, ok2 := g2[uint64(t)]
+		tpath := joinPath(path, strconv.Itoa(t))
+		switch {
+		case !ok1:
+			d.report(DiffAdded, tpath, nil, b2)
+		case !ok2:
+			d.report(DiffRemoved, tpath, b1, nil)
+		case !bytes.Equal(b1, b2):
+			d.report(DiffChanged, tpath, b1, b2)
+		}
+	}
+	if len(d.diffs) == n {
+		// Same fields in a different order.
+		d.report(DiffChanged, joinPath(path, "XXX_unrecognized"), u1, u2)
+	}
+}
+
+// groupUnknown splits encoded fields by field number, keeping the
+// complete encoding of each field, tag included.
+// It reports false if b is not a valid encoding.
+func groupUnknown(b []byte) (map[uint64][]byte, bool) {
+	m := map[uint64][]byte{}
+	for len(b) > 0 {
+		b0 := b
+		x, n := decodeVarint(b)
+		if n == 0 {
+			return nil, false
+		}
+		var err error
+		if b, err = skipField(b[n:], int(x&7)); err != nil {
+			return nil, false
+		}
+		m[x>>3] = append(m[x>>3], b0[:len(b0)-len(b)]...)
+	}
+	return m, true
+}
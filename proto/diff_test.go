@@ -0,0 +1,175 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+)
+
+func TestDiff(t *testing.T) {
+	withExt := func(m *pb.MyMessage, desc *ExtensionDesc, v interface{}) *pb.MyMessage {
+		if err := SetExtension(m, desc, v); err != nil {
+			t.Fatalf("SetExtension: %v", err)
+		}
+		return m
+	}
+
+	tests := []struct {
+		desc string
+		a, b Message
+		want string
+	}{
+		{
+			"equal",
+			&pb.MyMessage{Count: Int32(1), Pet: []string{"a"}},
+			&pb.MyMessage{Count: Int32(1), Pet: []string{"a"}},
+			"",
+		},
+		{
+			"scalar fields",
+			&pb.MyMessage{Count: Int32(1), Name: String("Kirk")},
+			&pb.MyMessage{Count: Int32(2), Quote: String("Engage")},
+			"-count: 1\n+count: 2\n-name: \"Kirk\"\n+quote: \"Engage\"\n",
+		},
+		{
+			"nested messages",
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(1)}, WeMustGoDeeper: &pb.RequiredInnerMessage{}},
+			"-inner.host: \"a\"\n+inner.host: \"b\"\n+we_must_go_deeper: {}\n",
+		},
+		{
+			"repeated fields",
+			&pb.MyMessage{Pet: []string{"cat", "dog"}, RepInner: []*pb.InnerMessage{{Host: String("x")}}},
+			&pb.MyMessage{Pet: []string{"cat"}, RepInner: []*pb.InnerMessage{{Host: String("y")}, {Host: String("z")}}},
+			"-pet[1]: \"dog\"\n-rep_inner[0].host: \"x\"\n+rep_inner[0].host: \"y\"\n+rep_inner[1]: {host:\"z\"}\n",
+		},
+		{
+			"enums and groups",
+			&pb.MyMessage{Bikeshed: pb.MyMessage_RED.Enum(), Somegroup: &pb.MyMessage_SomeGroup{GroupField: Int32(1)}},
+			&pb.MyMessage{Bikeshed: pb.MyMessage_BLUE.Enum(), Somegroup: &pb.MyMessage_SomeGroup{GroupField: Int32(2)}},
+			"-bikeshed: RED\n+bikeshed: BLUE\n-SomeGroup.group_field: 1\n+SomeGroup.group_field: 2\n",
+		},
+		{
+			"oneof same case",
+			&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+			&pb.Communique{Union: &pb.Communique_Number{Number: 2}},
+			"-number: 1\n+number: 2\n",
+		},
+		{
+			"oneof different case",
+			&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+			&pb.Communique{Union: &pb.Communique_Name{Name: "x"}},
+			"-number: 1\n+name: \"x\"\n",
+		},
+		{
+			"oneof unset",
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("s")}}},
+			&pb.Communique{},
+			"-msg: {string_field:\"s\"}\n",
+		},
+		{
+			"proto3 scalars and maps",
+			&proto3pb.Message{Name: "a", HeightInCm: 3, StringMap: map[string]string{"k": "v", "x": "y"}},
+			&proto3pb.Message{Data: []byte("d"), HeightInCm: 4, StringMap: map[string]string{"k": "w", "z": "y"}},
+			"-name: \"a\"\n-height_in_cm: 3\n+height_in_cm: 4\n+data: \"d\"\n" +
+				"-string_map[\"k\"]: \"v\"\n+string_map[\"k\"]: \"w\"\n-string_map[\"x\"]: \"y\"\n+string_map[\"z\"]: \"y\"\n",
+		},
+		{
+			"map of messages",
+			&proto3pb.Message{Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "x"}}},
+			&proto3pb.Message{Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "y", Cute: true}}},
+			"-terrain[\"a\"].bunny: \"x\"\n+terrain[\"a\"].bunny: \"y\"\n+terrain[\"a\"].cute: true\n",
+		},
+		{
+			"extensions",
+			withExt(withExt(&pb.MyMessage{}, pb.E_Ext_More, &pb.Ext{Data: String("Kirk")}), pb.E_Greeting, []string{"hi"}),
+			withExt(&pb.MyMessage{}, pb.E_Ext_More, &pb.Ext{Data: String("Picard")}),
+			"-[test_proto.Ext.more].data: \"Kirk\"\n+[test_proto.Ext.more].data: \"Picard\"\n-[test_proto.greeting]: [hi]\n",
+		},
+		{
+			"unknown fields",
+			&pb.MyMessage{XXX_unrecognized: []byte{0x98, 0x06, 0x01, 0xa0, 0x06, 0x02}},
+			&pb.MyMessage{XXX_unrecognized: []byte{0xa0, 0x06, 0x03}},
+			"-99: \"\\x98\\x06\\x01\"\n-100: \"\\xa0\\x06\\x02\"\n+100: \"\\xa0\\x06\\x03\"\n",
+		},
+		{
+			"unknown fields reordered",
+			&pb.MyMessage{XXX_unrecognized: []byte{0x98, 0x06, 0x01, 0xa0, 0x06, 0x02}},
+			&pb.MyMessage{XXX_unrecognized: []byte{0xa0, 0x06, 0x02, 0x98, 0x06, 0x01}},
+			"-XXX_unrecognized: \"\\x98\\x06\\x01\\xa0\\x06\\x02\"\n+XXX_unrecognized: \"\\xa0\\x06\\x02\\x98\\x06\\x01\"\n",
+		},
+		{
+			"different types",
+			&pb.MyMessage{Count: Int32(1)},
+			&pb.InnerMessage{Host: String("h")},
+			"-{count:1}\n+{host:\"h\"}\n",
+		},
+	}
+	for _, tt := range tests {
+		if got := FormatDiff(Diff(tt.a, tt.b)); got != tt.want {
+			t.Errorf("%s: FormatDiff(Diff(a, b)):\ngot:\n%s\nwant:\n%s", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestDiffKinds(t *testing.T) {
+	a := &pb.MyMessage{Count: Int32(1), Name: String("a")}
+	b := &pb.MyMessage{Count: Int32(2), Quote: String("q")}
+	want := []Difference{
+		{Path: "count", Kind: DiffChanged, Old: int32(1), New: int32(2)},
+		{Path: "name", Kind: DiffRemoved, Old: "a"},
+		{Path: "quote", Kind: DiffAdded, New: "q"},
+	}
+	got := Diff(a, b)
+	if len(got) != len(want) {
+		t.Fatalf("Diff: got %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("Diff[%d]: got %#v, want %#v", i, got[i], want[i])
+		}
+	}
+}
+
+// Diff must agree with Equal.
+func TestDiffMatchesEqual(t *testing.T) {
+	for _, tc := range EqualTests {
+		diffs := Diff(tc.a, tc.b)
+		if got := len(diffs) == 0; got != tc.exp {
+			t.Errorf("%v: Diff(%v, %v) reported %d differences, Equal = %v:\n%s", tc.desc, tc.a, tc.b, len(diffs), tc.exp, FormatDiff(diffs))
+		}
+	}
+}
@@ -83,3 +83,80 @@ func BenchmarkEmpy(b *testing.B) {
 		blackhole = raw
 	}
 }
+
+func TestMarshalAppend(t *testing.T) {
+	m := &tpb.Message{Name: "append", Key: []uint64{1, 2, 3}, Nested: &tpb.Nested{Bunny: "b"}}
+	want, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prefix := []byte("hdr")
+	b, err := proto.MarshalAppend(append([]byte(nil), prefix...), m)
+	if err != nil {
+		t.Fatalf("MarshalAppend: %v", err)
+	}
+	if got := string(b); got != string(prefix)+string(want) {
+		t.Errorf("MarshalAppend: got %q, want %q", got, string(prefix)+string(want))
+	}
+
+	siz := proto.Size(m)
+	b = proto.EncodeVarint(uint64(siz))
+	b, err = proto.MarshalAppendSize(b, m, siz)
+	if err != nil {
+		t.Fatalf("MarshalAppendSize: %v", err)
+	}
+	got := new(tpb.Message)
+	if err := proto.NewBuffer(b).DecodeMessage(got); err != nil {
+		t.Fatalf("DecodeMessage: %v", err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("DecodeMessage: got %v, want %v", got, m)
+	}
+
+	if _, err := proto.MarshalAppend(nil, nil); err != proto.ErrNil {
+		t.Errorf("MarshalAppend(nil): got %v, want ErrNil", err)
+	}
+}
+
+func TestMarshalAppendSizeGrowsOnce(t *testing.T) {
+	m := &tpb.Message{Name: "grow", Data: make([]byte, 100)}
+	siz := proto.Size(m)
+	prefix := []byte("hdr")
+	b, err := proto.MarshalAppendSize(prefix, m, siz)
+	if err != nil {
+		t.Fatalf("MarshalAppendSize: %v", err)
+	}
+	if len(b) != len(prefix)+siz || cap(b) != len(b) {
+		t.Errorf("MarshalAppendSize: got len %d, cap %d, want both %d", len(b), cap(b), len(prefix)+siz)
+	}
+}
+
+func TestMarshalAppendReusesBuffer(t *testing.T) {
+	m := &tpb.Message{Name: "reuse", Data: make([]byte, 100)}
+	buf := make([]byte, 0, 1024)
+	allocs := testing.AllocsPerRun(100, func() {
+		var err error
+		buf, err = proto.MarshalAppend(buf[:0], m)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("MarshalAppend into a large enough buffer: got %v allocs, want 0", allocs)
+	}
+}
+
+// BenchmarkMarshalAppend measures marshaling into a reused buffer.
+func BenchmarkMarshalAppend(b *testing.B) {
+	m := &tpb.Message{Name: "bench", Key: []uint64{1, 2, 3}, Data: make([]byte, 1<<10)}
+	buf := make([]byte, 0, 2<<10)
+	for i := 0; i < b.N; i++ {
+		var err error
+		buf, err = proto.MarshalAppend(buf[:0], m)
+		if err != nil {
+			b.Error("wrong encode", err)
+		}
+	}
+	blackhole = buf
+}
@@ -267,10 +267,7 @@ func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
 
 		// At least one is encoded. To do a semantically correct comparison
 		// we need to unmarshal them first.
-		var desc *ExtensionDesc
-		if m := extensionMaps[base]; m != nil {
-			desc = m[extNum]
-		}
+		desc := registeredExtension(base, extNum)
 		if desc == nil {
 			// If both have only encoded form and the bytes are the same,
 			// it is handled above. We get here when the bytes are different.
@@ -0,0 +1,182 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Configurable comparison of messages.
+ */
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EqualOptions configures how messages are compared.
+// The zero value compares messages the same way as Equal.
+type EqualOptions struct {
+	// IgnorePaths lists fields that are not compared, in the path
+	// syntax of Difference, but without indexes or map keys:
+	// "inner.host" ignores the host field of the inner message, and
+	// "rep_inner.host" the host field of every element of rep_inner.
+	// A oneof can be ignored by the name of either the oneof or a case.
+	IgnorePaths []string
+
+	// IgnoreUnknown skips unknown fields in the comparison.
+	IgnoreUnknown bool
+
+	// RepeatedAsSets compares repeated fields without regard to the order
+	// of their elements. Duplicates are significant: [1, 1, 2] and [1, 2]
+	// are not equal.
+	RepeatedAsSets bool
+
+	// FloatEpsilon is the largest absolute difference between two
+	// floating-point values that are considered equal.
+	FloatEpsilon float64
+
+	// EquateNaN makes NaN equal to NaN.
+	EquateNaN bool
+
+	// UnpackAny compares google.protobuf.Any messages by their contents,
+	// decoded using the registered message types, rather than their
+	// encoded bytes, which may vary with field order or unknown fields.
+	// Messages of an unregistered type are compared by bytes.
+	// In paths, the contents of an Any are named by their type URL, as in
+	// "anything.[type.googleapis.com/pkg.Message].name".
+	UnpackAny bool
+}
+
+// Equal reports whether protocol buffers a and b are equal under the options.
+// It is like the top-level Equal function when the options are zero.
+func (o *EqualOptions) Equal(a, b Message) bool {
+	return len(newDiffer(o, true).diff(a, b)) == 0
+}
+
+// Diff is like the top-level Diff function, but applies the options.
+// It returns no differences exactly when o.Equal(a, b) is true.
+func (o *EqualOptions) Diff(a, b Message) []Difference {
+	return newDiffer(o, false).diff(a, b)
+}
+
+func (o *EqualOptions) equalFloat(x, y float64) bool {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return o.EquateNaN && math.IsNaN(x) && math.IsNaN(y)
+	}
+	return x == y || math.Abs(x-y) <= o.FloatEpsilon
+}
+
+// diffSet compares repeated fields f1 and f2 as multisets.
+// Each element of f1 is matched with the first equal unmatched element
+// of f2; the elements left over are reported as removed or added.
+func (d *differ) diffSet(path, fpath string, f1, f2 reflect.Value, prop *Properties) {
+	matched := make([]bool, f2.Len())
+	var removed []int
+	for i := 0; i < f1.Len(); i++ {
+		found := false
+		for j := 0; j < f2.Len(); j++ {
+			if !matched[j] && d.equalElem(fpath, f1.Index(i), f2.Index(j), prop) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, i)
+			if d.first {
+				break
+			}
+		}
+	}
+	for _, i := range removed {
+		d.report(DiffRemoved, path+"["+strconv.Itoa(i)+"]", diffValue(f1.Index(i)), nil)
+	}
+	for j, m := range matched {
+		if d.done() {
+			return
+		}
+		if !m {
+			d.report(DiffAdded, path+"["+strconv.Itoa(j)+"]", nil, diffValue(f2.Index(j)))
+		}
+	}
+}
+
+// equalElem reports whether the elements v1 and v2 are equal under d's options.
+func (d *differ) equalElem(fpath string, v1, v2 reflect.Value, prop *Properties) bool {
+	if v1.Kind() == reflect.Ptr && (v1.IsNil() || v2.IsNil()) {
+		return v1.IsNil() == v2.IsNil()
+	}
+	sub := &differ{opts: d.opts, ignore: d.ignore, first: true}
+	sub.diffElem("", fpath, v1, v2, prop)
+	return len(sub.diffs) == 0
+}
+
+// diffAny compares the contents of two google.protobuf.Any messages
+// of the same type. It reports false if the contents can't be decoded,
+// in which case the messages should be compared as usual.
+func (d *differ) diffAny(path, fpath string, v1, v2 reflect.Value) bool {
+	m1, url := unpackAny(v1)
+	if !m1.IsValid() {
+		return false
+	}
+	m2, url2 := unpackAny(v2)
+	if !m2.IsValid() || url != url2 {
+		return false
+	}
+	name := "[" + url + "]"
+	d.diffStruct(joinPath(path, name), joinPath(fpath, name), m1.Elem(), m2.Elem())
+	return true
+}
+
+// unpackAny decodes the message held in sv, a google.protobuf.Any,
+// returning a pointer to it and the type URL, or an invalid Value
+// if the type isn't registered or the contents are malformed.
+func unpackAny(sv reflect.Value) (reflect.Value, string) {
+	turl := sv.FieldByName("TypeUrl")
+	val := sv.FieldByName("Value")
+	if !turl.IsValid() || !val.IsValid() {
+		return reflect.Value{}, ""
+	}
+	b, ok := val.Interface().([]byte)
+	if !ok {
+		return reflect.Value{}, ""
+	}
+	parts := strings.Split(turl.String(), "/")
+	mt := MessageType(parts[len(parts)-1])
+	if mt == nil {
+		return reflect.Value{}, ""
+	}
+	m := reflect.New(mt.Elem())
+	if err := Unmarshal(b, m.Interface().(Message)); err != nil {
+		return reflect.Value{}, ""
+	}
+	return m, turl.String()
+}
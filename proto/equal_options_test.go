@@ -0,0 +1,153 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+func TestEqualOptions(t *testing.T) {
+	greeting := func(s string) *pb.MyMessage {
+		m := &pb.MyMessage{Count: Int32(1)}
+		if err := SetExtension(m, pb.E_Greeting, []string{s}); err != nil {
+			t.Fatalf("SetExtension: %v", err)
+		}
+		return m
+	}
+	// Two encodings of the same proto3_proto.Nested, with fields in
+	// different orders.
+	nestedAny := func(b []byte) *proto3pb.Message {
+		return &proto3pb.Message{Anything: &any.Any{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: b}}
+	}
+	bunnyCute := []byte{0x0a, 0x01, 'x', 0x10, 0x01}
+	cuteBunny := []byte{0x10, 0x01, 0x0a, 0x01, 'x'}
+	cuteBunnyY := []byte{0x10, 0x01, 0x0a, 0x01, 'y'}
+
+	tests := []struct {
+		desc string
+		opts EqualOptions
+		a, b Message
+		want bool
+	}{
+		{"zero options", EqualOptions{}, &pb.MyMessage{Count: Int32(1)}, &pb.MyMessage{Count: Int32(1)}, true},
+		{"zero options differ", EqualOptions{}, &pb.MyMessage{Count: Int32(1)}, &pb.MyMessage{Count: Int32(2)}, false},
+
+		{"ignore field", EqualOptions{IgnorePaths: []string{"inner.host"}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(1)}}, true},
+		{"ignore other field", EqualOptions{IgnorePaths: []string{"inner.port"}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a")}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b")}}, false},
+		{"ignore field in repeated", EqualOptions{IgnorePaths: []string{"rep_inner.host"}},
+			&pb.MyMessage{RepInner: []*pb.InnerMessage{{Host: String("a")}, {Host: String("b")}}},
+			&pb.MyMessage{RepInner: []*pb.InnerMessage{{Host: String("c")}, {Host: String("d")}}}, true},
+		{"ignore oneof", EqualOptions{IgnorePaths: []string{"union"}},
+			&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+			&pb.Communique{Union: &pb.Communique_Name{Name: "x"}}, true},
+		{"ignore oneof case", EqualOptions{IgnorePaths: []string{"number"}},
+			&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+			&pb.Communique{}, true},
+		{"ignore extension", EqualOptions{IgnorePaths: []string{"[test_proto.greeting]"}},
+			greeting("hello"), greeting("bye"), true},
+		{"ignore map values", EqualOptions{IgnorePaths: []string{"terrain.bunny"}},
+			&proto3pb.Message{Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "x", Cute: true}}},
+			&proto3pb.Message{Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "y", Cute: true}}}, true},
+
+		{"unknown fields", EqualOptions{},
+			&pb.MyMessage{XXX_unrecognized: []byte{0xa0, 0x06, 0x02}},
+			&pb.MyMessage{}, false},
+		{"ignore unknown fields", EqualOptions{IgnoreUnknown: true},
+			&pb.MyMessage{XXX_unrecognized: []byte{0xa0, 0x06, 0x02}},
+			&pb.MyMessage{}, true},
+
+		{"repeated order", EqualOptions{},
+			&pb.MyMessage{Pet: []string{"a", "b"}}, &pb.MyMessage{Pet: []string{"b", "a"}}, false},
+		{"repeated as sets", EqualOptions{RepeatedAsSets: true},
+			&pb.MyMessage{Pet: []string{"a", "b", "a"}}, &pb.MyMessage{Pet: []string{"a", "a", "b"}}, true},
+		{"repeated as sets counts duplicates", EqualOptions{RepeatedAsSets: true},
+			&pb.MyMessage{Pet: []string{"a", "b", "a"}}, &pb.MyMessage{Pet: []string{"a", "b", "b"}}, false},
+		{"repeated messages as sets", EqualOptions{RepeatedAsSets: true},
+			&pb.MyMessage{RepInner: []*pb.InnerMessage{{Host: String("a")}, {Host: String("b")}}},
+			&pb.MyMessage{RepInner: []*pb.InnerMessage{{Host: String("b")}, {Host: String("a")}}}, true},
+
+		{"float epsilon", EqualOptions{FloatEpsilon: 1e-6},
+			&pb.MyMessage{Bigfloat: Float64(1)}, &pb.MyMessage{Bigfloat: Float64(1 + 1e-9)}, true},
+		{"float beyond epsilon", EqualOptions{FloatEpsilon: 1e-6},
+			&pb.MyMessage{Bigfloat: Float64(1)}, &pb.MyMessage{Bigfloat: Float64(1.1)}, false},
+		{"proto3 float epsilon", EqualOptions{FloatEpsilon: 1e-3},
+			&proto3pb.Message{Score: 0.5}, &proto3pb.Message{Score: 0.5001}, true},
+		{"NaN", EqualOptions{},
+			&pb.MyMessage{Bigfloat: Float64(math.NaN())}, &pb.MyMessage{Bigfloat: Float64(math.NaN())}, false},
+		{"equate NaN", EqualOptions{EquateNaN: true},
+			&pb.MyMessage{Bigfloat: Float64(math.NaN())}, &pb.MyMessage{Bigfloat: Float64(math.NaN())}, true},
+		{"equate NaN with number", EqualOptions{EquateNaN: true, FloatEpsilon: math.Inf(1)},
+			&pb.MyMessage{Bigfloat: Float64(math.NaN())}, &pb.MyMessage{Bigfloat: Float64(1)}, false},
+
+		{"Any by bytes", EqualOptions{}, nestedAny(bunnyCute), nestedAny(cuteBunny), false},
+		{"Any by contents", EqualOptions{UnpackAny: true}, nestedAny(bunnyCute), nestedAny(cuteBunny), true},
+		{"Any contents differ", EqualOptions{UnpackAny: true}, nestedAny(bunnyCute), nestedAny(cuteBunnyY), false},
+		{"Any ignore contents", EqualOptions{UnpackAny: true, IgnorePaths: []string{"anything.[type.googleapis.com/proto3_proto.Nested].bunny"}},
+			nestedAny(bunnyCute), nestedAny(cuteBunnyY), true},
+	}
+	for _, tt := range tests {
+		if got := tt.opts.Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: Equal(%v, %v) = %v, want %v", tt.desc, tt.a, tt.b, got, tt.want)
+		}
+		if got := len(tt.opts.Diff(tt.a, tt.b)) == 0; got != tt.want {
+			t.Errorf("%s: Diff(%v, %v) reported differences %v, want %v", tt.desc, tt.a, tt.b, !got, !tt.want)
+		}
+	}
+}
+
+func TestEqualOptionsDiff(t *testing.T) {
+	opts := EqualOptions{RepeatedAsSets: true, UnpackAny: true}
+	a := &proto3pb.Message{
+		Key:      []uint64{1, 2, 3},
+		Anything: &any.Any{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: []byte{0x0a, 0x01, 'x'}},
+	}
+	b := &proto3pb.Message{
+		Key:      []uint64{4, 3, 1},
+		Anything: &any.Any{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: []byte{0x0a, 0x01, 'y'}},
+	}
+	want := "-key[1]: 2\n+key[0]: 4\n" +
+		"-anything.[type.googleapis.com/proto3_proto.Nested].bunny: \"x\"\n" +
+		"+anything.[type.googleapis.com/proto3_proto.Nested].bunny: \"y\"\n"
+	if got := FormatDiff(opts.Diff(a, b)); got != want {
+		t.Errorf("FormatDiff(Diff(a, b)):\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
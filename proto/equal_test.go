@@ -233,6 +233,12 @@ var EqualTests = []struct {
 		&pb.Communique{Union: &pb.Communique_Name{"Bobby Tables"}},
 		false,
 	},
+	{
+		"unknown fields reordered",
+		&pb.MyMessage{XXX_unrecognized: []byte{0x98, 0x06, 0x01, 0xa0, 0x06, 0x02}},
+		&pb.MyMessage{XXX_unrecognized: []byte{0xa0, 0x06, 0x02, 0x98, 0x06, 0x01}},
+		false,
+	},
 }
 
 func TestEqual(t *testing.T) {
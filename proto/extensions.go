@@ -299,13 +299,22 @@ func ClearExtension(pb Message, extension *ExtensionDesc) {
 // otherwise ErrMissingExtension is reported.
 //
 // If the descriptor is not type complete (i.e., ExtensionDesc.ExtensionType is nil),
-// then GetExtension returns the raw encoded bytes of the field extension.
+// then GetExtension returns the raw encoded bytes of the field extension,
+// unless the extension is not registered but a registered ExtensionResolver
+// finds it by field number, in which case the field is decoded using the
+// resolved descriptor.
 func GetExtension(pb Message, extension *ExtensionDesc) (interface{}, error) {
 	epb, err := extendable(pb)
 	if err != nil {
 		return nil, err
 	}
 
+	if extension.ExtensionType == nil && RegisteredExtensions(pb)[extension.Field] == nil {
+		if desc := resolveExtension(pb, extension.Field); desc != nil {
+			extension = desc
+		}
+	}
+
 	if extension.ExtendedType != nil {
 		// can only check type if this is a complete descriptor
 		if err := checkExtensionTypes(epb, extension); err != nil {
@@ -398,14 +407,25 @@ func defaultExtensionValue(extension *ExtensionDesc) (interface{}, error) {
 
 // decodeExtension decodes an extension encoded in b.
 func decodeExtension(b []byte, extension *ExtensionDesc) (interface{}, error) {
+	v, err := decodeExtensionPartial(b, extension)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// decodeExtensionPartial is like decodeExtension, but when required fields
+// are missing it returns the decoded value along with a *RequiredNotSetError.
+func decodeExtensionPartial(b []byte, extension *ExtensionDesc) (interface{}, error) {
 	t := reflect.TypeOf(extension.ExtensionType)
-	unmarshal := typeUnmarshaler(t, extension.Tag)
+	unmarshal := typeUnmarshaler(t, extension.Tag, false)
 
 	// t is a pointer to a struct, pointer to basic type or a slice.
 	// Allocate space to store the pointer/slice.
 	value := reflect.New(t).Elem()
 
 	var err error
+	var rnse *RequiredNotSetError
 	for {
 		x, n := decodeVarint(b)
 		if n == 0 {
@@ -415,7 +435,10 @@ func decodeExtension(b []byte, extension *ExtensionDesc) (interface{}, error) {
 		wire := int(x) & 7
 
 		b, err = unmarshal(b, valToPointer(value.Addr()), wire)
-		if err != nil {
+		if r, ok := err.(*RequiredNotSetError); ok {
+			// Keep decoding; the value is complete but for required fields.
+			rnse = r
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -423,6 +446,9 @@ func decodeExtension(b []byte, extension *ExtensionDesc) (interface{}, error) {
 			break
 		}
 	}
+	if rnse != nil {
+		return value.Interface(), rnse
+	}
 	return value.Interface(), nil
 }
 
@@ -447,7 +473,8 @@ func GetExtensions(pb Message, es []*ExtensionDesc) (extensions []interface{}, e
 }
 
 // ExtensionDescs returns a new slice containing pb's extension descriptors, in undefined order.
-// For non-registered extensions, ExtensionDescs returns an incomplete descriptor containing
+// For non-registered extensions that no registered ExtensionResolver finds,
+// ExtensionDescs returns an incomplete descriptor containing
 // just the Field field, which defines the extension's field number.
 func ExtensionDescs(pb Message) ([]*ExtensionDesc, error) {
 	epb, err := extendable(pb)
@@ -467,9 +494,12 @@ func ExtensionDescs(pb Message) ([]*ExtensionDesc, error) {
 		desc := e.desc
 		if desc == nil {
 			desc = registeredExtensions[extid]
-			if desc == nil {
-				desc = &ExtensionDesc{Field: extid}
-			}
+		}
+		if desc == nil {
+			desc = resolveExtension(pb, extid)
+		}
+		if desc == nil {
+			desc = &ExtensionDesc{Field: extid}
 		}
 
 		extensions = append(extensions, desc)
@@ -519,11 +549,17 @@ func ClearAllExtensions(pb Message) {
 // A global registry of extensions.
 // The generated code will register the generated descriptors by calling RegisterExtension.
 
-var extensionMaps = make(map[reflect.Type]map[int32]*ExtensionDesc)
+var (
+	extensionMapsLock sync.RWMutex
+	extensionMaps     = make(map[reflect.Type]map[int32]*ExtensionDesc)
+	extensionNames    = make(map[string]*ExtensionDesc) // indexed by fully-qualified name
+)
 
 // RegisterExtension is called from the generated code.
 func RegisterExtension(desc *ExtensionDesc) {
 	st := reflect.TypeOf(desc.ExtendedType).Elem()
+	extensionMapsLock.Lock()
+	defer extensionMapsLock.Unlock()
 	m := extensionMaps[st]
 	if m == nil {
 		m = make(map[int32]*ExtensionDesc)
@@ -533,11 +569,135 @@ func RegisterExtension(desc *ExtensionDesc) {
 		panic("proto: duplicate extension registered: " + st.String() + " " + strconv.Itoa(int(desc.Field)))
 	}
 	m[desc.Field] = desc
+	if _, ok := extensionNames[desc.Name]; !ok && desc.Name != "" {
+		extensionNames[desc.Name] = desc
+	}
 }
 
 // RegisteredExtensions returns a map of the registered extensions of a
 // protocol buffer struct, indexed by the extension number.
 // The argument pb should be a nil pointer to the struct type.
 func RegisteredExtensions(pb Message) map[int32]*ExtensionDesc {
+	extensionMapsLock.RLock()
+	defer extensionMapsLock.RUnlock()
 	return extensionMaps[reflect.TypeOf(pb).Elem()]
 }
+
+// registeredExtension returns the registered extension with field number
+// field of the struct type t, or nil if there is none.
+func registeredExtension(t reflect.Type, field int32) *ExtensionDesc {
+	extensionMapsLock.RLock()
+	defer extensionMapsLock.RUnlock()
+	return extensionMaps[t][field]
+}
+
+// An ExtensionResolver finds extensions that are not registered with
+// RegisterExtension, for example by building descriptors at run time from
+// a FileDescriptorSet when no Go code for the extensions is linked in.
+//
+// The descriptors it returns must be complete, with ExtendedType set to
+// the Go type of the extended message, and the same descriptor must be
+// returned each time an extension is looked up.
+type ExtensionResolver interface {
+	// FindExtensionByName returns the extension with the fully-qualified
+	// name, such as "network.api.http", or nil if there is none.
+	FindExtensionByName(name string) *ExtensionDesc
+
+	// FindExtensionByNumber returns the extension with field number field
+	// of the message with the fully-qualified name extendee, or nil if
+	// there is none.
+	FindExtensionByNumber(extendee string, field int32) *ExtensionDesc
+}
+
+// extensionResolvers holds the registered resolvers. The list is only ever
+// appended to, so a copy of the slice taken under the lock stays valid.
+var extensionResolvers struct {
+	sync.RWMutex
+	list []ExtensionResolver
+}
+
+// registeredResolvers returns the registered extension resolvers. They are
+// called without the lock held, so that they may use this package freely.
+func registeredResolvers() []ExtensionResolver {
+	extensionResolvers.RLock()
+	defer extensionResolvers.RUnlock()
+	return extensionResolvers.list
+}
+
+// RegisterExtensionResolver adds r to the resolvers consulted, in the order
+// they were registered, for extensions that are not registered.
+// Extensions found by a resolver are used by FindExtensionByName and
+// FindExtensionByNumber, by the text format and JSON parsers and printers,
+// by ExtensionDescs, and by GetExtension to decode extensions given only
+// their field number. It is safe to call concurrently with lookups.
+func RegisterExtensionResolver(r ExtensionResolver) {
+	extensionResolvers.Lock()
+	extensionResolvers.list = append(extensionResolvers.list, r)
+	extensionResolvers.Unlock()
+}
+
+// FindExtensionByName returns the extension with the fully-qualified name,
+// such as "network.api.http", from the registered extensions or a
+// registered ExtensionResolver. It returns nil if there is none.
+func FindExtensionByName(name string) *ExtensionDesc {
+	extensionMapsLock.RLock()
+	desc := extensionNames[name]
+	extensionMapsLock.RUnlock()
+	if desc != nil {
+		return desc
+	}
+	for _, r := range registeredResolvers() {
+		if desc := r.FindExtensionByName(name); desc != nil {
+			return desc
+		}
+	}
+	return nil
+}
+
+// FindExtensionByNumber returns the extension with field number field of
+// the message with the fully-qualified name extendee, such as
+// "google.protobuf.MethodOptions", from the registered extensions or a
+// registered ExtensionResolver. It returns nil if there is none.
+func FindExtensionByNumber(extendee string, field int32) *ExtensionDesc {
+	if t := MessageType(extendee); t != nil && t.Kind() == reflect.Ptr {
+		if desc := registeredExtension(t.Elem(), field); desc != nil {
+			return desc
+		}
+	}
+	for _, r := range registeredResolvers() {
+		if desc := r.FindExtensionByNumber(extendee, field); desc != nil {
+			return desc
+		}
+	}
+	return nil
+}
+
+// findExtension returns the extension of pb with the fully-qualified name,
+// or nil if there is none.
+func findExtension(pb Message, name string) *ExtensionDesc {
+	desc := FindExtensionByName(name)
+	if desc == nil || reflect.TypeOf(desc.ExtendedType) != reflect.TypeOf(pb) {
+		return nil
+	}
+	return desc
+}
+
+// resolveExtension returns the descriptor found by a registered
+// ExtensionResolver for the extension of pb with field number field,
+// or nil if there is none.
+func resolveExtension(pb Message, field int32) *ExtensionDesc {
+	resolvers := registeredResolvers()
+	if len(resolvers) == 0 {
+		return nil
+	}
+	name := MessageName(pb)
+	if name == "" {
+		return nil
+	}
+	for _, r := range resolvers {
+		if desc := r.FindExtensionByNumber(name, field); desc != nil {
+			return desc
+		}
+	}
+	return nil
+}
@@ -686,3 +686,122 @@ func TestMarshalRace(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestFindExtension(t *testing.T) {
+	if got := proto.FindExtensionByName("test_proto.greeting"); got != pb.E_Greeting {
+		t.Errorf("FindExtensionByName(test_proto.greeting) = %v, want %v", got, pb.E_Greeting)
+	}
+	if got := proto.FindExtensionByNumber("test_proto.MyMessage", 106); got != pb.E_Greeting {
+		t.Errorf("FindExtensionByNumber(test_proto.MyMessage, 106) = %v, want %v", got, pb.E_Greeting)
+	}
+	if got := proto.FindExtensionByName("test_proto.no_such_extension"); got != nil {
+		t.Errorf("FindExtensionByName(test_proto.no_such_extension) = %v, want nil", got)
+	}
+	if got := proto.FindExtensionByNumber("test_proto.MyMessage", 107); got != nil {
+		t.Errorf("FindExtensionByNumber(test_proto.MyMessage, 107) = %v, want nil", got)
+	}
+	if got := proto.FindExtensionByNumber("test_proto.NoSuchMessage", 106); got != nil {
+		t.Errorf("FindExtensionByNumber(test_proto.NoSuchMessage, 106) = %v, want nil", got)
+	}
+}
+
+// testResolver resolves an extension of MyMessage that is not registered,
+// as if it were built from a descriptor at run time.
+type testResolver struct {
+	enabled bool
+}
+
+var resolvedExtension = &proto.ExtensionDesc{
+	ExtendedType:  (*pb.MyMessage)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         9100,
+	Name:          "test_proto.resolved_name",
+	Tag:           "bytes,9100,opt,name=resolved_name",
+}
+
+func (r *testResolver) FindExtensionByName(name string) *proto.ExtensionDesc {
+	if r.enabled && name == resolvedExtension.Name {
+		return resolvedExtension
+	}
+	return nil
+}
+
+func (r *testResolver) FindExtensionByNumber(extendee string, field int32) *proto.ExtensionDesc {
+	if r.enabled && extendee == "test_proto.MyMessage" && field == resolvedExtension.Field {
+		return resolvedExtension
+	}
+	return nil
+}
+
+var resolver = new(testResolver)
+
+func init() {
+	proto.RegisterExtensionResolver(resolver)
+}
+
+func TestExtensionResolver(t *testing.T) {
+	b := proto.NewBuffer(nil)
+	b.EncodeVarint(9100<<3 | proto.WireBytes)
+	b.EncodeStringBytes("hello")
+	raw := b.Bytes()
+	incomplete := &proto.ExtensionDesc{Field: 9100}
+
+	m := &pb.MyMessage{Count: proto.Int32(1)}
+	proto.SetRawExtension(m, 9100, raw)
+	if got, err := proto.GetExtension(m, incomplete); err != nil || !bytes.Equal(got.([]byte), raw) {
+		t.Errorf("GetExtension without resolver = %v, %v; want raw bytes", got, err)
+	}
+
+	resolver.enabled = true
+	defer func() { resolver.enabled = false }()
+
+	if got := proto.FindExtensionByName("test_proto.resolved_name"); got != resolvedExtension {
+		t.Errorf("FindExtensionByName = %v, want resolved extension", got)
+	}
+	if got := proto.FindExtensionByNumber("test_proto.MyMessage", 9100); got != resolvedExtension {
+		t.Errorf("FindExtensionByNumber = %v, want resolved extension", got)
+	}
+	descs, err := proto.ExtensionDescs(m)
+	if err != nil || len(descs) != 1 || descs[0] != resolvedExtension {
+		t.Errorf("ExtensionDescs = %v, %v; want resolved extension", descs, err)
+	}
+	if got := proto.CompactTextString(m); got != `count:1 [test_proto.resolved_name]:"hello" ` {
+		t.Errorf("CompactTextString = %q", got)
+	}
+	for i := 0; i < 2; i++ {
+		// The second call returns the value decoded by the first.
+		got, err := proto.GetExtension(m, incomplete)
+		if s, ok := got.(*string); err != nil || !ok || *s != "hello" {
+			t.Errorf("GetExtension with resolver = %v, %v; want decoded string", got, err)
+		}
+	}
+
+	m2 := new(pb.MyMessage)
+	if err := proto.UnmarshalText(`count: 1 [test_proto.resolved_name]: "bye"`, m2); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if got, err := proto.GetExtension(m2, resolvedExtension); err != nil || *got.(*string) != "bye" {
+		t.Errorf("GetExtension after UnmarshalText = %v, %v; want bye", got, err)
+	}
+}
+
+// TestExtensionResolverConcurrentRegistration registers resolvers while
+// others look up extensions, for the race detector.
+func TestExtensionResolverConcurrentRegistration(t *testing.T) {
+	var g errgroup.Group
+	for n := 3; n > 0; n-- {
+		g.Go(func() error {
+			proto.RegisterExtensionResolver(new(testResolver))
+			return nil
+		})
+		g.Go(func() error {
+			if desc := proto.FindExtensionByNumber("test_proto.MyMessage", 9101); desc != nil {
+				return fmt.Errorf("FindExtensionByNumber(test_proto.MyMessage, 9101) = %v, want nil", desc)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+}
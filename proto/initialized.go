@@ -0,0 +1,188 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Checking that required fields are set.
+ */
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RequiredFieldsNotSetError is returned by CheckInitialized.
+// It lists every required field that is not set.
+type RequiredFieldsNotSetError struct {
+	// Fields holds the paths of the missing fields, in the path syntax
+	// of Difference, e.g. "inner.host" or "rep_inner[2].host".
+	Fields []string
+}
+
+func (e *RequiredFieldsNotSetError) Error() string {
+	q := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		q[i] = strconv.Quote(f)
+	}
+	if len(q) == 1 {
+		return "proto: required field " + q[0] + " not set"
+	}
+	return "proto: required fields " + strings.Join(q, ", ") + " not set"
+}
+
+// IsInitialized reports whether all required fields of pb are set,
+// including those of nested messages.
+func IsInitialized(pb Message) bool {
+	return CheckInitialized(pb) == nil
+}
+
+// CheckInitialized returns a *RequiredFieldsNotSetError listing all
+// required fields of pb that are not set, including those of messages
+// nested in fields, repeated fields, maps, oneofs and extensions.
+// Unlike Marshal, which stops at the first missing field, it reports
+// them all, and it does so without encoding the message.
+// Extensions that are only held in encoded form are decoded to be checked,
+// if they are registered.
+// It returns nil if all required fields are set.
+func CheckInitialized(pb Message) error {
+	v := reflect.ValueOf(pb)
+	if pb == nil || v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	var missing []string
+	checkInitialized("", v.Elem(), &missing)
+	if len(missing) > 0 {
+		return &RequiredFieldsNotSetError{Fields: missing}
+	}
+	return nil
+}
+
+// checkInitialized appends to missing the paths of the required fields
+// that aren't set in sv, a message struct at path.
+func checkInitialized(path string, sv reflect.Value, missing *[]string) {
+	sprop := GetProperties(sv.Type())
+	for i := 0; i < sv.NumField(); i++ {
+		f := sv.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		prop := sprop.Prop[i]
+		fv := sv.Field(i)
+		if f.Type.Kind() == reflect.Interface {
+			// A oneof field.
+			if !fv.IsNil() {
+				c, p := oneofCase(fv.Elem())
+				checkInitializedValue(joinPath(path, p.OrigName), c, missing)
+			}
+			continue
+		}
+		fpath := joinPath(path, prop.OrigName)
+		// Required fields are pointers, or slices for bytes fields.
+		if prop.Required && (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Slice) && fv.IsNil() {
+			*missing = append(*missing, fpath)
+			continue
+		}
+		checkInitializedValue(fpath, fv, missing)
+	}
+
+	if ef := sv.FieldByName("XXX_InternalExtensions"); ef.IsValid() {
+		x := ef.Addr().Interface().(*XXX_InternalExtensions)
+		em, mu := x.extensionsRead()
+		if em != nil {
+			mu.Lock()
+			checkInitializedExtensions(path, sv.Type(), em, missing)
+			mu.Unlock()
+		}
+	}
+	if ef := sv.FieldByName("XXX_extensions"); ef.IsValid() {
+		checkInitializedExtensions(path, sv.Type(), ef.Interface().(map[int32]Extension), missing)
+	}
+}
+
+// checkInitializedValue checks the messages held in v,
+// the value of a field, oneof case or extension.
+func checkInitializedValue(path string, v reflect.Value, missing *[]string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			checkInitialized(path, v.Elem(), missing)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Ptr {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			checkInitializedValue(path+"["+strconv.Itoa(i)+"]", v.Index(i), missing)
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Ptr {
+			return
+		}
+		keys := v.MapKeys()
+		sort.Sort(mapKeys(keys))
+		for _, k := range keys {
+			checkInitializedValue(path+"["+formatDiffValue(k.Interface())+"]", v.MapIndex(k), missing)
+		}
+	}
+}
+
+// checkInitializedExtensions checks the messages held in the
+// extensions em of a message of type base.
+func checkInitializedExtensions(path string, base reflect.Type, em map[int32]Extension, missing *[]string) {
+	nums := make([]int, 0, len(em))
+	for n := range em {
+		nums = append(nums, int(n))
+	}
+	sort.Ints(nums)
+	for _, n := range nums {
+		e := em[int32(n)]
+		desc := e.desc
+		if desc == nil {
+			desc = registeredExtension(base, int32(n))
+		}
+		if desc == nil {
+			// Without the extension's type, it can't be checked.
+			continue
+		}
+		v := e.value
+		if v == nil {
+			var err error
+			v, err = decodeExtensionPartial(e.enc, desc)
+			if _, ok := err.(*RequiredNotSetError); err != nil && !ok {
+				continue
+			}
+		}
+		checkInitializedValue(joinPath(path, "["+desc.Name+"]"), reflect.ValueOf(v), missing)
+	}
+}
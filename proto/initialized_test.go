@@ -0,0 +1,131 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+)
+
+// An extension with required fields, for checking extensions.
+var eRequiredInner = &ExtensionDesc{
+	ExtendedType:  (*pb.OtherMessage)(nil),
+	ExtensionType: (*pb.InnerMessage)(nil),
+	Field:         9000,
+	Name:          "test_proto.required_inner",
+	Tag:           "bytes,9000,opt,name=required_inner",
+}
+
+func init() {
+	RegisterExtension(eRequiredInner)
+}
+
+func TestCheckInitialized(t *testing.T) {
+	withExt := func(m *pb.OtherMessage, marshal bool) *pb.OtherMessage {
+		if err := SetExtension(m, eRequiredInner, &pb.InnerMessage{Port: Int32(1)}); err != nil {
+			t.Fatalf("SetExtension: %v", err)
+		}
+		if !marshal {
+			return m
+		}
+		// Leave the extension in encoded form only.
+		b, err := Marshal(m)
+		if _, ok := err.(*RequiredNotSetError); err != nil && !ok {
+			t.Fatalf("Marshal: %v", err)
+		}
+		m2 := new(pb.OtherMessage)
+		if err := Unmarshal(b, m2); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		return m2
+	}
+
+	tests := []struct {
+		desc string
+		in   Message
+		want []string
+	}{
+		{"nil", (*pb.MyMessage)(nil), nil},
+		{"set", &pb.MyMessage{Count: Int32(1)}, nil},
+		{"top level", &pb.MyMessage{}, []string{"count"}},
+		{"nested", &pb.MyMessage{Inner: &pb.InnerMessage{}, WeMustGoDeeper: &pb.RequiredInnerMessage{}},
+			[]string{"count", "inner.host", "we_must_go_deeper.leo_finally_won_an_oscar"}},
+		{"repeated", &pb.MyMessage{Count: Int32(1), RepInner: []*pb.InnerMessage{{Host: String("a")}, {}, {}}},
+			[]string{"rep_inner[1].host", "rep_inner[2].host"}},
+		{"map", &pb.MessageWithMap{MsgMapping: map[int64]*pb.FloatingPoint{3: {}, 1: {F: Float64(1)}, 2: {}}},
+			[]string{"msg_mapping[2].f", "msg_mapping[3].f"}},
+		{"oneof", &pb.Oneof{Union: &pb.Oneof_F_Message{F_Message: &pb.GoTestField{Label: String("l")}}}, []string{"F_Message.Type"}},
+		{"other oneof", &pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{}}}, nil},
+		{"group", &pb.GoTestRequiredGroupField{Group: &pb.GoTestRequiredGroupField_Group{}}, []string{"Group.Field"}},
+		{"extension", withExt(&pb.OtherMessage{}, false), []string{"[test_proto.required_inner].host"}},
+		{"encoded extension", withExt(&pb.OtherMessage{}, true), []string{"[test_proto.required_inner].host"}},
+		{"all kinds set", initGoTest(false), nil},
+		{"empty bytes", func() *pb.GoTest { m := initGoTest(false); m.F_BytesRequired = []byte{}; return m }(), nil},
+		{"bytes", func() *pb.GoTest { m := initGoTest(false); m.F_BytesRequired = nil; return m }(), []string{"F_Bytes_required"}},
+		{"proto3 with proto2 field", &proto3pb.Message{Terrain: map[string]*proto3pb.Nested{"a": {}}}, nil},
+	}
+	for _, tt := range tests {
+		err := CheckInitialized(tt.in)
+		if IsInitialized(tt.in) != (err == nil) {
+			t.Errorf("%s: IsInitialized disagrees with CheckInitialized error %v", tt.desc, err)
+		}
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("%s: CheckInitialized: got %v, want nil", tt.desc, err)
+			}
+			continue
+		}
+		rerr, ok := err.(*RequiredFieldsNotSetError)
+		if !ok {
+			t.Errorf("%s: CheckInitialized: got %v, want *RequiredFieldsNotSetError", tt.desc, err)
+			continue
+		}
+		if !reflect.DeepEqual(rerr.Fields, tt.want) {
+			t.Errorf("%s: CheckInitialized fields: got %q, want %q", tt.desc, rerr.Fields, tt.want)
+		}
+	}
+}
+
+func TestRequiredFieldsNotSetError(t *testing.T) {
+	err := CheckInitialized(&pb.MyMessage{Inner: &pb.InnerMessage{}})
+	if got, want := err.Error(), `proto: required fields "count", "inner.host" not set`; got != want {
+		t.Errorf("Error: got %q, want %q", got, want)
+	}
+	err = CheckInitialized(&pb.MyMessage{})
+	if got, want := err.Error(), `proto: required field "count" not set`; got != want {
+		t.Errorf("Error: got %q, want %q", got, want)
+	}
+}
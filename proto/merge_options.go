@@ -0,0 +1,291 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Configurable merging of messages.
+ */
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MergeOptions configures how messages are merged.
+// The zero value merges messages the same way as Merge.
+type MergeOptions struct {
+	// ReplaceRepeated makes a repeated field or map that is set in src
+	// replace the field in dst, rather than appending elements to it
+	// or adding entries to it.
+	ReplaceRepeated bool
+
+	// ReplaceMessages makes a message field that is set in src replace
+	// the field in dst, rather than being merged into it field by field.
+	ReplaceMessages bool
+
+	// SkipUnsetScalars leaves scalar fields of dst named by Paths alone
+	// when they are unset in src. Otherwise they are cleared, so that a
+	// mask can reset a field to its default. A proto3 scalar is unset when
+	// it is the zero value.
+	// Merging without Paths never copies unset scalars.
+	SkipUnsetScalars bool
+
+	// Paths, if not nil, restricts the merge to the fields named by the
+	// paths of a google.protobuf.FieldMask, such as "name" or "inner.host".
+	// A path names a field by its original name; naming a message field
+	// merges the whole message, and the fields of a message field can be
+	// named through it. Fields of repeated fields and maps cannot be named.
+	// Paths that name no field are ignored.
+	// Extensions and unknown fields are merged only without Paths.
+	//
+	// Following the field mask convention, fields named by Paths are copied
+	// from src even when unset there: scalars are cleared unless
+	// SkipUnsetScalars is set, repeated fields and maps are cleared if
+	// ReplaceRepeated is set, and message fields if ReplaceMessages is set.
+	// The same holds for paths through a message field unset in src.
+	Paths []string
+}
+
+// Merge merges src into dst under the options.
+// It is like the top-level Merge function when the options are zero.
+// Messages with a custom Merge method are merged by that method,
+// which ignores the options.
+// Merge panics if src and dst are not the same type, or if dst is nil.
+func (o *MergeOptions) Merge(dst, src Message) {
+	if !o.ReplaceRepeated && !o.ReplaceMessages && o.Paths == nil {
+		Merge(dst, src)
+		return
+	}
+	in := reflect.ValueOf(src)
+	out := reflect.ValueOf(dst)
+	if out.IsNil() {
+		panic("proto: nil destination")
+	}
+	if in.Type() != out.Type() {
+		panic(fmt.Sprintf("proto: MergeOptions.Merge(%T, %T) type mismatch", dst, src))
+	}
+	if in.IsNil() {
+		return // Merge from nil src is a noop
+	}
+	if _, ok := dst.(Merger); ok || out.Elem().Kind() != reflect.Struct {
+		Merge(dst, src)
+		return
+	}
+	var mask mergeMask
+	if o.Paths != nil {
+		mask = make(mergeMask)
+		for _, p := range o.Paths {
+			mask.add(strings.Split(p, "."))
+		}
+	}
+	o.mergeStruct(out.Elem(), in.Elem(), mask)
+}
+
+// mergeMask is the tree of fields named by MergeOptions.Paths,
+// keyed by original field names. A nil subtree names the whole field.
+type mergeMask map[string]mergeMask
+
+func (m mergeMask) add(path []string) {
+	sub, ok := m[path[0]]
+	switch {
+	case len(path) == 1:
+		m[path[0]] = nil
+	case ok && sub == nil:
+		// The whole field is already named.
+	default:
+		if sub == nil {
+			sub = make(mergeMask)
+			m[path[0]] = sub
+		}
+		sub.add(path[1:])
+	}
+}
+
+// mergeStruct merges the fields of in named by mask into out,
+// or all of them if mask is nil.
+func (o *MergeOptions) mergeStruct(out, in reflect.Value, mask mergeMask) {
+	sprop := GetProperties(in.Type())
+	for i := 0; i < in.NumField(); i++ {
+		f := in.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		if f.Type.Kind() == reflect.Interface {
+			o.mergeOneof(out.Field(i), in.Field(i), mask)
+			continue
+		}
+		prop := sprop.Prop[i]
+		if mask == nil {
+			o.mergeField(out.Field(i), in.Field(i), prop)
+			continue
+		}
+		sub, ok := mask[prop.OrigName]
+		switch {
+		case !ok:
+		case sub == nil:
+			o.copyField(out.Field(i), in.Field(i), prop)
+		case isMessagePtr(f.Type) && !in.Field(i).IsNil():
+			if out.Field(i).IsNil() {
+				out.Field(i).Set(reflect.New(f.Type.Elem()))
+			}
+			o.mergeStruct(out.Field(i).Elem(), in.Field(i).Elem(), sub)
+		case isMessagePtr(f.Type) && !out.Field(i).IsNil():
+			// Nothing is set at the paths in src, so they are
+			// cleared in dst as if src held an empty message.
+			o.mergeStruct(out.Field(i).Elem(), reflect.Zero(f.Type.Elem()), sub)
+		}
+	}
+	if mask != nil {
+		return
+	}
+
+	if emIn, err := extendable(in.Addr().Interface()); err == nil {
+		emOut, _ := extendable(out.Addr().Interface())
+		mIn, muIn := emIn.extensionsRead()
+		if mIn != nil {
+			mOut := emOut.extensionsWrite()
+			muIn.Lock()
+			mergeExtension(mOut, mIn)
+			muIn.Unlock()
+		}
+	}
+
+	uf := in.FieldByName("XXX_unrecognized")
+	if !uf.IsValid() {
+		return
+	}
+	uin := uf.Bytes()
+	if len(uin) > 0 {
+		out.FieldByName("XXX_unrecognized").SetBytes(append([]byte(nil), uin...))
+	}
+}
+
+// isMessagePtr reports whether t is a pointer to a message struct.
+func isMessagePtr(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct
+}
+
+// mergeField merges field in into out, leaving out alone if in is unset.
+func (o *MergeOptions) mergeField(out, in reflect.Value, prop *Properties) {
+	switch {
+	case isMessagePtr(in.Type()):
+		if in.IsNil() {
+			return
+		}
+		if o.ReplaceMessages || out.IsNil() {
+			out.Set(reflect.ValueOf(Clone(in.Interface().(Message))))
+			return
+		}
+		if _, ok := out.Interface().(Merger); ok {
+			Merge(out.Interface().(Message), in.Interface().(Message))
+			return
+		}
+		o.mergeStruct(out.Elem(), in.Elem(), nil)
+	case in.Kind() == reflect.Map || in.Kind() == reflect.Slice && in.Type().Elem().Kind() != reflect.Uint8:
+		if in.Len() == 0 {
+			return
+		}
+		if o.ReplaceRepeated {
+			out.Set(reflect.Zero(in.Type()))
+		}
+		mergeAny(out, in, false, prop)
+	default:
+		mergeAny(out, in, false, prop)
+	}
+}
+
+// copyField copies field in, which is named by MergeOptions.Paths, into out.
+func (o *MergeOptions) copyField(out, in reflect.Value, prop *Properties) {
+	var replace bool
+	switch {
+	case isMessagePtr(in.Type()):
+		replace = o.ReplaceMessages
+	case in.Kind() == reflect.Map || in.Kind() == reflect.Slice && in.Type().Elem().Kind() != reflect.Uint8:
+		replace = o.ReplaceRepeated
+	default:
+		replace = !o.SkipUnsetScalars
+	}
+	if replace {
+		out.Set(reflect.Zero(in.Type()))
+	}
+	o.mergeField(out, in, prop)
+}
+
+// mergeOneof merges the oneof field in into out, which both hold
+// pointers to wrapper structs whose single field is the selected case.
+// If mask is not nil, only the cases it names are merged.
+func (o *MergeOptions) mergeOneof(out, in reflect.Value, mask mergeMask) {
+	if mask != nil && !out.IsNil() && (in.IsNil() || in.Elem().Type() != out.Elem().Type()) {
+		// The case set in dst is unset in src.
+		c, p := oneofCase(out.Elem())
+		sub, ok := mask[p.OrigName]
+		switch {
+		case !ok:
+		case sub != nil:
+			// Paths through the message case are cleared in it.
+			if isMessagePtr(c.Type()) && !c.IsNil() {
+				o.mergeStruct(c.Elem(), reflect.Zero(c.Type().Elem()), sub)
+			}
+		case isMessagePtr(c.Type()):
+			if o.ReplaceMessages {
+				out.Set(reflect.Zero(out.Type()))
+			}
+		case !o.SkipUnsetScalars:
+			out.Set(reflect.Zero(out.Type()))
+		}
+	}
+	if in.IsNil() {
+		return
+	}
+	c, p := oneofCase(in.Elem())
+	sub, ok := mask[p.OrigName]
+	if mask != nil && !ok || isMessagePtr(c.Type()) && c.IsNil() {
+		return
+	}
+	if out.IsNil() || out.Elem().Type() != in.Elem().Type() {
+		out.Set(reflect.New(in.Elem().Elem().Type())) // interface -> *T -> T -> new(T)
+	}
+	oc, _ := oneofCase(out.Elem())
+	switch {
+	case !isMessagePtr(c.Type()):
+		// A oneof case has presence, so even zero values are copied.
+		mergeAny(oc, c, true, nil)
+	case sub != nil:
+		if oc.IsNil() {
+			oc.Set(reflect.New(c.Type().Elem()))
+		}
+		o.mergeStruct(oc.Elem(), c.Elem(), sub)
+	default:
+		o.mergeField(oc, c, p)
+	}
+}
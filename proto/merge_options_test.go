@@ -0,0 +1,199 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+)
+
+func TestMergeOptions(t *testing.T) {
+	tests := []struct {
+		desc           string
+		opts           MergeOptions
+		dst, src, want Message
+	}{
+		{"append repeated", MergeOptions{},
+			&pb.MyMessage{Pet: []string{"a"}},
+			&pb.MyMessage{Pet: []string{"b"}},
+			&pb.MyMessage{Pet: []string{"a", "b"}}},
+		{"replace repeated", MergeOptions{ReplaceRepeated: true},
+			&pb.MyMessage{Pet: []string{"a"}, RepInner: []*pb.InnerMessage{{Host: String("x")}}},
+			&pb.MyMessage{Pet: []string{"b"}},
+			&pb.MyMessage{Pet: []string{"b"}, RepInner: []*pb.InnerMessage{{Host: String("x")}}}},
+		{"replace repeated in submessage", MergeOptions{ReplaceRepeated: true},
+			&proto3pb.Message{Submessage: &proto3pb.Message{Key: []uint64{1, 2}, Name: "x"}},
+			&proto3pb.Message{Submessage: &proto3pb.Message{Key: []uint64{3}}},
+			&proto3pb.Message{Submessage: &proto3pb.Message{Key: []uint64{3}, Name: "x"}}},
+		{"replace map", MergeOptions{ReplaceRepeated: true},
+			&proto3pb.Message{StringMap: map[string]string{"a": "1", "b": "2"}},
+			&proto3pb.Message{StringMap: map[string]string{"b": "3"}},
+			&proto3pb.Message{StringMap: map[string]string{"b": "3"}}},
+		{"merge submessage", MergeOptions{},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b")}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(1)}}},
+		{"replace submessage", MergeOptions{ReplaceMessages: true},
+			&pb.MyMessage{Count: Int32(1), Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b")}},
+			&pb.MyMessage{Count: Int32(1), Inner: &pb.InnerMessage{Host: String("b")}}},
+		{"replace oneof submessage", MergeOptions{ReplaceMessages: true},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("a"), BytesField: []byte("b")}}},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("c")}}},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("c")}}}},
+		{"oneof zero value", MergeOptions{ReplaceMessages: true},
+			&pb.Communique{Union: &pb.Communique_Name{Name: "a"}},
+			&pb.Communique{Union: &pb.Communique_Number{Number: 0}},
+			&pb.Communique{Union: &pb.Communique_Number{Number: 0}}},
+		{"unset proto3 scalars are skipped", MergeOptions{ReplaceMessages: true},
+			&proto3pb.Message{Name: "a", HeightInCm: 3},
+			&proto3pb.Message{HeightInCm: 4},
+			&proto3pb.Message{Name: "a", HeightInCm: 4}},
+
+		{"paths", MergeOptions{Paths: []string{"name", "inner"}},
+			&pb.MyMessage{Count: Int32(1), Name: String("a")},
+			&pb.MyMessage{Count: Int32(2), Name: String("b"), Quote: String("q"), Inner: &pb.InnerMessage{Host: String("h")}},
+			&pb.MyMessage{Count: Int32(1), Name: String("b"), Inner: &pb.InnerMessage{Host: String("h")}}},
+		{"nested paths", MergeOptions{Paths: []string{"inner.port"}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a")}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(2)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(2)}}},
+		{"nested path into unset message", MergeOptions{Paths: []string{"inner.port"}},
+			&pb.MyMessage{},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(2)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Port: Int32(2)}}},
+		{"nested path through unset message", MergeOptions{Paths: []string{"inner.port"}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+			&pb.MyMessage{},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a")}}},
+		{"nested path through unset message skips unset scalars", MergeOptions{Paths: []string{"inner.port"}, SkipUnsetScalars: true},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+			&pb.MyMessage{},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}}},
+		{"whole field path wins", MergeOptions{Paths: []string{"inner.port", "inner"}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a")}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(2)}},
+			&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(2)}}},
+		{"paths clear unset scalars", MergeOptions{Paths: []string{"name", "height_in_cm", "data"}},
+			&proto3pb.Message{Name: "a", HeightInCm: 3, Data: []byte("x"), ResultCount: 5},
+			&proto3pb.Message{},
+			&proto3pb.Message{ResultCount: 5}},
+		{"paths clear unset proto2 scalars", MergeOptions{Paths: []string{"name"}},
+			&pb.MyMessage{Name: String("a")},
+			&pb.MyMessage{},
+			&pb.MyMessage{}},
+		{"paths skip unset scalars", MergeOptions{Paths: []string{"name", "height_in_cm"}, SkipUnsetScalars: true},
+			&proto3pb.Message{Name: "a", HeightInCm: 3},
+			&proto3pb.Message{HeightInCm: 4},
+			&proto3pb.Message{Name: "a", HeightInCm: 4}},
+		{"paths keep unset messages", MergeOptions{Paths: []string{"nested"}},
+			&proto3pb.Message{Nested: &proto3pb.Nested{Bunny: "a"}},
+			&proto3pb.Message{},
+			&proto3pb.Message{Nested: &proto3pb.Nested{Bunny: "a"}}},
+		{"paths replace unset messages", MergeOptions{Paths: []string{"nested"}, ReplaceMessages: true},
+			&proto3pb.Message{Nested: &proto3pb.Nested{Bunny: "a"}},
+			&proto3pb.Message{},
+			&proto3pb.Message{}},
+		{"paths append repeated", MergeOptions{Paths: []string{"key"}},
+			&proto3pb.Message{Key: []uint64{1}},
+			&proto3pb.Message{Key: []uint64{2}, ShortKey: []int32{3}},
+			&proto3pb.Message{Key: []uint64{1, 2}}},
+		{"paths replace repeated", MergeOptions{Paths: []string{"key", "short_key"}, ReplaceRepeated: true},
+			&proto3pb.Message{Key: []uint64{1}, ShortKey: []int32{2}},
+			&proto3pb.Message{Key: []uint64{3}},
+			&proto3pb.Message{Key: []uint64{3}}},
+		{"paths oneof case", MergeOptions{Paths: []string{"number"}},
+			&pb.Communique{MakeMeCry: Bool(true)},
+			&pb.Communique{MakeMeCry: Bool(false), Union: &pb.Communique_Number{Number: 1}},
+			&pb.Communique{MakeMeCry: Bool(true), Union: &pb.Communique_Number{Number: 1}}},
+		{"paths other oneof case", MergeOptions{Paths: []string{"name"}},
+			&pb.Communique{Union: &pb.Communique_Name{Name: "a"}},
+			&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+			&pb.Communique{}},
+		{"paths into oneof message", MergeOptions{Paths: []string{"msg.bytes_field"}},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("a")}}},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("b"), BytesField: []byte("c")}}},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("a"), BytesField: []byte("c")}}}},
+		{"paths through unset oneof message", MergeOptions{Paths: []string{"msg.bytes_field"}},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("a"), BytesField: []byte("b")}}},
+			&pb.Communique{},
+			&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: String("a")}}}},
+		{"empty paths", MergeOptions{Paths: []string{}},
+			&pb.MyMessage{Name: String("a")},
+			&pb.MyMessage{Name: String("b"), Count: Int32(1)},
+			&pb.MyMessage{Name: String("a")}},
+		{"unknown path", MergeOptions{Paths: []string{"no_such_field", "name.x"}},
+			&pb.MyMessage{Name: String("a")},
+			&pb.MyMessage{Name: String("b")},
+			&pb.MyMessage{Name: String("a")}},
+	}
+	for _, tt := range tests {
+		src := Clone(tt.src)
+		tt.opts.Merge(tt.dst, tt.src)
+		if !Equal(tt.dst, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.desc, tt.dst, tt.want)
+		}
+		if !Equal(tt.src, src) {
+			t.Errorf("%s: src modified to %v", tt.desc, tt.src)
+		}
+	}
+}
+
+func TestMergeOptionsDeepCopy(t *testing.T) {
+	src := &proto3pb.Message{
+		Nested:   &proto3pb.Nested{Bunny: "a"},
+		Children: []*proto3pb.Message{{Name: "b"}},
+		Terrain:  map[string]*proto3pb.Nested{"c": {Bunny: "c"}},
+	}
+	dst := &proto3pb.Message{}
+	opts := MergeOptions{ReplaceRepeated: true, ReplaceMessages: true}
+	opts.Merge(dst, src)
+	src.Nested.Bunny = "x"
+	src.Children[0].Name = "x"
+	src.Terrain["c"].Bunny = "x"
+	if dst.Nested.Bunny != "a" || dst.Children[0].Name != "b" || dst.Terrain["c"].Bunny != "c" {
+		t.Errorf("merged message shares data with src: %v", dst)
+	}
+}
+
+func TestMergeOptionsZero(t *testing.T) {
+	for _, m := range mergeTests {
+		got := Clone(m.dst)
+		new(MergeOptions).Merge(got, m.src)
+		if !Equal(got, m.want) {
+			t.Errorf("MergeOptions{}.Merge(%v, %v)\ngot  %v\nwant %v", m.dst, m.src, got, m.want)
+		}
+	}
+}
@@ -142,6 +142,8 @@ type Properties struct {
 	proto3   bool   // whether this is known to be a proto3 field
 	oneof    bool   // whether this is a oneof field
 
+	Sensitive bool // whether the field holds sensitive data, redacted when printed
+
 	Default    string // default value
 	HasDefault bool   // whether an explicit default was provided
 
@@ -180,6 +182,9 @@ func (p *Properties) String() string {
 	if p.oneof {
 		s += ",oneof"
 	}
+	if p.Sensitive {
+		s += ",sensitive"
+	}
 	if len(p.Enum) > 0 {
 		s += ",enum=" + p.Enum
 	}
@@ -246,6 +251,8 @@ outer:
 			p.proto3 = true
 		case f == "oneof":
 			p.oneof = true
+		case f == "sensitive":
+			p.Sensitive = true
 		case strings.HasPrefix(f, "def="):
 			p.HasDefault = true
 			p.Default = f[4:] // rest of string
@@ -0,0 +1,200 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Redaction of sensitive fields.
+ */
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces the values of sensitive fields, those marked with
+// the network.api.sensitive option, in the text format, in jsonpb output
+// with Marshaler.RedactSensitive set, and in messages returned by CloneRedacted.
+const RedactedValue = "[REDACTED]"
+
+// CloneRedacted returns a deep copy of pb with its sensitive fields redacted,
+// suitable for logging or exporting in any format.
+// Sensitive string and bytes fields, and the elements of repeated ones,
+// are set to RedactedValue; other sensitive fields are cleared.
+// Messages held in google.protobuf.Any fields are redacted too if their
+// type is registered. Extensions are redacted like regular fields if their
+// descriptors are known; others are copied unchanged.
+func CloneRedacted(pb Message) Message {
+	c := Clone(pb)
+	v := reflect.ValueOf(c)
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+		redactStruct(v.Elem())
+	}
+	return c
+}
+
+// redactStruct redacts the sensitive fields of the message struct v in place.
+func redactStruct(v reflect.Value) {
+	if isAny(v) {
+		redactAny(v)
+		return
+	}
+	sprop := GetProperties(v.Type())
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		fv := v.Field(i)
+		if f.Type.Kind() == reflect.Interface {
+			// A oneof field.
+			if fv.IsNil() {
+				continue
+			}
+			c, p := oneofCase(fv.Elem())
+			if p.Sensitive && !redactValue(c) {
+				fv.Set(reflect.Zero(fv.Type()))
+				continue
+			}
+			redactNested(c)
+			continue
+		}
+		if sprop.Prop[i].Sensitive {
+			if !redactValue(fv) {
+				fv.Set(reflect.Zero(fv.Type()))
+			}
+			continue
+		}
+		redactNested(fv)
+	}
+	if _, err := extendable(v.Addr().Interface()); err == nil {
+		redactExtensions(v.Addr().Interface().(Message))
+	}
+}
+
+// redactExtensions redacts the sensitive extensions of pb in place,
+// and the messages held in its other extensions.
+func redactExtensions(pb Message) {
+	descs, _ := ExtensionDescs(pb)
+	for _, desc := range descs {
+		if desc.ExtensionType == nil {
+			continue // unknown extension
+		}
+		var p Properties
+		p.Parse(desc.Tag)
+		ev, err := GetExtension(pb, desc)
+		if err != nil {
+			continue
+		}
+		// A settable copy of the value, which holds the same pointers.
+		v := reflect.New(reflect.TypeOf(ev)).Elem()
+		v.Set(reflect.ValueOf(ev))
+		if !p.Sensitive {
+			redactNested(v)
+			continue
+		}
+		if !redactValue(v) {
+			ClearExtension(pb, desc)
+			continue
+		}
+		SetExtension(pb, desc, v.Interface())
+	}
+}
+
+// redactValue sets the string or bytes field v, or the elements of a
+// repeated one, to RedactedValue unless they are empty.
+// It reports false if v holds some other type.
+func redactValue(v reflect.Value) bool {
+	t := v.Type()
+	switch {
+	case t.Kind() == reflect.String:
+		if v.Len() > 0 {
+			v.SetString(RedactedValue)
+		}
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.String:
+		if !v.IsNil() {
+			v.Set(reflect.ValueOf(String(RedactedValue)))
+		}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		if v.Len() > 0 {
+			v.SetBytes([]byte(RedactedValue))
+		}
+	case t.Kind() == reflect.Slice && (t.Elem().Kind() == reflect.String ||
+		t.Elem().Kind() == reflect.Slice && t.Elem().Elem().Kind() == reflect.Uint8):
+		for i := 0; i < v.Len(); i++ {
+			redactValue(v.Index(i))
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// redactNested redacts the messages held in the non-sensitive field v.
+func redactNested(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			redactStruct(v.Elem())
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Ptr {
+			for i := 0; i < v.Len(); i++ {
+				redactNested(v.Index(i))
+			}
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() == reflect.Ptr {
+			for _, k := range v.MapKeys() {
+				redactNested(v.MapIndex(k))
+			}
+		}
+	}
+}
+
+// redactAny redacts the message held in the google.protobuf.Any struct v,
+// if its type is registered.
+func redactAny(v reflect.Value) {
+	turl := v.FieldByName("TypeUrl").String()
+	val := v.FieldByName("Value")
+	mt := MessageType(turl[strings.LastIndex(turl, "/")+1:])
+	if mt == nil {
+		return
+	}
+	m := reflect.New(mt.Elem()).Interface().(Message)
+	if err := Unmarshal(val.Bytes(), m); err != nil {
+		return
+	}
+	redactStruct(reflect.ValueOf(m).Elem())
+	if b, err := Marshal(m); err == nil {
+		val.SetBytes(b)
+	}
+}
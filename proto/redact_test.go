@@ -0,0 +1,203 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// secretMessage has fields marked with the network.api.sensitive option,
+// as protoc-gen-go records it in their struct tags.
+type secretMessage struct {
+	User     *string           `protobuf:"bytes,1,opt,name=user"`
+	Password *string           `protobuf:"bytes,2,opt,name=password,sensitive"`
+	Pin      *int32            `protobuf:"varint,3,opt,name=pin,sensitive"`
+	Tokens   []string          `protobuf:"bytes,4,rep,name=tokens,sensitive"`
+	Keys     map[string]string `protobuf:"bytes,5,rep,name=keys,sensitive" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Inner    *secretMessage    `protobuf:"bytes,6,opt,name=inner"`
+	Anything *any.Any          `protobuf:"bytes,7,opt,name=anything"`
+
+	XXX_InternalExtensions
+}
+
+func (*secretMessage) ExtensionRangeArray() []ExtensionRange {
+	return []ExtensionRange{{Start: 100, End: 199}}
+}
+
+// Extensions of secretMessage, which are set with their descriptors
+// rather than registered.
+var (
+	secretExt = &ExtensionDesc{
+		ExtendedType:  (*secretMessage)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         100,
+		Name:          "proto_test.secret",
+		Tag:           "bytes,100,opt,name=secret,sensitive",
+	}
+	secretPinExt = &ExtensionDesc{
+		ExtendedType:  (*secretMessage)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         101,
+		Name:          "proto_test.secret_pin",
+		Tag:           "varint,101,opt,name=secret_pin,sensitive",
+	}
+	innerExt = &ExtensionDesc{
+		ExtendedType:  (*secretMessage)(nil),
+		ExtensionType: (*secretMessage)(nil),
+		Field:         102,
+		Name:          "proto_test.inner",
+		Tag:           "bytes,102,opt,name=inner",
+	}
+)
+
+func (m *secretMessage) Reset()         { *m = secretMessage{} }
+func (m *secretMessage) String() string { return CompactTextString(m) }
+func (*secretMessage) ProtoMessage()    {}
+
+func init() {
+	RegisterType((*secretMessage)(nil), "proto_test.SecretMessage")
+}
+
+func newSecretMessage(t *testing.T) *secretMessage {
+	b, err := Marshal(&secretMessage{User: String("u"), Password: String("hunter2")})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	return &secretMessage{
+		User:     String("gopher"),
+		Password: String("hunter2"),
+		Pin:      Int32(1234),
+		Tokens:   []string{"t1", "t2"},
+		Keys:     map[string]string{"k": "v"},
+		Inner:    &secretMessage{Password: String("hunter3")},
+		Anything: &any.Any{TypeUrl: "type.googleapis.com/proto_test.SecretMessage", Value: b},
+	}
+}
+
+func TestRedactText(t *testing.T) {
+	m := newSecretMessage(t)
+	tm := TextMarshaler{Compact: true, ExpandAny: true}
+	got := tm.Text(m)
+	want := `user:"gopher" password:"[REDACTED]" pin:"[REDACTED]" tokens:"[REDACTED]" tokens:"[REDACTED]" ` +
+		`keys:"[REDACTED]" inner:<password:"[REDACTED]" > ` +
+		`anything:<[type.googleapis.com/proto_test.SecretMessage]:<user:"u" password:"[REDACTED]" > > `
+	if got != want {
+		t.Errorf("redacted text:\ngot  %s\nwant %s", got, want)
+	}
+	// String doesn't expand Any messages, so only the outer message is redacted.
+	if s := (&secretMessage{Password: String("hunter2"), Pin: Int32(1234)}).String(); strings.Contains(s, "hunter2") || strings.Contains(s, "1234") {
+		t.Errorf("String() shows sensitive fields: %s", s)
+	}
+
+	tm.ShowSensitive = true
+	if s := tm.Text(m); !strings.Contains(s, `password:"hunter2"`) || !strings.Contains(s, `keys:<key:"k" value:"v" >`) {
+		t.Errorf("ShowSensitive: sensitive fields missing from %s", s)
+	}
+}
+
+func TestCloneRedacted(t *testing.T) {
+	m := newSecretMessage(t)
+	orig := Clone(m)
+	c := CloneRedacted(m).(*secretMessage)
+	if !Equal(m, orig) {
+		t.Errorf("CloneRedacted modified its argument: %v", m)
+	}
+	if got := c.GetUser(); got != "gopher" {
+		t.Errorf("user = %q, want %q", got, "gopher")
+	}
+	if got := *c.Password; got != RedactedValue {
+		t.Errorf("password = %q, want %q", got, RedactedValue)
+	}
+	if c.Pin != nil || c.Keys != nil {
+		t.Errorf("pin = %v, keys = %v, want both cleared", c.Pin, c.Keys)
+	}
+	if len(c.Tokens) != 2 || c.Tokens[0] != RedactedValue || c.Tokens[1] != RedactedValue {
+		t.Errorf("tokens = %q, want redacted", c.Tokens)
+	}
+	if got := *c.Inner.Password; got != RedactedValue {
+		t.Errorf("inner.password = %q, want %q", got, RedactedValue)
+	}
+	inAny := new(secretMessage)
+	if err := Unmarshal(c.Anything.Value, inAny); err != nil {
+		t.Fatalf("Unmarshal Any value: %v", err)
+	}
+	if inAny.GetUser() != "u" || inAny.GetPassword() != RedactedValue {
+		t.Errorf("message in Any = %v, want password redacted", inAny)
+	}
+
+	// Extensions are redacted by their descriptors.
+	m = &secretMessage{User: String("gopher")}
+	for desc, v := range map[*ExtensionDesc]interface{}{
+		secretExt:    String("hunter2"),
+		secretPinExt: Int32(1234),
+		innerExt:     &secretMessage{Password: String("hunter3")},
+	} {
+		if err := SetExtension(m, desc, v); err != nil {
+			t.Fatalf("SetExtension(%s): %v", desc.Name, err)
+		}
+	}
+	c = CloneRedacted(m).(*secretMessage)
+	if v, err := GetExtension(c, secretExt); err != nil || *v.(*string) != RedactedValue {
+		t.Errorf("secret extension = %v, %v; want %q", v, err, RedactedValue)
+	}
+	if HasExtension(c, secretPinExt) {
+		t.Errorf("secret_pin extension not cleared")
+	}
+	if v, err := GetExtension(c, innerExt); err != nil || v.(*secretMessage).GetPassword() != RedactedValue {
+		t.Errorf("inner extension = %v, %v; want password redacted", v, err)
+	}
+	if v, _ := GetExtension(m, secretExt); *v.(*string) != "hunter2" {
+		t.Errorf("CloneRedacted modified the extensions of its argument")
+	}
+
+	if got := CloneRedacted((*secretMessage)(nil)); got != (*secretMessage)(nil) {
+		t.Errorf("CloneRedacted(nil) = %v", got)
+	}
+}
+
+func (m *secretMessage) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+func (m *secretMessage) GetPassword() string {
+	if m != nil && m.Password != nil {
+		return *m.Password
+	}
+	return ""
+}
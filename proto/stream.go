@@ -0,0 +1,147 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Routines for reading and writing streams of length-delimited messages.
+ */
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// DefaultMaxDelimitedSize is the largest message a DelimitedReader
+// will accept unless told otherwise.
+const DefaultMaxDelimitedSize = 64 << 20
+
+// ErrMessageTooLarge is returned by DelimitedReader.ReadMessage when the
+// length prefix of the next message exceeds the reader's maximum size.
+var ErrMessageTooLarge = errors.New("proto: delimited message exceeds maximum size")
+
+// A DelimitedReader reads a stream of messages, each prefixed by its
+// varint-encoded length, as written by DelimitedWriter or Buffer.EncodeMessage.
+// The internal buffer is reused between messages.
+type DelimitedReader struct {
+	r       *bufio.Reader
+	buf     []byte
+	maxSize int
+}
+
+// NewDelimitedReader returns a DelimitedReader reading from r.
+// Messages larger than maxSize bytes are rejected with ErrMessageTooLarge;
+// if maxSize <= 0, DefaultMaxDelimitedSize is used.
+func NewDelimitedReader(r io.Reader, maxSize int) *DelimitedReader {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxDelimitedSize
+	}
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &DelimitedReader{r: br, maxSize: maxSize}
+}
+
+// ReadMessage reads the next message from the stream and unmarshals it into pb,
+// which is reset first.
+// It returns io.EOF if the stream ends cleanly before the next message,
+// and io.ErrUnexpectedEOF if it ends in the middle of one.
+func (d *DelimitedReader) ReadMessage(pb Message) error {
+	n, err := d.readVarint()
+	if err != nil {
+		return err
+	}
+	if n > uint64(d.maxSize) {
+		return ErrMessageTooLarge
+	}
+	if cap(d.buf) < int(n) {
+		d.buf = make([]byte, n)
+	}
+	d.buf = d.buf[:n]
+	if _, err := io.ReadFull(d.r, d.buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return Unmarshal(d.buf, pb)
+}
+
+// readVarint reads the varint length prefix of the next message.
+func (d *DelimitedReader) readVarint() (uint64, error) {
+	var x uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := d.r.ReadByte()
+		if err != nil {
+			if err == io.EOF && shift > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		x |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return x, nil
+		}
+	}
+	return 0, errOverflow
+}
+
+// A DelimitedWriter writes a stream of messages, each prefixed by its
+// varint-encoded length. Each message is written with a single call to
+// the underlying io.Writer; wrap it in a bufio.Writer to batch writes.
+// The internal buffer is reused between messages.
+type DelimitedWriter struct {
+	w   io.Writer
+	buf Buffer
+}
+
+// NewDelimitedWriter returns a DelimitedWriter writing to w.
+func NewDelimitedWriter(w io.Writer) *DelimitedWriter {
+	return &DelimitedWriter{w: w}
+}
+
+// SetDeterministic sets whether to use deterministic serialization
+// for subsequent messages. See Buffer.SetDeterministic.
+func (d *DelimitedWriter) SetDeterministic(deterministic bool) {
+	d.buf.SetDeterministic(deterministic)
+}
+
+// WriteMessage marshals pb and writes it to the stream, prefixed by its length.
+func (d *DelimitedWriter) WriteMessage(pb Message) error {
+	d.buf.Reset()
+	if err := d.buf.EncodeMessage(pb); err != nil {
+		return err
+	}
+	_, err := d.w.Write(d.buf.Bytes())
+	return err
+}
@@ -0,0 +1,116 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+)
+
+func TestDelimitedRoundTrip(t *testing.T) {
+	msgs := []*pb.GoTest{initGoTest(true), initGoTest(false), initGoTest(false)}
+	msgs[2].Kind = pb.GoTest_TIME.Enum()
+	msgs[2].RepeatedField = []*pb.GoTestField{initGoTestField(), initGoTestField()}
+	var b bytes.Buffer
+	w := proto.NewDelimitedWriter(&b)
+	w.SetDeterministic(true)
+	for _, m := range msgs {
+		if err := w.WriteMessage(m); err != nil {
+			t.Fatalf("WriteMessage: %v", err)
+		}
+	}
+
+	r := proto.NewDelimitedReader(&b, 0)
+	for i, want := range msgs {
+		got := new(pb.GoTest)
+		if err := r.ReadMessage(got); err != nil {
+			t.Fatalf("ReadMessage #%d: %v", i, err)
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("ReadMessage #%d:\n got %v\nwant %v", i, got, want)
+		}
+	}
+	if err := r.ReadMessage(new(pb.GoTest)); err != io.EOF {
+		t.Errorf("ReadMessage at end: got %v, want io.EOF", err)
+	}
+}
+
+func TestDelimitedCompatibleWithBuffer(t *testing.T) {
+	m := &pb.Strings{StringField: proto.String("hello"), BytesField: []byte("world")}
+	b := proto.NewBuffer(nil)
+	if err := b.EncodeMessage(m); err != nil {
+		t.Fatal(err)
+	}
+	got := new(pb.Strings)
+	if err := proto.NewDelimitedReader(bytes.NewReader(b.Bytes()), 0).ReadMessage(got); err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("got %v, want %v", got, m)
+	}
+
+	var out bytes.Buffer
+	if err := proto.NewDelimitedWriter(&out).WriteMessage(m); err != nil {
+		t.Fatalf("WriteMessage: %v", err)
+	}
+	got.Reset()
+	if err := proto.NewBuffer(out.Bytes()).DecodeMessage(got); err != nil {
+		t.Fatalf("DecodeMessage: %v", err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("got %v, want %v", got, m)
+	}
+}
+
+func TestDelimitedReaderErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		in      []byte
+		maxSize int
+		want    error
+	}{
+		{"empty", nil, 0, io.EOF},
+		{"truncated length", []byte{0x80}, 0, io.ErrUnexpectedEOF},
+		{"truncated message", []byte{0x05, 0x08, 0x01}, 0, io.ErrUnexpectedEOF},
+		{"too large", []byte{0x05, 0x08, 0x01, 0x10, 0x02, 0x18}, 4, proto.ErrMessageTooLarge},
+	}
+	for _, tt := range tests {
+		r := proto.NewDelimitedReader(bytes.NewReader(tt.in), tt.maxSize)
+		if err := r.ReadMessage(new(pb.GoTest)); err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.desc, err, tt.want)
+		}
+	}
+}
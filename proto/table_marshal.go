@@ -2689,6 +2689,44 @@ func Marshal(pb Message) ([]byte, error) {
 	return info.Marshal(b, pb, false)
 }
 
+// MarshalAppend encodes pb into the wire format and appends the result to b,
+// returning the extended slice. Passing a reused slice, such as a pooled frame
+// buffer truncated to its header, avoids allocating a new slice per message.
+func MarshalAppend(b []byte, pb Message) ([]byte, error) {
+	return marshalAppend(b, pb, Size(pb))
+}
+
+// MarshalAppendSize is like MarshalAppend, but takes the encoded size of pb,
+// which the caller needed already, for example to write a length prefix,
+// and grows b once to hold it. siz must be the result of calling Size on pb
+// after its last modification: the encoder relies on the sizes cached by
+// that call, and pb is not sized again.
+func MarshalAppendSize(b []byte, pb Message, siz int) ([]byte, error) {
+	return marshalAppend(b, pb, siz)
+}
+
+// marshalAppend appends the encoding of pb to b, after reserving siz bytes.
+// siz must be the result of calling Size on pb after its last modification.
+func marshalAppend(b []byte, pb Message, siz int) ([]byte, error) {
+	if m, ok := pb.(newMarshaler); ok {
+		b = growSlice(b, siz)
+		return m.XXX_Marshal(b, false)
+	}
+	if m, ok := pb.(Marshaler); ok {
+		// If the message can marshal itself, let it do it, for compatibility.
+		// NOTE: This is not efficient.
+		b1, err := m.Marshal()
+		return append(b, b1...), err
+	}
+	// in case somehow we didn't generate the wrapper
+	if pb == nil {
+		return b, ErrNil
+	}
+	var info InternalMessageInfo
+	b = growSlice(b, siz)
+	return info.Marshal(b, pb, false)
+}
+
 // Marshal takes a protocol buffer message
 // and encodes it into the wire format, writing the result to the
 // Buffer.
@@ -2724,13 +2762,19 @@ func (p *Buffer) Marshal(pb Message) error {
 // another n bytes. After grow(n), at least n bytes can be written to the
 // buffer without another allocation.
 func (p *Buffer) grow(n int) {
-	need := len(p.buf) + n
-	if need <= cap(p.buf) {
-		return
+	p.buf = growSlice(p.buf, n)
+}
+
+// growSlice returns b with its capacity grown, if necessary, to guarantee
+// space for another n bytes.
+func growSlice(b []byte, n int) []byte {
+	need := len(b) + n
+	if need <= cap(b) {
+		return b
 	}
-	newCap := len(p.buf) * 2
+	newCap := len(b) * 2
 	if newCap < need {
 		newCap = need
 	}
-	p.buf = append(make([]byte, 0, newCap), p.buf...)
+	return append(make([]byte, 0, newCap), b...)
 }
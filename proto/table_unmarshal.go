@@ -65,7 +65,8 @@ func (a *InternalMessageInfo) Unmarshal(msg Message, b []byte) error {
 }
 
 type unmarshalInfo struct {
-	typ reflect.Type // type of the protobuf struct
+	typ      reflect.Type // type of the protobuf struct
+	aliasing bool         // whether bytes fields alias the input buffer
 
 	// 0 = only typ field is initialized
 	// 1 = completely initialized
@@ -102,8 +103,9 @@ type unmarshalFieldInfo struct {
 }
 
 var (
-	unmarshalInfoMap  = map[reflect.Type]*unmarshalInfo{}
-	unmarshalInfoLock sync.Mutex
+	unmarshalInfoMap         = map[reflect.Type]*unmarshalInfo{}
+	aliasingUnmarshalInfoMap = map[reflect.Type]*unmarshalInfo{}
+	unmarshalInfoLock        sync.Mutex
 )
 
 // getUnmarshalInfo returns the data structure which can be
@@ -126,6 +128,21 @@ func getUnmarshalInfo(t reflect.Type) *unmarshalInfo {
 	return u
 }
 
+// getAliasingUnmarshalInfo is like getUnmarshalInfo, but the returned
+// unmarshaler makes bytes fields alias the input buffer
+// instead of copying it. Such unmarshalers are cached separately,
+// since the choice is made when the field unmarshalers are computed.
+func getAliasingUnmarshalInfo(t reflect.Type) *unmarshalInfo {
+	unmarshalInfoLock.Lock()
+	defer unmarshalInfoLock.Unlock()
+	u := aliasingUnmarshalInfoMap[t]
+	if u == nil {
+		u = &unmarshalInfo{typ: t, aliasing: true}
+		aliasingUnmarshalInfoMap[t] = u
+	}
+	return u
+}
+
 // unmarshal does the main work of unmarshaling a message.
 // u provides type information used to unmarshal the message.
 // m is a pointer to a protocol buffer message.
@@ -343,7 +360,7 @@ func (u *unmarshalInfo) computeUnmarshalInfo() {
 		}
 
 		// Extract unmarshaling function from the field (its type and tags).
-		unmarshal := fieldUnmarshaler(&f)
+		unmarshal := fieldUnmarshaler(&f, u.aliasing)
 
 		// Required field?
 		var reqMask uint64
@@ -371,7 +388,7 @@ func (u *unmarshalInfo) computeUnmarshalInfo() {
 			typ := tptr.Elem()                            // Msg_X
 
 			f := typ.Field(0) // oneof implementers have one field
-			baseUnmarshal := fieldUnmarshaler(&f)
+			baseUnmarshal := fieldUnmarshaler(&f, u.aliasing)
 			tags := strings.Split(f.Tag.Get("protobuf"), ",")
 			fieldNum, err := strconv.Atoi(tags[1])
 			if err != nil {
@@ -444,15 +461,17 @@ func (u *unmarshalInfo) setTag(tag int, field field, unmarshal unmarshaler, reqM
 }
 
 // fieldUnmarshaler returns an unmarshaler for the given field.
-func fieldUnmarshaler(f *reflect.StructField) unmarshaler {
+// If aliasing is set, bytes fields alias the input buffer.
+func fieldUnmarshaler(f *reflect.StructField, aliasing bool) unmarshaler {
 	if f.Type.Kind() == reflect.Map {
-		return makeUnmarshalMap(f)
+		return makeUnmarshalMap(f, aliasing)
 	}
-	return typeUnmarshaler(f.Type, f.Tag.Get("protobuf"))
+	return typeUnmarshaler(f.Type, f.Tag.Get("protobuf"), aliasing)
 }
 
 // typeUnmarshaler returns an unmarshaler for the given field type / field tag pair.
-func typeUnmarshaler(t reflect.Type, tags string) unmarshaler {
+// If aliasing is set, bytes fields alias the input buffer.
+func typeUnmarshaler(t reflect.Type, tags string, aliasing bool) unmarshaler {
 	tagArray := strings.Split(tags, ",")
 	encoding := tagArray[0]
 	name := "unknown"
@@ -609,6 +628,12 @@ func typeUnmarshaler(t reflect.Type, tags string) unmarshaler {
 		if pointer {
 			panic("bad pointer in slice case in " + t.Name())
 		}
+		if aliasing {
+			if slice {
+				return unmarshalAliasBytesSlice
+			}
+			return unmarshalAliasBytesValue
+		}
 		if slice {
 			return unmarshalBytesSlice
 		}
@@ -635,17 +660,21 @@ func typeUnmarshaler(t reflect.Type, tags string) unmarshaler {
 		if !pointer {
 			panic(fmt.Sprintf("message/group field %s:%s without pointer", t, encoding))
 		}
+		sub := getUnmarshalInfo(t)
+		if aliasing {
+			sub = getAliasingUnmarshalInfo(t)
+		}
 		switch encoding {
 		case "bytes":
 			if slice {
-				return makeUnmarshalMessageSlicePtr(getUnmarshalInfo(t), name)
+				return makeUnmarshalMessageSlicePtr(sub, name)
 			}
-			return makeUnmarshalMessagePtr(getUnmarshalInfo(t), name)
+			return makeUnmarshalMessagePtr(sub, name)
 		case "group":
 			if slice {
-				return makeUnmarshalGroupSlicePtr(getUnmarshalInfo(t), name)
+				return makeUnmarshalGroupSlicePtr(sub, name)
 			}
-			return makeUnmarshalGroupPtr(getUnmarshalInfo(t), name)
+			return makeUnmarshalGroupPtr(sub, name)
 		}
 	}
 	panic(fmt.Sprintf("unmarshaler not found type:%s encoding:%s", t, encoding))
@@ -1618,6 +1647,45 @@ func unmarshalBytesSlice(b []byte, f pointer, w int) ([]byte, error) {
 	return b[x:], nil
 }
 
+// unmarshalAliasBytesValue is like unmarshalBytesValue,
+// but the field refers to the input buffer instead of a copy.
+func unmarshalAliasBytesValue(b []byte, f pointer, w int) ([]byte, error) {
+	if w != WireBytes {
+		return b, errInternalBadWireType
+	}
+	x, n := decodeVarint(b)
+	if n == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b = b[n:]
+	if x > uint64(len(b)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	// Limit the capacity, so appending to the field can't
+	// overwrite the rest of the input.
+	*f.toBytes() = b[:x:x]
+	return b[x:], nil
+}
+
+// unmarshalAliasBytesSlice is like unmarshalBytesSlice,
+// but the elements refer to the input buffer instead of copies.
+func unmarshalAliasBytesSlice(b []byte, f pointer, w int) ([]byte, error) {
+	if w != WireBytes {
+		return b, errInternalBadWireType
+	}
+	x, n := decodeVarint(b)
+	if n == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b = b[n:]
+	if x > uint64(len(b)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	s := f.toBytesSlice()
+	*s = append(*s, b[:x:x])
+	return b[x:], nil
+}
+
 func makeUnmarshalMessagePtr(sub *unmarshalInfo, name string) unmarshaler {
 	return func(b []byte, f pointer, w int) ([]byte, error) {
 		if w != WireBytes {
@@ -1728,12 +1796,12 @@ func makeUnmarshalGroupSlicePtr(sub *unmarshalInfo, name string) unmarshaler {
 	}
 }
 
-func makeUnmarshalMap(f *reflect.StructField) unmarshaler {
+func makeUnmarshalMap(f *reflect.StructField, aliasing bool) unmarshaler {
 	t := f.Type
 	kt := t.Key()
 	vt := t.Elem()
-	unmarshalKey := typeUnmarshaler(kt, f.Tag.Get("protobuf_key"))
-	unmarshalVal := typeUnmarshaler(vt, f.Tag.Get("protobuf_val"))
+	unmarshalKey := typeUnmarshaler(kt, f.Tag.Get("protobuf_key"), aliasing)
+	unmarshalVal := typeUnmarshaler(vt, f.Tag.Get("protobuf_val"), aliasing)
 	return func(b []byte, f pointer, w int) ([]byte, error) {
 		// The map entry is a submessage. Figure out how big it is.
 		if w != WireBytes {
@@ -44,7 +44,9 @@ import (
 	"math"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 var (
@@ -72,6 +74,12 @@ type textWriter struct {
 	complete bool // if the current position is a complete line
 	compact  bool // whether to write out as a one-liner
 	w        writer
+	col      int    // the column of the current position, if not compact
+	comment  string // a comment to write at the end of the current line
+
+	width         int  // the line width to keep strings within, if not zero
+	hexBytes      bool // whether to escape bytes fields in hex
+	decodeUnknown bool // whether to decode unknown fields that hold messages
 }
 
 func (w *textWriter) WriteString(s string) (n int, err error) {
@@ -80,6 +88,7 @@ func (w *textWriter) WriteString(s string) (n int, err error) {
 			w.writeIndent()
 		}
 		w.complete = false
+		w.col += len(s)
 		return io.WriteString(w.w, s)
 	}
 	// WriteString is typically called without newlines, so this
@@ -96,6 +105,7 @@ func (w *textWriter) Write(p []byte) (n int, err error) {
 		}
 		n, err = w.w.Write(p)
 		w.complete = false
+		w.col += n
 		return n, err
 	}
 
@@ -123,10 +133,15 @@ func (w *textWriter) Write(p []byte) (n int, err error) {
 		}
 		nn, err := w.w.Write(frag)
 		n += nn
+		w.col += nn
 		if err != nil {
 			return n, err
 		}
 		if i+1 < len(frags) {
+			if err := w.writeComment(); err != nil {
+				return n, err
+			}
+			w.col = 0
 			if err := w.w.WriteByte('\n'); err != nil {
 				return n, err
 			}
@@ -144,11 +159,29 @@ func (w *textWriter) WriteByte(c byte) error {
 	if !w.compact && w.complete {
 		w.writeIndent()
 	}
+	if c == '\n' {
+		if err := w.writeComment(); err != nil {
+			return err
+		}
+		w.col = 0
+	} else {
+		w.col++
+	}
 	err := w.w.WriteByte(c)
 	w.complete = c == '\n'
 	return err
 }
 
+// writeComment writes the comment for the end of the current line, if any.
+func (w *textWriter) writeComment() error {
+	if w.comment == "" {
+		return nil
+	}
+	_, err := io.WriteString(w.w, w.comment)
+	w.comment = ""
+	return err
+}
+
 func (w *textWriter) indent() { w.ind++ }
 
 func (w *textWriter) unindent() {
@@ -286,7 +319,7 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 			// isn't anything we can show for it.
 			continue
 		}
-		if fv.Kind() == reflect.Slice && fv.IsNil() {
+		if fv.Kind() == reflect.Slice && fv.IsNil() && !tm.emitsDefault(fv, props) {
 			// Repeated field that is empty, or a bytes field that is unused.
 			continue
 		}
@@ -297,6 +330,7 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 				if err := writeName(w, props); err != nil {
 					return err
 				}
+				tm.noteFieldNumber(w, props.Tag)
 				if !w.compact {
 					if err := w.WriteByte(' '); err != nil {
 						return err
@@ -329,11 +363,22 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 				if err := writeName(w, props); err != nil {
 					return err
 				}
+				tm.noteFieldNumber(w, props.Tag)
 				if !w.compact {
 					if err := w.WriteByte(' '); err != nil {
 						return err
 					}
 				}
+				if tm.redacts(props) {
+					// Keys may be as sensitive as values.
+					if err := writeString(w, RedactedValue); err != nil {
+						return err
+					}
+					if err := w.WriteByte('\n'); err != nil {
+						return err
+					}
+					continue
+				}
 				// open struct
 				if err := w.WriteByte('<'); err != nil {
 					return err
@@ -388,13 +433,13 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 			}
 			continue
 		}
-		if props.proto3 && fv.Kind() == reflect.Slice && fv.Len() == 0 {
+		if props.proto3 && fv.Kind() == reflect.Slice && fv.Len() == 0 && !tm.emitsDefault(fv, props) {
 			// empty bytes field
 			continue
 		}
 		if fv.Kind() != reflect.Ptr && fv.Kind() != reflect.Slice {
 			// proto3 non-repeated scalar field; skip if zero value
-			if isProto3Zero(fv) {
+			if isProto3Zero(fv) && !tm.emitsDefault(fv, props) {
 				continue
 			}
 		}
@@ -429,6 +474,7 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 		if err := writeName(w, props); err != nil {
 			return err
 		}
+		tm.noteFieldNumber(w, props.Tag)
 		if !w.compact {
 			if err := w.WriteByte(' '); err != nil {
 				return err
@@ -456,8 +502,38 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 	return nil
 }
 
+// emitsDefault reports whether the field fv with properties props is printed
+// when it holds its default value, which is so for proto3 scalar fields
+// if EmitDefaults is set.
+func (tm *TextMarshaler) emitsDefault(fv reflect.Value, props *Properties) bool {
+	if !tm.EmitDefaults || !props.proto3 || props.Repeated {
+		return false
+	}
+	switch fv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Interface:
+		return false
+	}
+	return true
+}
+
+// noteFieldNumber arranges for the number n of the field being written
+// to follow it in a comment, if FieldNumbers is set.
+func (tm *TextMarshaler) noteFieldNumber(w *textWriter, n int) {
+	if tm.FieldNumbers && !w.compact {
+		w.comment = " # " + strconv.Itoa(n)
+	}
+}
+
+// redacts reports whether the field with properties props is printed as RedactedValue.
+func (tm *TextMarshaler) redacts(props *Properties) bool {
+	return props != nil && props.Sensitive && !tm.ShowSensitive
+}
+
 // writeAny writes an arbitrary field.
 func (tm *TextMarshaler) writeAny(w *textWriter, v reflect.Value, props *Properties) error {
+	if tm.redacts(props) {
+		return writeString(w, RedactedValue)
+	}
 	v = reflect.Indirect(v)
 
 	// Floats have special cases.
@@ -484,7 +560,7 @@ func (tm *TextMarshaler) writeAny(w *textWriter, v reflect.Value, props *Propert
 	switch v.Kind() {
 	case reflect.Slice:
 		// Should only be a []byte; repeated fields are handled in writeStruct.
-		if err := writeString(w, string(v.Bytes())); err != nil {
+		if err := writeQuoted(w, string(v.Bytes()), w.hexBytes); err != nil {
 			return err
 		}
 	case reflect.String:
@@ -557,47 +633,87 @@ func isprint(c byte) bool {
 // These differences are to maintain interoperability with the other
 // languages' implementations of the text format.
 func writeString(w *textWriter, s string) error {
+	return writeQuoted(w, s, false)
+}
+
+// writeQuoted is like writeString, but uses hex escapes instead of octal
+// ones if hex is set. If the writer has a width, a string that would not
+// fit on the line is split into several on the following lines, which
+// the parser joins back together.
+func writeQuoted(w *textWriter, s string, hex bool) error {
 	// use WriteByte here to get any needed indent
 	if err := w.WriteByte('"'); err != nil {
 		return err
 	}
+	wrap := w.width > 0 && !w.compact
+	var esc [4]byte
+	n := 0 // the length of the current line of the string
 	// Loop over the bytes, not the runes.
 	for i := 0; i < len(s); i++ {
-		var err error
-		// Divergence from C++: we don't escape apostrophes.
-		// There's no need to escape them, and the C++ parser
-		// copes with a naked apostrophe.
-		switch c := s[i]; c {
-		case '\n':
-			_, err = w.w.Write(backslashN)
-		case '\r':
-			_, err = w.w.Write(backslashR)
-		case '\t':
-			_, err = w.w.Write(backslashT)
-		case '"':
-			_, err = w.w.Write(backslashDQ)
-		case '\\':
-			_, err = w.w.Write(backslashBS)
-		default:
-			if isprint(c) {
-				err = w.w.WriteByte(c)
-			} else {
-				_, err = fmt.Fprintf(w.w, "\\%03o", c)
+		e := appendEscaped(esc[:0], s[i], hex)
+		if wrap && n > 0 && w.col+n+len(e)+1 > w.width {
+			// Close the string and continue on the next line.
+			w.col += n
+			if err := w.WriteByte('"'); err != nil {
+				return err
 			}
+			w.indent()
+			if err := w.WriteByte('\n'); err != nil {
+				return err
+			}
+			if err := w.WriteByte('"'); err != nil {
+				return err
+			}
+			w.unindent()
+			n = 0
 		}
-		if err != nil {
+		if _, err := w.w.Write(e); err != nil {
 			return err
 		}
+		n += len(e)
 	}
+	w.col += n
 	return w.WriteByte('"')
 }
 
+// appendEscaped appends the byte c to b as it appears in a quoted string.
+func appendEscaped(b []byte, c byte, hex bool) []byte {
+	// Divergence from C++: we don't escape apostrophes.
+	// There's no need to escape them, and the C++ parser
+	// copes with a naked apostrophe.
+	switch c {
+	case '\n':
+		return append(b, backslashN...)
+	case '\r':
+		return append(b, backslashR...)
+	case '\t':
+		return append(b, backslashT...)
+	case '"':
+		return append(b, backslashDQ...)
+	case '\\':
+		return append(b, backslashBS...)
+	}
+	if isprint(c) {
+		return append(b, c)
+	}
+	const digits = "0123456789abcdef"
+	if hex {
+		return append(b, '\\', 'x', digits[c>>4], digits[c&0xf])
+	}
+	return append(b, '\\', '0'+c>>6, '0'+(c>>3)&7, '0'+c&7)
+}
+
 func writeUnknownStruct(w *textWriter, data []byte) (err error) {
 	if !w.compact {
 		if _, err := fmt.Fprintf(w, "/* %d unknown bytes */\n", len(data)); err != nil {
 			return err
 		}
 	}
+	return writeUnknownFields(w, data)
+}
+
+// writeUnknownFields writes the fields encoded in data, by number.
+func writeUnknownFields(w *textWriter, data []byte) (err error) {
 	b := NewBuffer(data)
 	for b.index < len(b.buf) {
 		x, err := b.DecodeVarint()
@@ -629,10 +745,15 @@ func writeUnknownStruct(w *textWriter, data []byte) (err error) {
 		switch wire {
 		case WireBytes:
 			buf, e := b.DecodeRawBytes(false)
-			if e == nil {
-				_, err = fmt.Fprintf(w, "%q", buf)
-			} else {
+			switch {
+			case e != nil:
 				_, err = fmt.Fprintf(w, "/* %v */", e)
+			case w.decodeUnknown && isWireMessage(buf):
+				err = writeUnknownMessage(w, buf)
+			case w.decodeUnknown && utf8.Valid(buf):
+				err = writeString(w, string(buf))
+			default:
+				_, err = fmt.Fprintf(w, "%q", buf)
 			}
 		case WireFixed32:
 			x, err = b.DecodeFixed32()
@@ -659,6 +780,68 @@ func writeUnknownStruct(w *textWriter, data []byte) (err error) {
 	return nil
 }
 
+// writeUnknownMessage writes an unknown field that holds the message data.
+func writeUnknownMessage(w *textWriter, data []byte) error {
+	if err := w.WriteByte('<'); err != nil {
+		return err
+	}
+	if !w.compact {
+		if err := w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	w.indent()
+	if err := writeUnknownFields(w, data); err != nil {
+		return err
+	}
+	w.unindent()
+	return w.WriteByte('>')
+}
+
+// isWireMessage reports whether data is a well-formed message in the wire
+// format. As with protoc --decode_raw, a length-delimited unknown field
+// that is one is taken to hold a message, though it may be a short string.
+func isWireMessage(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	var groups []uint64
+	b := NewBuffer(data)
+	for b.index < len(b.buf) {
+		x, err := b.DecodeVarint()
+		if err != nil {
+			return false
+		}
+		wire, tag := x&7, x>>3
+		if tag == 0 || tag > maxFieldNumber {
+			return false
+		}
+		switch wire {
+		case WireVarint:
+			_, err = b.DecodeVarint()
+		case WireFixed64:
+			_, err = b.DecodeFixed64()
+		case WireBytes:
+			_, err = b.DecodeRawBytes(false)
+		case WireStartGroup:
+			groups = append(groups, tag)
+		case WireEndGroup:
+			if len(groups) == 0 || groups[len(groups)-1] != tag {
+				return false
+			}
+			groups = groups[:len(groups)-1]
+		case WireFixed32:
+			_, err = b.DecodeFixed32()
+		default:
+			return false
+		}
+		if err != nil {
+			return false
+		}
+	}
+	return len(groups) == 0
+}
+
 func writeUnknownInt(w *textWriter, x uint64, err error) error {
 	if err == nil {
 		_, err = fmt.Fprint(w, x)
@@ -677,7 +860,6 @@ func (s int32Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 // writeExtensions writes all the extensions in pv.
 // pv is assumed to be a pointer to a protocol message struct that is extendable.
 func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error {
-	emap := extensionMaps[pv.Type().Elem()]
 	ep, _ := extendable(pv.Interface())
 
 	// Order the extensions by ID.
@@ -697,9 +879,9 @@ func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error
 
 	for _, extNum := range ids {
 		ext := m[extNum]
-		var desc *ExtensionDesc
-		if emap != nil {
-			desc = emap[extNum]
+		desc := registeredExtension(pv.Type().Elem(), extNum)
+		if desc == nil {
+			desc = resolveExtension(pv.Interface().(Message), extNum)
 		}
 		if desc == nil {
 			// Unknown extension.
@@ -716,13 +898,13 @@ func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error
 
 		// Repeated extensions will appear as a slice.
 		if !desc.repeated() {
-			if err := tm.writeExtension(w, desc.Name, pb); err != nil {
+			if err := tm.writeExtension(w, desc, pb); err != nil {
 				return err
 			}
 		} else {
 			v := reflect.ValueOf(pb)
 			for i := 0; i < v.Len(); i++ {
-				if err := tm.writeExtension(w, desc.Name, v.Index(i).Interface()); err != nil {
+				if err := tm.writeExtension(w, desc, v.Index(i).Interface()); err != nil {
 					return err
 				}
 			}
@@ -731,10 +913,11 @@ func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error
 	return nil
 }
 
-func (tm *TextMarshaler) writeExtension(w *textWriter, name string, pb interface{}) error {
-	if _, err := fmt.Fprintf(w, "[%s]:", name); err != nil {
+func (tm *TextMarshaler) writeExtension(w *textWriter, desc *ExtensionDesc, pb interface{}) error {
+	if _, err := fmt.Fprintf(w, "[%s]:", desc.Name); err != nil {
 		return err
 	}
+	tm.noteFieldNumber(w, int(desc.Field))
 	if !w.compact {
 		if err := w.WriteByte(' '); err != nil {
 			return err
@@ -763,12 +946,30 @@ func (w *textWriter) writeIndent() {
 		remain -= n
 	}
 	w.complete = false
+	w.col = w.ind * 2
 }
 
 // TextMarshaler is a configurable text format marshaler.
+//
+// Fields marked with the network.api.sensitive option are printed as
+// RedactedValue, which keeps secrets out of String methods and logs,
+// unless ShowSensitive is set. The fields of messages held in Any
+// fields are redacted only if ExpandAny is set.
+//
+// For debugging, FieldNumbers follows each field with a comment giving its
+// number, and DecodeUnknown prints unknown fields that hold messages or
+// text as such rather than as bytes. For canonical configuration files,
+// EmitDefaults prints every proto3 scalar field, and Width wraps long
+// strings. Neither FieldNumbers nor Width applies to compact output.
 type TextMarshaler struct {
-	Compact   bool // use compact text format (one line).
-	ExpandAny bool // expand google.protobuf.Any messages of known types
+	Compact       bool // use compact text format (one line).
+	ExpandAny     bool // expand google.protobuf.Any messages of known types
+	ShowSensitive bool // print the values of sensitive fields
+	FieldNumbers  bool // follow each field with its number in a comment
+	HexBytes      bool // escape bytes in bytes fields in hex (\xff) instead of octal (\377)
+	EmitDefaults  bool // print proto3 scalar fields that hold their default values
+	DecodeUnknown bool // print unknown fields that hold messages or text as such
+	Width         int  // split strings over lines to keep them within Width bytes, if not zero
 }
 
 // Marshal writes a given protocol buffer in text format.
@@ -786,9 +987,12 @@ func (tm *TextMarshaler) Marshal(w io.Writer, pb Message) error {
 		ww = bw
 	}
 	aw := &textWriter{
-		w:        ww,
-		complete: true,
-		compact:  tm.Compact,
+		w:             ww,
+		complete:      true,
+		compact:       tm.Compact,
+		width:         tm.Width,
+		hexBytes:      tm.HexBytes,
+		decodeUnknown: tm.DecodeUnknown,
 	}
 
 	if etm, ok := pb.(encoding.TextMarshaler); ok {
@@ -0,0 +1,432 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Editing text format documents without losing their comments and layout.
+ */
+
+import (
+	"bytes"
+	"strings"
+)
+
+// TextDocument is a text format message read by ParseTextDocument, kept
+// with its comments and layout so that it can be edited and written back
+// with minimal changes. Its fields can be edited, removed, reordered and
+// added to; String writes the parts of the document that were not edited
+// exactly as they were read, and formats the rest.
+//
+// A TextDocument is not tied to a message type. Use Unmarshal to check
+// it against one.
+type TextDocument struct {
+	Fields []*TextField
+
+	parsed  bool   // whether the document was read by ParseTextDocument
+	trailer string // whitespace and comments after the last field
+}
+
+// TextField is a field of a TextDocument or of a message within it.
+type TextField struct {
+	// Name is the name of the field, or an extension name or Any type
+	// URL in brackets, such as "[test_proto.ext]".
+	Name string
+
+	// Value is the value of the field as written in the text format,
+	// such as `42`, `RED`, `"a string"` or `[1, 2]`; it is written as is.
+	// It is empty for a message field, whose fields are in Fields.
+	Value string
+
+	// Fields holds the fields of a message field.
+	Fields []*TextField
+
+	// Comments holds the comment lines before the field, and LineComment
+	// the comment after the field on its last line, without the leading
+	// "#" and space.
+	Comments    []string
+	LineComment string
+
+	src *textFieldSource // how the field was written, if it was parsed
+}
+
+// textFieldSource is the text a field was parsed from, in pieces, along
+// with the parts of the field that were read, which tell what was edited.
+type textFieldSource struct {
+	name, value string
+	comments    []string
+	lineComment string
+
+	prefix  string // whitespace and comments before the field
+	head    string // from the name to the value, or the opening bracket of a message
+	trailer string // whitespace and comments before the closing bracket of a message
+	closer  string // the closing bracket of a message
+	suffix  string // the separator and comment after the value on its line
+}
+
+// ParseTextDocument parses s, a message in the text format, into a
+// TextDocument. Only the syntax of s is checked; an error is a *ParseError.
+func ParseTextDocument(s string) (*TextDocument, error) {
+	p := newTextParser(s)
+	d := &TextDocument{parsed: true}
+	var err error
+	d.Fields, d.trailer, err = p.readTextFields(s, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Field returns the first field of d named name, or nil if there is none.
+func (d *TextDocument) Field(name string) *TextField {
+	return findTextField(d.Fields, name)
+}
+
+// Field returns the first field of the message field f named name,
+// or nil if there is none.
+func (f *TextField) Field(name string) *TextField {
+	return findTextField(f.Fields, name)
+}
+
+func findTextField(fields []*TextField, name string) *TextField {
+	for _, f := range fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// Unmarshal reads the document into pb, as UnmarshalText does.
+func (d *TextDocument) Unmarshal(pb Message) error {
+	return UnmarshalText(d.String(), pb)
+}
+
+// String returns the document in the text format.
+func (d *TextDocument) String() string {
+	w := new(textDocWriter)
+	w.writeFields(d.Fields, textIndent(d.Fields, ""))
+	if d.parsed {
+		w.writeRaw(d.trailer, "")
+	} else if w.Len() > 0 {
+		w.WriteByte('\n')
+	}
+	return w.String()
+}
+
+// readTextFields reads fields up to terminator, the closing bracket of a
+// message or "" for the end of the input, which it consumes. The text of
+// the first field starts at offset pos of the input s. It returns the
+// fields and the text after them, before the terminator.
+func (p *textParser) readTextFields(s, terminator string, pos int) ([]*TextField, string, error) {
+	var fields []*TextField
+	for {
+		tok := p.next()
+		if tok.err != nil {
+			return nil, "", tok.err
+		}
+		if tok.value == terminator {
+			if terminator == "" {
+				return fields, s[pos:], nil
+			}
+			return fields, s[pos:tok.offset], nil
+		}
+		if tok.value == "" {
+			return nil, "", p.errorf("unexpected EOF")
+		}
+
+		f := &TextField{src: &textFieldSource{prefix: s[pos:tok.offset]}}
+		src := f.src
+		start := tok.offset
+
+		// The name.
+		if tok.value == "[" {
+			name, err := p.consumeExtName()
+			if err != nil {
+				return nil, "", err
+			}
+			f.Name = "[" + name + "]"
+		} else if isIdentOrNumberChar(tok.value[0]) {
+			f.Name = tok.value
+		} else {
+			return nil, "", p.errorf("expected field name, found %q", tok.value)
+		}
+
+		// The value.
+		tok = p.next()
+		if tok.err != nil {
+			return nil, "", tok.err
+		}
+		colon := tok.value == ":"
+		if colon {
+			if tok = p.next(); tok.err != nil {
+				return nil, "", tok.err
+			}
+		}
+		valStart := tok.offset
+		switch tok.value {
+		case "{", "<":
+			closer := "}"
+			if tok.value == "<" {
+				closer = ">"
+			}
+			src.head = s[start:p.end.Offset]
+			var err error
+			f.Fields, src.trailer, err = p.readTextFields(s, closer, p.end.Offset)
+			if err != nil {
+				return nil, "", err
+			}
+			src.closer = closer
+		case "[":
+			// A list, which is kept as written.
+			for depth := 1; depth > 0; {
+				if tok = p.next(); tok.err != nil {
+					return nil, "", tok.err
+				}
+				switch tok.value {
+				case "":
+					return nil, "", p.errorf("unexpected EOF")
+				case "{", "<", "[":
+					depth++
+				case "}", ">", "]":
+					depth--
+				}
+			}
+			src.head = s[start:valStart]
+			f.Value = s[valStart:p.end.Offset]
+		case "":
+			return nil, "", p.errorf("unexpected EOF")
+		default:
+			if !colon {
+				return nil, "", p.errorf("expected ':', found %q", tok.value)
+			}
+			if !isQuote(tok.value[0]) && !isIdentOrNumberChar(tok.value[0]) {
+				return nil, "", p.errorf("expected value, found %q", tok.value)
+			}
+			src.head = s[start:valStart]
+			f.Value = s[valStart:p.end.Offset]
+		}
+		valEnd := p.end.Offset
+
+		// An optional separator and the rest of the line.
+		pos = valEnd
+		if tok = p.next(); tok.err != nil {
+			return nil, "", tok.err
+		}
+		if tok.value == ";" || tok.value == "," {
+			pos = p.end.Offset
+			if tok = p.next(); tok.err != nil {
+				return nil, "", tok.err
+			}
+		}
+		next := tok.offset
+		if tok.value == "" {
+			next = len(s)
+		}
+		p.back()
+		rest := s[pos:next]
+		if i := strings.IndexByte(rest, '\n'); i >= 0 {
+			rest = rest[:i]
+		} else if next < len(s) {
+			// The next token is on the same line.
+			rest = ""
+		}
+		pos += len(rest)
+		src.suffix = s[valEnd:pos]
+
+		f.Comments = textComments(src.prefix)
+		f.LineComment = textLineComment(src.suffix)
+		src.name, src.value = f.Name, f.Value
+		src.comments, src.lineComment = f.Comments, f.LineComment
+		fields = append(fields, f)
+	}
+}
+
+// textComments returns the comments in s, which holds only whitespace
+// and comments.
+func textComments(s string) []string {
+	var comments []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			comments = append(comments, textCommentText(line))
+		}
+	}
+	return comments
+}
+
+// textLineComment returns the comment in the suffix s of a field, if any.
+func textLineComment(s string) string {
+	i := strings.IndexByte(s, '#')
+	if i < 0 {
+		return ""
+	}
+	return textCommentText(strings.TrimSpace(s[i:]))
+}
+
+// textCommentText strips the "#" and a following space from a comment.
+func textCommentText(c string) string {
+	c = strings.TrimPrefix(c[1:], " ")
+	return strings.TrimRightFunc(c, func(r rune) bool { return r == ' ' || r == '\t' || r == '\r' })
+}
+
+// textIndent returns the indentation of the first of fields that was parsed
+// from its own line, or def if there is none.
+func textIndent(fields []*TextField, def string) string {
+	for _, f := range fields {
+		if f.src == nil {
+			continue
+		}
+		i := strings.LastIndexByte(f.src.prefix, '\n')
+		if i < 0 {
+			continue
+		}
+		if indent := f.src.prefix[i+1:]; strings.Trim(indent, " \t") == "" {
+			return indent
+		}
+	}
+	return def
+}
+
+// textDocWriter writes a TextDocument.
+type textDocWriter struct {
+	bytes.Buffer
+	inComment bool // whether the current line ends in a comment
+}
+
+// writeRaw writes s, which holds only whitespace and comments and begins
+// a line at indentation indent, starting a new line if one is needed to
+// end a comment before it.
+func (w *textDocWriter) writeRaw(s, indent string) {
+	if w.inComment && !strings.Contains(s, "\n") {
+		w.WriteString("\n" + indent)
+		s = strings.TrimLeft(s, " \t")
+	}
+	w.WriteString(s)
+	w.inComment = false
+}
+
+// newLine starts a new line, unless the output is empty or one was just started.
+func (w *textDocWriter) newLine() {
+	if b := w.Bytes(); len(b) > 0 && b[len(b)-1] != '\n' {
+		w.WriteByte('\n')
+	}
+	w.inComment = false
+}
+
+func (w *textDocWriter) writeFields(fields []*TextField, indent string) {
+	for _, f := range fields {
+		w.writeField(f, indent)
+	}
+}
+
+func (w *textDocWriter) writeField(f *TextField, indent string) {
+	src := f.src
+	if src == nil {
+		src = new(textFieldSource)
+	}
+	isMessage := f.Value == ""
+	parsed := f.src != nil
+	wasMessage := parsed && src.value == ""
+
+	if parsed && equalStrings(f.Comments, src.comments) {
+		w.writeRaw(src.prefix, indent)
+	} else {
+		w.newLine()
+		for _, c := range f.Comments {
+			w.WriteString(strings.TrimRight(indent+"# "+c, " ") + "\n")
+		}
+		w.WriteString(indent)
+	}
+
+	switch {
+	case parsed && f.Name == src.name && isMessage == wasMessage:
+		w.WriteString(src.head)
+	case isMessage:
+		w.WriteString(f.Name + " {")
+	default:
+		w.WriteString(f.Name + ": ")
+	}
+
+	if isMessage {
+		w.writeFields(f.Fields, textIndent(f.Fields, indent+"  "))
+		if wasMessage {
+			trailer := src.trailer
+			if !strings.Contains(trailer, "\n") && (w.inComment || hasNewTextFields(f.Fields)) {
+				trailer = "\n" + indent + strings.TrimLeft(trailer, " \t")
+			}
+			w.writeRaw(trailer, indent)
+			w.WriteString(src.closer)
+		} else {
+			if len(f.Fields) > 0 {
+				w.newLine()
+				w.WriteString(indent)
+			}
+			w.WriteString("}")
+		}
+	} else {
+		w.WriteString(f.Value)
+	}
+
+	suffix := src.suffix
+	if !parsed || f.LineComment != src.lineComment {
+		if i := strings.IndexByte(suffix, '#'); i >= 0 {
+			suffix = strings.TrimRight(suffix[:i], " \t")
+		}
+		if f.LineComment != "" {
+			suffix += " # " + f.LineComment
+		}
+	}
+	w.WriteString(suffix)
+	w.inComment = strings.Contains(suffix, "#")
+}
+
+// hasNewTextFields reports whether any of fields was not parsed.
+func hasNewTextFields(fields []*TextField) bool {
+	for _, f := range fields {
+		if f.src == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+)
+
+const textDocument = `# The service configuration.
+count: 42   # the answer
+name: "Dave"
+pet: "bunny"; pet: ["kitty", 'horsey']
+
+# Where to find it.
+inner <
+  host: "footrest.syd"  # down under
+  port: 7001
+
+  # Trailing comments are kept too.
+>
+others { key: 3 }
+[test_proto.Ext.more] { data: "x" }
+# The end.
+`
+
+func TestTextDocumentRoundTrip(t *testing.T) {
+	for _, in := range []string{
+		textDocument,
+		"",
+		"\n\n# just a comment",
+		"count:1",
+		"count: 1 inner{host:'h'port:2}",
+		"others: < weight: 1.5, inner {} >,\n",
+		"name: \"multi\"\n  \"line\" # concatenated\n",
+	} {
+		d, err := ParseTextDocument(in)
+		if err != nil {
+			t.Errorf("ParseTextDocument(%q): %v", in, err)
+			continue
+		}
+		if got := d.String(); got != in {
+			t.Errorf("ParseTextDocument(%q).String() = %q", in, got)
+		}
+	}
+}
+
+func TestTextDocumentModel(t *testing.T) {
+	d, err := ParseTextDocument(textDocument)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(d.Fields), 7; got != want {
+		t.Fatalf("got %d fields, want %d", got, want)
+	}
+	count := d.Field("count")
+	if count.Value != "42" || count.LineComment != "the answer" || len(count.Comments) != 1 || count.Comments[0] != "The service configuration." {
+		t.Errorf("count: got %+v", count)
+	}
+	if got, want := d.Fields[3].Value, `["kitty", 'horsey']`; got != want {
+		t.Errorf("pet list: got %q, want %q", got, want)
+	}
+	inner := d.Field("inner")
+	if inner.Value != "" || len(inner.Fields) != 2 || inner.Comments[0] != "Where to find it." {
+		t.Errorf("inner: got %+v", inner)
+	}
+	if host := inner.Field("host"); host.Value != `"footrest.syd"` || host.LineComment != "down under" {
+		t.Errorf("inner.host: got %+v", host)
+	}
+	if d.Field("[test_proto.Ext.more]") == nil {
+		t.Errorf("extension field not found")
+	}
+
+	m := new(pb.MyMessage)
+	if err := d.Unmarshal(m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if m.GetInner().GetPort() != 7001 || len(m.Pet) != 3 {
+		t.Errorf("Unmarshal: got %v", m)
+	}
+}
+
+func TestTextDocumentEdit(t *testing.T) {
+	d, err := ParseTextDocument(textDocument)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Field("count").Value = "43"
+	d.Field("name").LineComment = "who"
+	inner := d.Field("inner")
+	inner.Field("port").Value = "8080"
+	inner.Fields = append(inner.Fields, &TextField{Name: "connected", Value: "true", Comments: []string{"New."}})
+	d.Field("others").Fields = append(d.Field("others").Fields, &TextField{Name: "weight", Value: "2"})
+	d.Fields = append(d.Fields[:2], d.Fields[3:]...) // remove the first pet
+	d.Fields = append(d.Fields, &TextField{
+		Name:   "rep_inner",
+		Fields: []*TextField{{Name: "host", Value: `"new"`}},
+	})
+
+	want := `# The service configuration.
+count: 43   # the answer
+name: "Dave" # who
+pet: ["kitty", 'horsey']
+
+# Where to find it.
+inner <
+  host: "footrest.syd"  # down under
+  port: 8080
+  # New.
+  connected: true
+
+  # Trailing comments are kept too.
+>
+others { key: 3
+  weight: 2
+}
+[test_proto.Ext.more] { data: "x" }
+rep_inner {
+  host: "new"
+}
+# The end.
+`
+	if got := d.String(); got != want {
+		t.Errorf("edited document:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestTextDocumentNew(t *testing.T) {
+	d := &TextDocument{Fields: []*TextField{
+		{Name: "count", Value: "1", LineComment: "one"},
+		{Name: "inner", Comments: []string{"The inner message."}, Fields: []*TextField{
+			{Name: "host", Value: `"h"`},
+		}},
+	}}
+	want := `count: 1 # one
+# The inner message.
+inner {
+  host: "h"
+}
+`
+	if got := d.String(); got != want {
+		t.Errorf("new document:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestParseTextDocumentErrors(t *testing.T) {
+	for _, test := range []struct {
+		in, err string
+	}{
+		{"count 42", `line 1.7: expected ':', found "42"`},
+		{"inner { host: 1", `line 1.15: unexpected EOF`},
+		{"count: }", `line 1.8: expected value, found "}"`},
+		{": 1", `line 1.1: expected field name, found ":"`},
+		{`name: "unclosed`, `line 1.7: unmatched quote`},
+	} {
+		_, err := ParseTextDocument(test.in)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("ParseTextDocument(%q): got error %v, want %v", test.in, err, test.err)
+		}
+	}
+}
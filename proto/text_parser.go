@@ -51,9 +51,13 @@ type ParseError struct {
 	Message string
 	Line    int // 1-based line number
 	Offset  int // 0-based byte offset from start of input
+	Column  int // 1-based column number, in bytes
 }
 
 func (p *ParseError) Error() string {
+	if p.Column > 0 {
+		return fmt.Sprintf("line %d.%d: %v", p.Line, p.Column, p.Message)
+	}
 	if p.Line == 1 {
 		// show offset only for first line
 		return fmt.Sprintf("line 1.%d: %v", p.Offset, p.Message)
@@ -61,10 +65,41 @@ func (p *ParseError) Error() string {
 	return fmt.Sprintf("line %d: %v", p.Line, p.Message)
 }
 
+// ParseErrors lists every error found by UnmarshalOptions.UnmarshalText
+// when AllErrors is set, in input order.
+type ParseErrors []*ParseError
+
+// Error returns the messages of all the errors, one per line.
+func (e ParseErrors) Error() string {
+	if len(e) == 0 {
+		return "no errors"
+	}
+	msgs := make([]string, len(e))
+	for i, pe := range e {
+		msgs[i] = pe.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// TextPosition is a location in text format input.
+type TextPosition struct {
+	Line   int // 1-based line number
+	Column int // 1-based column number, in bytes
+	Offset int // 0-based byte offset from start of input
+}
+
+// TextSpan is the extent of a field in text format input, from the start
+// of its name to just past the end of its value. Elements of a repeated
+// field written in list notation, like [1, 2], span only the element.
+type TextSpan struct {
+	Start, End TextPosition
+}
+
 type token struct {
 	value    string
 	err      *ParseError
 	line     int    // line number
+	column   int    // byte number from start of line, 1-based
 	offset   int    // byte number from start of input, not start of line
 	unquoted string // the unquoted version of value, if it was a quoted string
 }
@@ -81,7 +116,18 @@ type textParser struct {
 	done         bool   // whether the parsing is finished (success or error)
 	backed       bool   // whether back() was called
 	offset, line int
+	lineStart    int // offset of the start of the current line
 	cur          token
+	end          TextPosition      // the end of the last token read
+	opts         *UnmarshalOptions // limits to enforce, or nil
+	depth        int               // nesting depth of the struct being read
+
+	// The following are used only with opts.AllErrors and spans.
+	spans      map[string]TextSpan // receives the spans of the fields read, or nil
+	errs       ParseErrors         // errors recovered from so far
+	badToken   bool                // whether the last error was in reading a token
+	path       string              // the path of the value being read
+	fieldStart TextPosition        // the start of the field being read
 }
 
 func newTextParser(s string) *textParser {
@@ -89,11 +135,12 @@ func newTextParser(s string) *textParser {
 	p.s = s
 	p.line = 1
 	p.cur.line = 1
+	p.cur.column = 1
 	return p
 }
 
 func (p *textParser) errorf(format string, a ...interface{}) *ParseError {
-	pe := &ParseError{fmt.Sprintf(format, a...), p.cur.line, p.cur.offset}
+	pe := &ParseError{fmt.Sprintf(format, a...), p.cur.line, p.cur.offset, p.cur.column}
 	p.cur.err = pe
 	p.done = true
 	return pe
@@ -144,6 +191,7 @@ func (p *textParser) skipWhitespace() {
 		}
 		if p.s[i] == '\n' {
 			p.line++
+			p.lineStart = p.offset + i + 1
 		}
 		i++
 	}
@@ -164,6 +212,7 @@ func (p *textParser) advance() {
 	// Start of non-whitespace
 	p.cur.err = nil
 	p.cur.offset, p.cur.line = p.offset, p.line
+	p.cur.column = p.offset - p.lineStart + 1
 	p.cur.unquoted = ""
 	switch p.s[0] {
 	case '<', '>', '{', '}', ':', '[', ']', ';', ',', '/':
@@ -181,11 +230,13 @@ func (p *textParser) advance() {
 		}
 		if i >= len(p.s) || p.s[i] != p.s[0] {
 			p.errorf("unmatched quote")
+			p.badToken = true
 			return
 		}
 		unq, err := unquoteC(p.s[1:i], rune(p.s[0]))
 		if err != nil {
 			p.errorf("invalid quoted string %s: %v", p.s[0:i+1], err)
+			p.badToken = true
 			return
 		}
 		p.cur.value, p.s = p.s[0:i+1], p.s[i+1:len(p.s)]
@@ -197,11 +248,13 @@ func (p *textParser) advance() {
 		}
 		if i == 0 {
 			p.errorf("unexpected byte %#x", p.s[0])
+			p.badToken = true
 			return
 		}
 		p.cur.value, p.s = p.s[0:i], p.s[i:len(p.s)]
 	}
 	p.offset += len(p.cur.value)
+	p.end = TextPosition{p.cur.line, p.cur.column + len(p.cur.value), p.offset}
 }
 
 var (
@@ -434,10 +487,15 @@ func (p *textParser) checkForColon(props *Properties, typ reflect.Type) *ParseEr
 
 func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 	st := sv.Type()
+	if p.opts != nil {
+		p.depth++
+		defer func() { p.depth-- }()
+		if err := p.opts.checkDepth(p.depth, ""); err != nil {
+			return err
+		}
+	}
 	sprops := GetProperties(st)
-	reqCount := sprops.reqCount
-	var reqFieldErr error
-	fieldSet := make(map[string]bool)
+	state := &structState{reqCount: sprops.reqCount, fieldSet: make(map[string]bool)}
 	// A struct is a sequence of "name: value", terminated by one of
 	// '>' or '}', or the end of the input.  A name may also be
 	// "[extension]" or "[type/url]".
@@ -447,246 +505,465 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 	for {
 		tok := p.next()
 		if tok.err != nil {
+			if p.recoverFrom(tok.err, terminator) {
+				continue
+			}
 			return tok.err
 		}
 		if tok.value == terminator {
 			break
 		}
-		if tok.value == "[" {
-			// Looks like an extension or an Any.
-			//
-			// TODO: Check whether we need to handle
-			// namespace rooted names (e.g. ".something.Foo").
-			extName, err := p.consumeExtName()
-			if err != nil {
-				return err
+		if tok.value == "" {
+			return p.errorf("unexpected EOF")
+		}
+		if err := p.readField(sv, state, tok); err != nil {
+			if p.recoverFrom(err, terminator) {
+				continue
 			}
+			return err
+		}
+	}
 
-			if s := strings.LastIndex(extName, "/"); s >= 0 {
-				// If it contains a slash, it's an Any type URL.
-				messageName := extName[s+1:]
-				mt := MessageType(messageName)
-				if mt == nil {
-					return p.errorf("unrecognized message %q in google.protobuf.Any", messageName)
-				}
+	if state.reqCount > 0 {
+		return p.missingRequiredFieldError(sv)
+	}
+	return state.reqFieldErr
+}
+
+// structState holds what readStruct tracks across the fields of a struct.
+type structState struct {
+	reqCount    int             // required fields not yet seen
+	reqFieldErr error           // a required field missing in a nested message
+	fieldSet    map[string]bool // fields seen so far
+}
+
+// readField reads the field whose name starts with tok into sv.
+func (p *textParser) readField(sv reflect.Value, state *structState, tok *token) error {
+	st := sv.Type()
+	sprops := GetProperties(st)
+	start := p.position(tok)
+	if tok.value == "[" {
+		// Looks like an extension or an Any.
+		//
+		// TODO: Check whether we need to handle
+		// namespace rooted names (e.g. ".something.Foo").
+		extName, err := p.consumeExtName()
+		if err != nil {
+			return err
+		}
+		path := joinPath(p.path, "["+extName+"]")
+
+		if s := strings.LastIndex(extName, "/"); s >= 0 {
+			// If it contains a slash, it's an Any type URL.
+			messageName := extName[s+1:]
+			mt := MessageType(messageName)
+			if mt == nil {
+				return p.errorf("unrecognized message %q in google.protobuf.Any", messageName)
+			}
+			tok = p.next()
+			if tok.err != nil {
+				return tok.err
+			}
+			// consume an optional colon
+			if tok.value == ":" {
 				tok = p.next()
 				if tok.err != nil {
 					return tok.err
 				}
-				// consume an optional colon
-				if tok.value == ":" {
-					tok = p.next()
-					if tok.err != nil {
-						return tok.err
-					}
-				}
-				var terminator string
-				switch tok.value {
-				case "<":
-					terminator = ">"
-				case "{":
-					terminator = "}"
-				default:
-					return p.errorf("expected '{' or '<', found %q", tok.value)
-				}
-				v := reflect.New(mt.Elem())
-				if pe := p.readStruct(v.Elem(), terminator); pe != nil {
-					return pe
-				}
-				b, err := Marshal(v.Interface().(Message))
-				if err != nil {
-					return p.errorf("failed to marshal message of type %q: %v", messageName, err)
-				}
-				if fieldSet["type_url"] {
-					return p.errorf(anyRepeatedlyUnpacked, "type_url")
-				}
-				if fieldSet["value"] {
-					return p.errorf(anyRepeatedlyUnpacked, "value")
-				}
-				sv.FieldByName("TypeUrl").SetString(extName)
-				sv.FieldByName("Value").SetBytes(b)
-				fieldSet["type_url"] = true
-				fieldSet["value"] = true
-				continue
 			}
-
-			var desc *ExtensionDesc
-			// This could be faster, but it's functional.
-			// TODO: Do something smarter than a linear scan.
-			for _, d := range RegisteredExtensions(reflect.New(st).Interface().(Message)) {
-				if d.Name == extName {
-					desc = d
-					break
-				}
+			var terminator string
+			switch tok.value {
+			case "<":
+				terminator = ">"
+			case "{":
+				terminator = "}"
+			default:
+				return p.errorf("expected '{' or '<', found %q", tok.value)
 			}
-			if desc == nil {
-				return p.errorf("unrecognized extension %q", extName)
+			v := reflect.New(mt.Elem())
+			saved := p.path
+			p.path = path
+			pe := p.readStruct(v.Elem(), terminator)
+			p.path = saved
+			if pe != nil {
+				return pe
 			}
-
-			props := &Properties{}
-			props.Parse(desc.Tag)
-
-			typ := reflect.TypeOf(desc.ExtensionType)
-			if err := p.checkForColon(props, typ); err != nil {
-				return err
+			b, err := Marshal(v.Interface().(Message))
+			if err != nil {
+				return p.errorf("failed to marshal message of type %q: %v", messageName, err)
 			}
-
-			rep := desc.repeated()
-
-			// Read the extension structure, and set it in
-			// the value we're constructing.
-			var ext reflect.Value
-			if !rep {
-				ext = reflect.New(typ).Elem()
-			} else {
-				ext = reflect.New(typ.Elem()).Elem()
+			if state.fieldSet["type_url"] {
+				return p.errorf(anyRepeatedlyUnpacked, "type_url")
 			}
-			if err := p.readAny(ext, props); err != nil {
-				if _, ok := err.(*RequiredNotSetError); !ok {
+			if state.fieldSet["value"] {
+				return p.errorf(anyRepeatedlyUnpacked, "value")
+			}
+			sv.FieldByName("TypeUrl").SetString(extName)
+			sv.FieldByName("Value").SetBytes(b)
+			state.fieldSet["type_url"] = true
+			state.fieldSet["value"] = true
+			p.addSpan(path, start)
+			return nil
+		}
+
+		desc := findExtension(sv.Addr().Interface().(Message), extName)
+		if desc == nil {
+			if p.opts != nil && p.opts.DiscardUnknown {
+				if err := p.skipValue(); err != nil {
 					return err
 				}
-				reqFieldErr = err
+				return p.consumeOptionalSeparator()
 			}
-			ep := sv.Addr().Interface().(Message)
-			if !rep {
-				SetExtension(ep, desc, ext.Interface())
+			return p.errorf("unrecognized extension %q", extName)
+		}
+
+		props := &Properties{}
+		props.Parse(desc.Tag)
+
+		typ := reflect.TypeOf(desc.ExtensionType)
+		if err := p.checkForColon(props, typ); err != nil {
+			return err
+		}
+
+		rep := desc.repeated()
+
+		// Read the extension structure, and set it in
+		// the value we're constructing.
+		var ext reflect.Value
+		if !rep {
+			ext = reflect.New(typ).Elem()
+		} else {
+			ext = reflect.New(typ.Elem()).Elem()
+		}
+		ep := sv.Addr().Interface().(Message)
+		var old interface{}
+		if rep {
+			old, err = GetExtension(ep, desc)
+			if err == nil {
+				path += "[" + strconv.Itoa(reflect.ValueOf(old).Len()) + "]"
 			} else {
-				old, err := GetExtension(ep, desc)
-				var sl reflect.Value
-				if err == nil {
-					sl = reflect.ValueOf(old) // existing slice
-				} else {
-					sl = reflect.MakeSlice(typ, 0, 1)
-				}
-				sl = reflect.Append(sl, ext)
-				SetExtension(ep, desc, sl.Interface())
+				old = nil
+				path += "[0]"
 			}
-			if err := p.consumeOptionalSeparator(); err != nil {
+		}
+		if err := p.readAnyAt(path, ext, props); err != nil {
+			if _, ok := err.(*RequiredNotSetError); !ok {
 				return err
 			}
-			continue
+			state.reqFieldErr = err
 		}
-
-		// This is a normal, non-extension field.
-		name := tok.value
-		var dst reflect.Value
-		fi, props, ok := structFieldByName(sprops, name)
-		if ok {
-			dst = sv.Field(fi)
-		} else if oop, ok := sprops.OneofTypes[name]; ok {
-			// It is a oneof.
-			props = oop.Prop
-			nv := reflect.New(oop.Type.Elem())
-			dst = nv.Elem().Field(0)
-			field := sv.Field(oop.Field)
-			if !field.IsNil() {
-				return p.errorf("field '%s' would overwrite already parsed oneof '%s'", name, sv.Type().Field(oop.Field).Name)
+		if !rep {
+			SetExtension(ep, desc, ext.Interface())
+		} else {
+			var sl reflect.Value
+			if old != nil {
+				sl = reflect.ValueOf(old) // existing slice
+			} else {
+				sl = reflect.MakeSlice(typ, 0, 1)
 			}
-			field.Set(nv)
-		}
-		if !dst.IsValid() {
-			return p.errorf("unknown field name %q in %v", name, st)
+			sl = reflect.Append(sl, ext)
+			SetExtension(ep, desc, sl.Interface())
 		}
+		p.addSpan(path, start)
+		return p.consumeOptionalSeparator()
+	}
 
-		if dst.Kind() == reflect.Map {
-			// Consume any colon.
-			if err := p.checkForColon(props, dst.Type()); err != nil {
+	// This is a normal, non-extension field.
+	name := tok.value
+	path := joinPath(p.path, name)
+	var dst reflect.Value
+	fi, props, ok := structFieldByName(sprops, name)
+	if ok {
+		dst = sv.Field(fi)
+	} else if oop, ok := sprops.OneofTypes[name]; ok {
+		// It is a oneof.
+		props = oop.Prop
+		nv := reflect.New(oop.Type.Elem())
+		dst = nv.Elem().Field(0)
+		field := sv.Field(oop.Field)
+		if !field.IsNil() {
+			return p.errorf("field '%s' would overwrite already parsed oneof '%s'", name, sv.Type().Field(oop.Field).Name)
+		}
+		field.Set(nv)
+	}
+	if !dst.IsValid() {
+		if p.opts != nil && p.opts.DiscardUnknown {
+			if err := p.skipValue(); err != nil {
 				return err
 			}
+			return p.consumeOptionalSeparator()
+		}
+		return p.errorf("unknown field name %q in %v", name, st)
+	}
 
-			// Construct the map if it doesn't already exist.
-			if dst.IsNil() {
-				dst.Set(reflect.MakeMap(dst.Type()))
-			}
-			key := reflect.New(dst.Type().Key()).Elem()
-			val := reflect.New(dst.Type().Elem()).Elem()
+	if dst.Kind() == reflect.Map {
+		// Consume any colon.
+		if err := p.checkForColon(props, dst.Type()); err != nil {
+			return err
+		}
+
+		// Construct the map if it doesn't already exist.
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(dst.Type()))
+		}
+		key := reflect.New(dst.Type().Key()).Elem()
+		val := reflect.New(dst.Type().Elem()).Elem()
 
-			// The map entry should be this sequence of tokens:
-			//	< key : KEY value : VALUE >
-			// However, implementations may omit key or value, and technically
-			// we should support them in any order.  See b/28924776 for a time
-			// this went wrong.
+		// The map entry should be this sequence of tokens:
+		//	< key : KEY value : VALUE >
+		// However, implementations may omit key or value, and technically
+		// we should support them in any order.  See b/28924776 for a time
+		// this went wrong.
 
+		tok := p.next()
+		var terminator string
+		switch tok.value {
+		case "<":
+			terminator = ">"
+		case "{":
+			terminator = "}"
+		default:
+			return p.errorf("expected '{' or '<', found %q", tok.value)
+		}
+		// The path of the entry depends on its key. If the key follows
+		// a message value, spans within the value are recorded apart and
+		// renamed once the key is known.
+		keySet := false
+		tmpPath := ""
+		var pending map[string]TextSpan
+		for {
 			tok := p.next()
-			var terminator string
-			switch tok.value {
-			case "<":
-				terminator = ">"
-			case "{":
-				terminator = "}"
-			default:
-				return p.errorf("expected '{' or '<', found %q", tok.value)
+			if tok.err != nil {
+				return tok.err
 			}
-			for {
-				tok := p.next()
-				if tok.err != nil {
-					return tok.err
+			if tok.value == terminator {
+				break
+			}
+			switch tok.value {
+			case "key":
+				if err := p.consumeToken(":"); err != nil {
+					return err
 				}
-				if tok.value == terminator {
-					break
+				if err := p.readAny(key, props.mkeyprop); err != nil {
+					return err
 				}
-				switch tok.value {
-				case "key":
-					if err := p.consumeToken(":"); err != nil {
-						return err
-					}
-					if err := p.readAny(key, props.mkeyprop); err != nil {
-						return err
-					}
-					if err := p.consumeOptionalSeparator(); err != nil {
-						return err
-					}
-				case "value":
-					if err := p.checkForColon(props.mvalprop, dst.Type().Elem()); err != nil {
-						return err
-					}
-					if err := p.readAny(val, props.mvalprop); err != nil {
-						return err
-					}
-					if err := p.consumeOptionalSeparator(); err != nil {
-						return err
+				keySet = true
+				if err := p.consumeOptionalSeparator(); err != nil {
+					return err
+				}
+			case "value":
+				if err := p.checkForColon(props.mvalprop, dst.Type().Elem()); err != nil {
+					return err
+				}
+				valPath := path + "[" + formatDiffValue(key.Interface()) + "]"
+				spans := p.spans
+				if !keySet && val.Kind() == reflect.Ptr {
+					tmpPath = path + "[\x00]"
+					valPath = tmpPath
+					if spans != nil {
+						if pending == nil {
+							pending = make(map[string]TextSpan)
+						}
+						p.spans = pending
 					}
-				default:
-					p.back()
-					return p.errorf(`expected "key", "value", or %q, found %q`, terminator, tok.value)
 				}
+				err := p.readAnyAt(valPath, val, props.mvalprop)
+				p.spans = spans
+				if err != nil {
+					return err
+				}
+				if err := p.consumeOptionalSeparator(); err != nil {
+					return err
+				}
+			default:
+				p.back()
+				return p.errorf(`expected "key", "value", or %q, found %q`, terminator, tok.value)
 			}
-
-			dst.SetMapIndex(key, val)
-			continue
 		}
 
-		// Check that it's not already set if it's not a repeated field.
-		if !props.Repeated && fieldSet[name] {
-			return p.errorf("non-repeated field %q was repeated", name)
+		dst.SetMapIndex(key, val)
+		if p.opts != nil {
+			if err := p.opts.checkElements(dst.Len(), props.OrigName); err != nil {
+				return err
+			}
+		}
+		path += "[" + formatDiffValue(key.Interface()) + "]"
+		for old, span := range pending {
+			p.spans[path+old[len(tmpPath):]] = span
 		}
+		p.addSpan(path, start)
+		return nil
+	}
 
-		if err := p.checkForColon(props, dst.Type()); err != nil {
+	// Check that it's not already set if it's not a repeated field.
+	if !props.Repeated && state.fieldSet[name] {
+		return p.errorf("non-repeated field %q was repeated", name)
+	}
+
+	if err := p.checkForColon(props, dst.Type()); err != nil {
+		return err
+	}
+
+	// Parse into the field.
+	state.fieldSet[name] = true
+	p.fieldStart = start
+	if err := p.readAnyAt(path, dst, props); err != nil {
+		if _, ok := err.(*RequiredNotSetError); !ok {
 			return err
 		}
+		state.reqFieldErr = err
+	}
+	if props.Required {
+		state.reqCount--
+	}
+	if !isRepeated(dst, props) {
+		p.addSpan(path, start)
+	}
 
-		// Parse into the field.
-		fieldSet[name] = true
-		if err := p.readAny(dst, props); err != nil {
-			if _, ok := err.(*RequiredNotSetError); !ok {
-				return err
+	return p.consumeOptionalSeparator()
+}
+
+// isRepeated reports whether v holds a repeated field, whose elements
+// readAny records the spans of.
+func isRepeated(v reflect.Value, props *Properties) bool {
+	return props.Repeated && v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8
+}
+
+// readAnyAt is like readAny, with path as the path of v.
+func (p *textParser) readAnyAt(path string, v reflect.Value, props *Properties) error {
+	saved := p.path
+	p.path = path
+	err := p.readAny(v, props)
+	p.path = saved
+	return err
+}
+
+// position returns the position of the start of tok.
+func (p *textParser) position(tok *token) TextPosition {
+	return TextPosition{tok.line, tok.column, tok.offset}
+}
+
+// addSpan records the span of the field at path, from start to the end
+// of the last token read, if spans are wanted.
+func (p *textParser) addSpan(path string, start TextPosition) {
+	if p.spans != nil {
+		p.spans[path] = TextSpan{start, p.end}
+	}
+}
+
+// recoverFrom records err and skips ahead to what looks like the start
+// of the next field of the struct ending with terminator, so that
+// parsing can go on to report later errors too. Fields are assumed to
+// be separated by semicolons or to start on a new line.
+// It reports whether parsing can go on, which is only when AllErrors is
+// set, err is a *ParseError, and the input is not exhausted.
+func (p *textParser) recoverFrom(err error, terminator string) bool {
+	pe, ok := err.(*ParseError)
+	if !ok || p.opts == nil || !p.opts.AllErrors {
+		return false
+	}
+	if !p.recorded(pe) {
+		p.errs = append(p.errs, pe)
+	}
+	if !p.badToken && !p.backed && isCloser(p.cur.value) {
+		// Take another look at a closing bracket, which may end the struct.
+		p.backed = true
+	}
+
+	// Skip the rest of the field. Errors in nested structs have already
+	// been recovered from, so only brackets opened after the error need
+	// to be matched.
+	line := pe.Line
+	depth, colon := 0, false
+	for {
+		p.cur.err = nil
+		if p.badToken {
+			p.skipBadToken()
+		}
+		if len(p.s) == 0 && !p.backed {
+			return p.recoverAtEOF(terminator)
+		}
+		p.done = false
+		tok := p.next()
+		if tok.err != nil {
+			if depth == 0 && tok.line > line {
+				p.errs = append(p.errs, tok.err)
+				line = tok.line
 			}
-			reqFieldErr = err
+			continue
 		}
-		if props.Required {
-			reqCount--
+		switch {
+		case tok.value == "":
+			return p.recoverAtEOF(terminator)
+		case depth == 0 && tok.value == terminator:
+			p.back()
+			return true
+		case depth == 0 && tok.value == ";":
+			return true
+		case depth == 0 && tok.line > line && !colon && !isCloser(tok.value):
+			p.back()
+			return true
+		case tok.value == "{" || tok.value == "<" || tok.value == "[":
+			depth++
+		case isCloser(tok.value):
+			if depth > 0 {
+				depth--
+			} else {
+				// The end of the map entry or list the error was in.
+				line = tok.line
+			}
 		}
+		colon = tok.value == ":"
+	}
+}
 
-		if err := p.consumeOptionalSeparator(); err != nil {
-			return err
-		}
+// recoverAtEOF is called when recovery reaches the end of the input,
+// which is an error of its own if the struct being read is unterminated.
+// It returns false, as parsing cannot go on.
+func (p *textParser) recoverAtEOF(terminator string) bool {
+	if n := len(p.errs); terminator != "" && p.errs[n-1].Message != "unexpected EOF" {
+		p.cur.line, p.cur.offset = p.line, p.offset
+		p.cur.column = p.offset - p.lineStart + 1
+		p.errs = append(p.errs, p.errorf("unexpected EOF"))
+	}
+	p.done, p.cur.value = true, ""
+	return false
+}
 
+// recorded reports whether pe is among the errors recovered from,
+// as it is when an unrecoverable error is passed up from a nested struct.
+func (p *textParser) recorded(pe *ParseError) bool {
+	for i := len(p.errs) - 1; i >= 0; i-- {
+		if p.errs[i] == pe {
+			return true
+		}
 	}
+	return false
+}
 
-	if reqCount > 0 {
-		return p.missingRequiredFieldError(sv)
+func isCloser(s string) bool {
+	return s == "}" || s == ">" || s == "]"
+}
+
+// skipBadToken skips the token that could not be read,
+// which is where the input is positioned.
+func (p *textParser) skipBadToken() {
+	p.badToken = false
+	i := 1
+	if isQuote(p.s[0]) {
+		for i < len(p.s) && p.s[i] != p.s[0] && p.s[i] != '\n' {
+			if p.s[i] == '\\' && i+1 < len(p.s) {
+				// skip escaped char
+				i++
+			}
+			i++
+		}
+		if i < len(p.s) && p.s[i] == p.s[0] {
+			i++
+		}
 	}
-	return reqFieldErr
+	p.offset += i
+	p.s = p.s[i:len(p.s)]
 }
 
 // consumeExtName consumes extension name or expanded Any type URL and the
@@ -721,6 +998,37 @@ func (p *textParser) consumeExtName() (string, error) {
 	return strings.Join(parts, ""), nil
 }
 
+// skipValue consumes the value of an unknown field, including an optional
+// leading colon. Nested messages and lists are skipped as a whole.
+func (p *textParser) skipValue() error {
+	tok := p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	if tok.value == ":" {
+		if tok = p.next(); tok.err != nil {
+			return tok.err
+		}
+	}
+	depth := 0
+	for {
+		switch tok.value {
+		case "":
+			return p.errorf("unexpected EOF")
+		case "{", "<", "[":
+			depth++
+		case "}", ">", "]":
+			depth--
+		}
+		if depth <= 0 {
+			return nil
+		}
+		if tok = p.next(); tok.err != nil {
+			return tok.err
+		}
+	}
+}
+
 // consumeOptionalSeparator consumes an optional semicolon or comma.
 // It is used in readStruct to provide backward compatibility.
 func (p *textParser) consumeOptionalSeparator() error {
@@ -759,15 +1067,24 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 			return nil
 		}
 		// Repeated field.
+		path := p.path
 		if tok.value == "[" {
 			// Repeated field with list notation, like [1,2,3].
 			for {
-				fv.Set(reflect.Append(fv, reflect.New(at.Elem()).Elem()))
-				err := p.readAny(fv.Index(fv.Len()-1), props)
-				if err != nil {
+				if err := p.checkElements(fv, props); err != nil {
 					return err
 				}
 				tok := p.next()
+				p.back()
+				start := p.position(tok)
+				i := fv.Len()
+				fv.Set(reflect.Append(fv, reflect.New(at.Elem()).Elem()))
+				epath := path + "[" + strconv.Itoa(i) + "]"
+				if err := p.readAnyAt(epath, fv.Index(i), props); err != nil {
+					return err
+				}
+				p.addSpan(epath, start)
+				tok = p.next()
 				if tok.err != nil {
 					return tok.err
 				}
@@ -782,8 +1099,18 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		}
 		// One value of the repeated field.
 		p.back()
+		if err := p.checkElements(fv, props); err != nil {
+			return err
+		}
+		start := p.fieldStart
+		i := fv.Len()
 		fv.Set(reflect.Append(fv, reflect.New(at.Elem()).Elem()))
-		return p.readAny(fv.Index(fv.Len()-1), props)
+		epath := path + "[" + strconv.Itoa(i) + "]"
+		if err := p.readAnyAt(epath, fv.Index(i), props); err != nil {
+			return err
+		}
+		p.addSpan(epath, start)
+		return nil
 	case reflect.Bool:
 		// true/1/t/True or false/f/0/False.
 		switch tok.value {
@@ -866,6 +1193,15 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 	return p.errorf("invalid %v: %v", v.Type(), tok.value)
 }
 
+// checkElements reports a *LimitError if appending to the repeated
+// field fv would exceed the configured maximum number of elements.
+func (p *textParser) checkElements(fv reflect.Value, props *Properties) error {
+	if p.opts == nil {
+		return nil
+	}
+	return p.opts.checkElements(fv.Len()+1, props.OrigName)
+}
+
 // UnmarshalText reads a protocol buffer in Text format. UnmarshalText resets pb
 // before starting to unmarshal, so any existing data in pb is always removed.
 // If a required field is not set and no other error occurs,
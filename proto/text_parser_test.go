@@ -186,37 +186,37 @@ var unMarshalTextTests = []UnmarshalTextTest{
 	// Bad quoted string
 	{
 		in:  `inner: < host: "\0" >` + "\n",
-		err: `line 1.15: invalid quoted string "\0": \0 requires 2 following digits`,
+		err: `line 1.16: invalid quoted string "\0": \0 requires 2 following digits`,
 	},
 
 	// Bad \u escape
 	{
 		in:  `count: 42 name: "\u000"`,
-		err: `line 1.16: invalid quoted string "\u000": \u requires 4 following digits`,
+		err: `line 1.17: invalid quoted string "\u000": \u requires 4 following digits`,
 	},
 
 	// Bad \U escape
 	{
 		in:  `count: 42 name: "\U0000000"`,
-		err: `line 1.16: invalid quoted string "\U0000000": \U requires 8 following digits`,
+		err: `line 1.17: invalid quoted string "\U0000000": \U requires 8 following digits`,
 	},
 
 	// Bad \U escape
 	{
 		in:  `count: 42 name: "\xxx"`,
-		err: `line 1.16: invalid quoted string "\xxx": \xxx contains non-hexadecimal digits`,
+		err: `line 1.17: invalid quoted string "\xxx": \xxx contains non-hexadecimal digits`,
 	},
 
 	// Number too large for int64
 	{
 		in:  "count: 1 others { key: 123456789012345678901 }",
-		err: "line 1.23: invalid int64: 123456789012345678901",
+		err: "line 1.24: invalid int64: 123456789012345678901",
 	},
 
 	// Number too large for int32
 	{
 		in:  "count: 1234567890123",
-		err: "line 1.7: invalid int32: 1234567890123",
+		err: "line 1.8: invalid int32: 1234567890123",
 	},
 
 	// Number in hexadecimal
@@ -269,31 +269,31 @@ var unMarshalTextTests = []UnmarshalTextTest{
 	// Number too large for float32
 	{
 		in:  "others:< weight: 12345678901234567890123456789012345678901234567890 >",
-		err: "line 1.17: invalid float32: 12345678901234567890123456789012345678901234567890",
+		err: "line 1.18: invalid float32: 12345678901234567890123456789012345678901234567890",
 	},
 
 	// Number posing as a quoted string
 	{
 		in:  `inner: < host: 12 >` + "\n",
-		err: `line 1.15: invalid string: 12`,
+		err: `line 1.16: invalid string: 12`,
 	},
 
 	// Quoted string posing as int32
 	{
 		in:  `count: "12"`,
-		err: `line 1.7: invalid int32: "12"`,
+		err: `line 1.8: invalid int32: "12"`,
 	},
 
 	// Quoted string posing a float32
 	{
 		in:  `others:< weight: "17.4" >`,
-		err: `line 1.17: invalid float32: "17.4"`,
+		err: `line 1.18: invalid float32: "17.4"`,
 	},
 
 	// unclosed bracket doesn't cause infinite loop
 	{
 		in:  `[`,
-		err: `line 1.0: unclosed type_url or extension name`,
+		err: `line 1.1: unclosed type_url or extension name`,
 	},
 
 	// Enum
@@ -351,13 +351,13 @@ var unMarshalTextTests = []UnmarshalTextTest{
 	// Missing colon for string field
 	{
 		in:  `name "Dave"`,
-		err: `line 1.5: expected ':', found "\"Dave\""`,
+		err: `line 1.6: expected ':', found "\"Dave\""`,
 	},
 
 	// Missing colon for int32 field
 	{
 		in:  `count 42`,
-		err: `line 1.6: expected ':', found "42"`,
+		err: `line 1.7: expected ':', found "42"`,
 	},
 
 	// Missing required field
@@ -382,7 +382,7 @@ var unMarshalTextTests = []UnmarshalTextTest{
 	// Repeated non-repeated field
 	{
 		in:  `name: "Rob" name: "Russ"`,
-		err: `line 1.12: non-repeated field "name" was repeated`,
+		err: `line 1.13: non-repeated field "name" was repeated`,
 	},
 
 	// Group
@@ -671,7 +671,7 @@ func TestOneofParsing(t *testing.T) {
 
 	const inOverwrite = `name:"Shrek" number:42`
 	m = new(Communique)
-	testErr := "line 1.13: field 'number' would overwrite already parsed oneof 'Union'"
+	testErr := "line 1.14: field 'number' would overwrite already parsed oneof 'Union'"
 	if err := UnmarshalText(inOverwrite, m); err == nil {
 		t.Errorf("TestOneofParsing: Didn't get expected error: %v", testErr)
 	} else if err.Error() != testErr {
@@ -704,3 +704,151 @@ func BenchmarkUnmarshalText(b *testing.B) {
 	}
 	b.SetBytes(int64(len(benchInput)))
 }
+
+func TestUnmarshalTextAllErrors(t *testing.T) {
+	const in = `count: 42
+name: 12
+inner {
+  host: "cauchy"
+  port: "x"
+  colour: red
+}
+pet: "bunny"; pet: [1, "kitty"]
+quote: "unclosed
+others < key: "one" >
+bikeshed: BLUE
+`
+	opts := UnmarshalOptions{AllErrors: true}
+	pb := new(MyMessage)
+	err := opts.UnmarshalText(in, pb)
+	errs, ok := err.(ParseErrors)
+	if !ok {
+		t.Fatalf("UnmarshalText: got %v, want ParseErrors", err)
+	}
+	want := []struct {
+		line, column int
+		message      string
+	}{
+		{2, 7, "invalid string: 12"},
+		{5, 9, `invalid int32: "x"`},
+		{6, 3, `unknown field name "colour" in test_proto.InnerMessage`},
+		{8, 21, `invalid string: 1`},
+		{9, 8, "unmatched quote"},
+		{10, 15, `invalid int64: "one"`},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("UnmarshalText: got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, w := range want {
+		e := errs[i]
+		if e.Line != w.line || e.Column != w.column || e.Message != w.message {
+			t.Errorf("error %d: got %d:%d: %s, want %d:%d: %s", i, e.Line, e.Column, e.Message, w.line, w.column, w.message)
+		}
+	}
+	wantErr := `line 2.7: invalid string: 12
+line 5.9: invalid int32: "x"
+line 6.3: unknown field name "colour" in test_proto.InnerMessage
+line 8.21: invalid string: 1
+line 9.8: unmatched quote
+line 10.15: invalid int64: "one"`
+	if err.Error() != wantErr {
+		t.Errorf("Error() = %q, want %q", err.Error(), wantErr)
+	}
+
+	// The fields without errors are still read. Fields with errors may
+	// be left set to zero values.
+	wantPB := &MyMessage{
+		Count:    Int32(42),
+		Name:     String(""),
+		Inner:    &InnerMessage{Host: String("cauchy"), Port: Int32(0)},
+		Pet:      []string{"bunny", ""},
+		Others:   []*OtherMessage{{Key: Int64(0)}},
+		Bikeshed: MyMessage_BLUE.Enum(),
+	}
+	if !Equal(pb, wantPB) {
+		t.Errorf("UnmarshalText: got %v, want %v", pb, wantPB)
+	}
+
+	// Without AllErrors, parsing stops at the first error.
+	err = new(UnmarshalOptions).UnmarshalText(in, pb)
+	if pe, ok := err.(*ParseError); !ok || pe.Line != 2 || pe.Column != 7 {
+		t.Errorf("UnmarshalText without AllErrors: got %#v, want line 2, column 7", err)
+	}
+}
+
+func TestUnmarshalTextAllErrorsEOF(t *testing.T) {
+	opts := UnmarshalOptions{AllErrors: true}
+	err := opts.UnmarshalText("count: x\ninner { host: 1\n", new(MyMessage))
+	errs, ok := err.(ParseErrors)
+	if !ok || len(errs) != 3 {
+		t.Fatalf("UnmarshalText: got %v, want 3 ParseErrors", err)
+	}
+	if got := errs[2].Message; got != "unexpected EOF" {
+		t.Errorf("last error: got %q, want %q", got, "unexpected EOF")
+	}
+}
+
+func TestUnmarshalTextSpans(t *testing.T) {
+	const in = `count: 42
+inner <
+  host: "cauchy"
+>
+pet: "horsey"
+pet: ["bunny", "kitty"]
+others { key: 3 }
+[test_proto.Ext.text]: "ext"
+`
+	spans, err := new(UnmarshalOptions).UnmarshalTextWithSpans(in, new(MyMessage))
+	if err != nil {
+		t.Fatalf("UnmarshalTextWithSpans: %v", err)
+	}
+	pos := func(line, column, offset int) TextPosition {
+		return TextPosition{Line: line, Column: column, Offset: offset}
+	}
+	want := map[string]TextSpan{
+		"count":                 {pos(1, 1, 0), pos(1, 10, 9)},
+		"inner":                 {pos(2, 1, 10), pos(4, 2, 36)},
+		"inner.host":            {pos(3, 3, 20), pos(3, 17, 34)},
+		"pet[0]":                {pos(5, 1, 37), pos(5, 14, 50)},
+		"pet[1]":                {pos(6, 7, 57), pos(6, 14, 64)},
+		"pet[2]":                {pos(6, 16, 66), pos(6, 23, 73)},
+		"others[0]":             {pos(7, 1, 75), pos(7, 18, 92)},
+		"others[0].key":         {pos(7, 10, 84), pos(7, 16, 90)},
+		"[test_proto.Ext.text]": {pos(8, 1, 93), pos(8, 29, 121)},
+	}
+	for path, w := range want {
+		if got, ok := spans[path]; !ok {
+			t.Errorf("no span for %q", path)
+		} else if got != w {
+			t.Errorf("span of %q: got %+v, want %+v", path, got, w)
+		}
+	}
+	for path := range spans {
+		if _, ok := want[path]; !ok {
+			t.Errorf("unexpected span for %q", path)
+		}
+	}
+	for path, span := range spans {
+		if s := in[span.Start.Offset:span.End.Offset]; path == "inner.host" && s != `host: "cauchy"` {
+			t.Errorf("text of %q: got %q", path, s)
+		}
+	}
+}
+
+func TestUnmarshalTextMapSpans(t *testing.T) {
+	const in = `msg_mapping { value { f: 1.5 } key: 7 }
+str_to_str { key: "a" value: "b" }
+`
+	spans, err := new(UnmarshalOptions).UnmarshalTextWithSpans(in, new(MessageWithMap))
+	if err != nil {
+		t.Fatalf("UnmarshalTextWithSpans: %v", err)
+	}
+	for _, path := range []string{"msg_mapping[7]", "msg_mapping[7].f", `str_to_str["a"]`} {
+		if _, ok := spans[path]; !ok {
+			t.Errorf("no span for %q; have %v", path, spans)
+		}
+	}
+	if len(spans) != 3 {
+		t.Errorf("got %d spans, want 3: %v", len(spans), spans)
+	}
+}
@@ -516,3 +516,92 @@ func TestRacyMarshal(t *testing.T) {
 		}()
 	}
 }
+
+func TestTextMarshalerOptions(t *testing.T) {
+	unknown := []byte{
+		0xf2, 0x01, 9, 0x08, 0x96, 0x01, 0x12, 4, 'h', 'e', 'y', '!', // 30: <1: 150 2: "hey!">
+		0xfa, 0x01, 5, 'h', 'e', 'l', 'l', 'o', // 31: "hello"
+		0x82, 0x02, 1, 0xff, // 32: "\xff"
+	}
+	tests := []struct {
+		name string
+		tm   proto.TextMarshaler
+		m    proto.Message
+		want string
+	}{{
+		name: "FieldNumbers",
+		tm:   proto.TextMarshaler{FieldNumbers: true},
+		m: &pb.MyMessage{
+			Count: proto.Int32(42),
+			Pet:   []string{"bunny"},
+			Inner: &pb.InnerMessage{Host: proto.String("footrest")},
+		},
+		want: "count: 42 # 1\npet: \"bunny\" # 4\ninner: < # 5\n  host: \"footrest\" # 1\n>\n",
+	}, {
+		name: "FieldNumbers compact",
+		tm:   proto.TextMarshaler{FieldNumbers: true, Compact: true},
+		m:    &pb.MyMessage{Count: proto.Int32(42)},
+		want: "count:42 ",
+	}, {
+		name: "HexBytes",
+		tm:   proto.TextMarshaler{HexBytes: true},
+		m:    &proto3pb.Message{Name: "\xff", Data: []byte("a\x00\xfe\n")},
+		want: "name: \"\\377\"\ndata: \"a\\x00\\xfe\\n\"\n",
+	}, {
+		name: "EmitDefaults",
+		tm:   proto.TextMarshaler{EmitDefaults: true, Compact: true},
+		m:    &proto3pb.Message{Key: []uint64{}, Nested: &proto3pb.Nested{}},
+		want: `name:"" hilarity:UNKNOWN height_in_cm:0 data:"" result_count:0 true_scotsman:false score:0 ` +
+			`nested:<bunny:"" cute:false > `,
+	}, {
+		name: "EmitDefaults proto2",
+		tm:   proto.TextMarshaler{EmitDefaults: true, Compact: true},
+		m:    &pb.MyMessage{Count: proto.Int32(0)},
+		want: "count:0 ",
+	}, {
+		name: "DecodeUnknown",
+		tm:   proto.TextMarshaler{DecodeUnknown: true},
+		m:    &proto3pb.Nested{Bunny: "x", XXX_unrecognized: unknown},
+		want: "bunny: \"x\"\n/* 24 unknown bytes */\n30: <\n  1: 150\n  2: \"hey!\"\n>\n31: \"hello\"\n32: \"\\xff\"\n",
+	}, {
+		name: "unknown",
+		tm:   proto.TextMarshaler{},
+		m:    &proto3pb.Nested{Bunny: "x", XXX_unrecognized: unknown},
+		want: "bunny: \"x\"\n/* 24 unknown bytes */\n30: \"\\b\\x96\\x01\\x12\\x04hey!\"\n31: \"hello\"\n32: \"\\xff\"\n",
+	}, {
+		name: "Width",
+		tm:   proto.TextMarshaler{Width: 20},
+		m: &pb.MyMessage{
+			Name:  proto.String("The quick brown fox jumps"),
+			Inner: &pb.InnerMessage{Host: proto.String("\x00\x01\x02\x03\x04")},
+		},
+		want: "name: \"The quick br\"\n  \"own fox jumps\"\n" +
+			"inner: <\n  host: \"\\000\\001\"\n    \"\\002\\003\\004\"\n>\n",
+	}}
+	for _, test := range tests {
+		if got := test.tm.Text(test.m); got != test.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTextWidthRoundTrip(t *testing.T) {
+	in := &pb.MyMessage{
+		Count: proto.Int32(1),
+		Name:  proto.String(strings.Repeat("all work and no play \x01\"\n", 10)),
+	}
+	tm := proto.TextMarshaler{Width: 30}
+	text := tm.Text(in)
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		if len(line) > 30 {
+			t.Errorf("line %q is longer than 30 bytes", line)
+		}
+	}
+	out := new(pb.MyMessage)
+	if err := proto.UnmarshalText(text, out); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if !proto.Equal(in, out) {
+		t.Errorf("round trip: got %v, want %v", out, in)
+	}
+}
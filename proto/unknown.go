@@ -0,0 +1,217 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Inspection and editing of unknown fields.
+ */
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// UnknownField is a field preserved in a message's XXX_unrecognized
+// data because the message type doesn't know its field number.
+type UnknownField struct {
+	Number   int32
+	WireType int // WireVarint, WireFixed64, WireBytes, WireStartGroup or WireFixed32
+
+	// Value is the encoded value that follows the tag:
+	// the varint for WireVarint, the 8 or 4 little-endian bytes for
+	// WireFixed64 and WireFixed32, the contents without the length prefix
+	// for WireBytes, and the fields without the end-group tag for
+	// WireStartGroup.
+	Value []byte
+}
+
+// UnknownFields is a sequence of unknown fields, in encoding order.
+type UnknownFields []UnknownField
+
+// errNoUnrecognized is returned for messages that can't hold unknown fields.
+var errNoUnrecognized = errors.New("proto: message has no XXX_unrecognized field")
+
+// ParseUnknownFields splits b, a sequence of encoded fields, into fields.
+// The Value slices of the returned fields refer to b.
+func ParseUnknownFields(b []byte) (UnknownFields, error) {
+	var fs UnknownFields
+	for len(b) > 0 {
+		x, n := decodeVarint(b)
+		if n == 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		b = b[n:]
+		num, wire := int32(x>>3), int(x&7)
+		if num <= 0 || x>>3 > maxFieldNumber {
+			return nil, fmt.Errorf("proto: illegal field number %d in unknown fields", x>>3)
+		}
+		var v []byte
+		switch wire {
+		case WireBytes:
+			m, k := decodeVarint(b)
+			if k == 0 || uint64(len(b)-k) < m {
+				return nil, io.ErrUnexpectedEOF
+			}
+			v, b = b[k:uint64(k)+m], b[uint64(k)+m:]
+		case WireStartGroup:
+			i, j := findEndGroup(b)
+			if i == -1 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			v, b = b[:i], b[j:]
+		default:
+			rest, err := skipField(b, wire)
+			if err != nil {
+				return nil, err
+			}
+			v, b = b[:len(b)-len(rest)], rest
+		}
+		fs = append(fs, UnknownField{Number: num, WireType: wire, Value: v})
+	}
+	return fs, nil
+}
+
+// maxFieldNumber is the largest valid field number.
+const maxFieldNumber = 1<<29 - 1
+
+// Bytes returns the encoding of the fields,
+// in the form stored in XXX_unrecognized.
+func (fs UnknownFields) Bytes() []byte {
+	var b []byte
+	for _, f := range fs {
+		b = appendVarint(b, uint64(f.Number)<<3|uint64(f.WireType))
+		switch f.WireType {
+		case WireBytes:
+			b = appendVarint(b, uint64(len(f.Value)))
+			b = append(b, f.Value...)
+		case WireStartGroup:
+			b = append(b, f.Value...)
+			b = appendVarint(b, uint64(f.Number)<<3|WireEndGroup)
+		default:
+			b = append(b, f.Value...)
+		}
+	}
+	return b
+}
+
+// Get returns the fields with field number num.
+func (fs UnknownFields) Get(num int32) UnknownFields {
+	var r UnknownFields
+	for _, f := range fs {
+		if f.Number == num {
+			r = append(r, f)
+		}
+	}
+	return r
+}
+
+// Remove returns the fields whose numbers are not among nums.
+// fs is not modified.
+func (fs UnknownFields) Remove(nums ...int32) UnknownFields {
+	var r UnknownFields
+outer:
+	for _, f := range fs {
+		for _, n := range nums {
+			if f.Number == n {
+				continue outer
+			}
+		}
+		r = append(r, f)
+	}
+	return r
+}
+
+// Renumber returns the fields with field number from changed to to.
+// fs is not modified.
+func (fs UnknownFields) Renumber(from, to int32) UnknownFields {
+	r := make(UnknownFields, len(fs))
+	copy(r, fs)
+	for i := range r {
+		if r[i].Number == from {
+			r[i].Number = to
+		}
+	}
+	return r
+}
+
+// GetUnknownFields returns the unknown fields of pb, which must be a
+// pointer to a generated message struct. The unknown fields of nested
+// messages are not included; call GetUnknownFields on them instead.
+// The Value slices of the returned fields refer to pb's data,
+// so they must not be modified.
+func GetUnknownFields(pb Message) (UnknownFields, error) {
+	u, err := unrecognizedField(pb)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnknownFields(u.Bytes())
+}
+
+// SetUnknownFields replaces the unknown fields of pb, which must be a
+// pointer to a generated message struct, with fs.
+// Setting no fields removes all unknown fields from pb,
+// leaving nested messages as they are.
+// Unknown fields whose numbers are known to pb's type are decoded as
+// that field when the message is next marshaled and unmarshaled.
+func SetUnknownFields(pb Message, fs UnknownFields) error {
+	u, err := unrecognizedField(pb)
+	if err != nil {
+		return err
+	}
+	for _, f := range fs {
+		if f.Number <= 0 || uint64(f.Number) > maxFieldNumber {
+			return fmt.Errorf("proto: illegal field number %d in unknown fields", f.Number)
+		}
+		switch f.WireType {
+		case WireVarint, WireFixed64, WireBytes, WireStartGroup, WireFixed32:
+		default:
+			return fmt.Errorf("proto: illegal wire type %d for unknown field %d", f.WireType, f.Number)
+		}
+	}
+	u.SetBytes(fs.Bytes())
+	return nil
+}
+
+// unrecognizedField returns the settable XXX_unrecognized field of pb.
+func unrecognizedField(pb Message) (reflect.Value, error) {
+	v := reflect.ValueOf(pb)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, ErrNil
+	}
+	u := v.Elem().FieldByName("XXX_unrecognized")
+	if !u.IsValid() || u.Type() != reflect.TypeOf([]byte(nil)) {
+		return reflect.Value{}, errNoUnrecognized
+	}
+	return u, nil
+}
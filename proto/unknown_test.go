@@ -0,0 +1,138 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+func TestUnknownFields(t *testing.T) {
+	b := NewBuffer(nil)
+	b.EncodeVarint(1<<3 | WireBytes) // known: bunny
+	b.EncodeStringBytes("hop")
+	b.EncodeVarint(3<<3 | WireVarint)
+	b.EncodeVarint(150)
+	b.EncodeVarint(4<<3 | WireBytes)
+	b.EncodeStringBytes("data")
+	b.EncodeVarint(5<<3 | WireFixed64)
+	b.EncodeFixed64(7)
+	b.EncodeVarint(6<<3 | WireFixed32)
+	b.EncodeFixed32(8)
+	b.EncodeVarint(7<<3 | WireStartGroup)
+	b.EncodeVarint(1<<3 | WireVarint)
+	b.EncodeVarint(1)
+	b.EncodeVarint(7<<3 | WireEndGroup)
+	b.EncodeVarint(3<<3 | WireVarint)
+	b.EncodeVarint(2)
+
+	m := new(proto3pb.Nested)
+	if err := Unmarshal(b.Bytes(), m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	fs, err := GetUnknownFields(m)
+	if err != nil {
+		t.Fatalf("GetUnknownFields: %v", err)
+	}
+	want := UnknownFields{
+		{Number: 3, WireType: WireVarint, Value: []byte{0x96, 0x01}},
+		{Number: 4, WireType: WireBytes, Value: []byte("data")},
+		{Number: 5, WireType: WireFixed64, Value: []byte{7, 0, 0, 0, 0, 0, 0, 0}},
+		{Number: 6, WireType: WireFixed32, Value: []byte{8, 0, 0, 0}},
+		{Number: 7, WireType: WireStartGroup, Value: []byte{0x08, 0x01}},
+		{Number: 3, WireType: WireVarint, Value: []byte{0x02}},
+	}
+	if !reflect.DeepEqual(fs, want) {
+		t.Fatalf("GetUnknownFields:\ngot  %v\nwant %v", fs, want)
+	}
+	if !bytes.Equal(fs.Bytes(), m.XXX_unrecognized) {
+		t.Errorf("Bytes: got %x, want %x", fs.Bytes(), m.XXX_unrecognized)
+	}
+	if got := fs.Get(3); len(got) != 2 || got[0].Number != 3 || got[1].Number != 3 {
+		t.Errorf("Get(3): got %v", got)
+	}
+
+	// Scrub two fields and move field 3 to field 2, which is known (cute).
+	fs = fs.Remove(4, 7).Renumber(3, 2)
+	if err := SetUnknownFields(m, fs); err != nil {
+		t.Fatalf("SetUnknownFields: %v", err)
+	}
+	out, err := Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	m2 := new(proto3pb.Nested)
+	if err := Unmarshal(out, m2); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if m2.Bunny != "hop" || !m2.Cute {
+		t.Errorf("after renumbering: got %v, want bunny and cute set", m2)
+	}
+	fs2, err := GetUnknownFields(m2)
+	if err != nil {
+		t.Fatalf("GetUnknownFields: %v", err)
+	}
+	if want := (UnknownFields{want[2], want[3]}); !reflect.DeepEqual(fs2, want) {
+		t.Errorf("GetUnknownFields after edits:\ngot  %v\nwant %v", fs2, want)
+	}
+
+	if err := SetUnknownFields(m2, nil); err != nil {
+		t.Fatalf("SetUnknownFields(nil): %v", err)
+	}
+	if len(m2.XXX_unrecognized) != 0 {
+		t.Errorf("SetUnknownFields(nil) left %x", m2.XXX_unrecognized)
+	}
+}
+
+func TestUnknownFieldsErrors(t *testing.T) {
+	if _, err := ParseUnknownFields([]byte{4<<3 | WireBytes, 5, 'a'}); err != io.ErrUnexpectedEOF {
+		t.Errorf("ParseUnknownFields(truncated bytes): got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if _, err := ParseUnknownFields([]byte{7<<3 | WireStartGroup, 0x08, 0x01}); err != io.ErrUnexpectedEOF {
+		t.Errorf("ParseUnknownFields(unterminated group): got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if _, err := ParseUnknownFields([]byte{0 | WireVarint, 1}); err == nil {
+		t.Errorf("ParseUnknownFields(field 0): got nil error")
+	}
+	m := new(proto3pb.Nested)
+	if err := SetUnknownFields(m, UnknownFields{{Number: 3, WireType: 6}}); err == nil {
+		t.Errorf("SetUnknownFields(bad wire type): got nil error")
+	}
+	if err := SetUnknownFields(m, UnknownFields{{Number: 0, WireType: WireVarint, Value: []byte{1}}}); err == nil {
+		t.Errorf("SetUnknownFields(field 0): got nil error")
+	}
+}
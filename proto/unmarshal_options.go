@@ -0,0 +1,447 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Resource limits for decoding untrusted input.
+ */
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+)
+
+// UnmarshalOptions configures decoding of the binary, text and JSON
+// formats with limits suitable for untrusted input.
+// A limit of zero means no limit, so the zero value behaves like
+// Unmarshal and UnmarshalText.
+// Exceeding a limit produces a *LimitError.
+type UnmarshalOptions struct {
+	// MaxDepth is the maximum nesting depth of messages and groups.
+	// The top-level message has depth 1.
+	MaxDepth int
+
+	// MaxBytes is the maximum size of the encoded input, in bytes.
+	MaxBytes int
+
+	// MaxElements is the maximum number of elements in any single
+	// repeated field or map of any single message.
+	MaxElements int
+
+	// DiscardUnknown drops fields that are not known to the message.
+	// In the binary format, DiscardUnknown is called on the message after
+	// decoding, so UnmarshalMerge also drops the unknown fields that the
+	// message held before the call. In the text format, unknown fields
+	// are skipped instead of failing the parse.
+	DiscardUnknown bool
+
+	// AliasBuffer makes bytes fields decoded from the binary format
+	// refer to the input buffer instead of copies of it, which avoids
+	// an allocation and a copy per field. String fields are still
+	// copied, since Go strings must not change.
+	// The input must not be modified for as long as the message is in use.
+	// In particular, messages read by a DelimitedReader, which reuses its
+	// buffer, must not be decoded this way.
+	// Messages with a custom Unmarshal method are decoded as usual.
+	AliasBuffer bool
+
+	// AllErrors makes UnmarshalText go on after an error in the text
+	// format and report every error found as ParseErrors. Parsing resumes
+	// at the next field, which is taken to follow a semicolon or start on
+	// a new line, as in hand-written files.
+	AllErrors bool
+}
+
+// The limits reported by LimitError.
+const (
+	LimitDepth    = "depth"
+	LimitBytes    = "bytes"
+	LimitElements = "elements"
+)
+
+// LimitError is returned when the input exceeds one of the limits
+// configured in UnmarshalOptions.
+type LimitError struct {
+	Limit string // LimitDepth, LimitBytes or LimitElements
+	Max   int    // the configured maximum
+	Field string // the field being decoded, if known
+}
+
+func (e *LimitError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("proto: input exceeds maximum %s of %d", e.Limit, e.Max)
+	}
+	return fmt.Sprintf("proto: field %q exceeds maximum %s of %d", e.Field, e.Limit, e.Max)
+}
+
+// Unmarshal is like the top-level Unmarshal function, but applies the options.
+// The input is checked against the limits before anything is decoded into pb.
+func (o *UnmarshalOptions) Unmarshal(b []byte, pb Message) error {
+	pb.Reset()
+	return o.UnmarshalMerge(b, pb)
+}
+
+// UnmarshalMerge is like the top-level UnmarshalMerge function, but applies the options.
+// The input is checked against the limits before anything is decoded into pb.
+func (o *UnmarshalOptions) UnmarshalMerge(b []byte, pb Message) error {
+	if err := o.checkBytes(len(b)); err != nil {
+		return err
+	}
+	if o.MaxDepth > 0 || o.MaxElements > 0 {
+		if err := o.checkWire(b, reflect.TypeOf(pb)); err != nil {
+			return err
+		}
+	}
+	var err error
+	if o.AliasBuffer {
+		err = unmarshalAliasing(b, pb)
+	} else {
+		err = UnmarshalMerge(b, pb)
+	}
+	if o.DiscardUnknown {
+		DiscardUnknown(pb)
+	}
+	return err
+}
+
+// unmarshalAliasing merges b into pb using the aliasing unmarshaler.
+func unmarshalAliasing(b []byte, pb Message) error {
+	if _, ok := pb.(Unmarshaler); ok {
+		return UnmarshalMerge(b, pb)
+	}
+	t := reflect.TypeOf(pb)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return UnmarshalMerge(b, pb)
+	}
+	return getAliasingUnmarshalInfo(t.Elem()).unmarshal(toPointer(&pb), b)
+}
+
+// UnmarshalText is like the top-level UnmarshalText function, but applies the options.
+func (o *UnmarshalOptions) UnmarshalText(s string, pb Message) error {
+	return o.unmarshalText(s, pb, nil)
+}
+
+// UnmarshalTextWithSpans is like UnmarshalText, but also returns the
+// TextSpan of each field read, keyed by its path in the form of
+// Difference.Path. The spans are returned even if there is an error.
+func (o *UnmarshalOptions) UnmarshalTextWithSpans(s string, pb Message) (map[string]TextSpan, error) {
+	spans := make(map[string]TextSpan)
+	err := o.unmarshalText(s, pb, spans)
+	return spans, err
+}
+
+// unmarshalText parses s into pb, recording the spans of the fields
+// in spans if it is not nil.
+func (o *UnmarshalOptions) unmarshalText(s string, pb Message, spans map[string]TextSpan) error {
+	if err := o.checkBytes(len(s)); err != nil {
+		return err
+	}
+	pb.Reset()
+	p := newTextParser(s)
+	p.opts = o
+	p.spans = spans
+	err := p.readStruct(reflect.ValueOf(pb).Elem(), "")
+	if _, ok := err.(*LimitError); !ok && len(p.errs) > 0 {
+		return p.errs
+	}
+	return err
+}
+
+// checkBytes reports a *LimitError if an input of n bytes exceeds MaxBytes.
+func (o *UnmarshalOptions) checkBytes(n int) error {
+	if o.MaxBytes > 0 && n > o.MaxBytes {
+		return &LimitError{Limit: LimitBytes, Max: o.MaxBytes}
+	}
+	return nil
+}
+
+// checkDepth reports a *LimitError if a message at the given nesting
+// depth exceeds MaxDepth. field names the message field, if known.
+func (o *UnmarshalOptions) checkDepth(depth int, field string) error {
+	if o.MaxDepth > 0 && depth > o.MaxDepth {
+		return &LimitError{Limit: LimitDepth, Max: o.MaxDepth, Field: field}
+	}
+	return nil
+}
+
+// checkElements reports a *LimitError if a repeated field or map
+// holding n elements exceeds MaxElements. field names the field, if known.
+func (o *UnmarshalOptions) checkElements(n int, field string) error {
+	if o.MaxElements > 0 && n > o.MaxElements {
+		return &LimitError{Limit: LimitElements, Max: o.MaxElements, Field: field}
+	}
+	return nil
+}
+
+// checkWire walks the binary encoding of a message of type t
+// (a pointer to a struct), enforcing MaxDepth and MaxElements.
+// Messages that are not generated structs are not checked.
+func (o *UnmarshalOptions) checkWire(b []byte, t reflect.Type) error {
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	if err := o.checkDepth(1, ""); err != nil {
+		return err
+	}
+	_, err := o.checkLimits(b, getLimitInfo(t.Elem()), 1, false)
+	return err
+}
+
+// checkLimits walks the fields in b, which belong to a message described by info
+// (nil if the message type is unknown) at the given depth.
+// If group is set, the walk stops after the matching end-group tag and
+// the data following it is returned.
+func (o *UnmarshalOptions) checkLimits(b []byte, info *limitInfo, depth int, group bool) ([]byte, error) {
+	var counts map[uint64]int
+	for len(b) > 0 {
+		x, n := decodeVarint(b)
+		if n == 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		b = b[n:]
+		tag, wire := x>>3, int(x&7)
+		if wire == WireEndGroup {
+			if group {
+				return b, nil
+			}
+			return nil, fmt.Errorf("proto: unexpected end group")
+		}
+
+		fi := info.field(tag)
+		if fi == nil {
+			if wire == WireStartGroup {
+				// Unknown groups still nest.
+				if err := o.checkDepth(depth+1, ""); err != nil {
+					return nil, err
+				}
+				var err error
+				if b, err = o.checkLimits(b, nil, depth+1, true); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			var err error
+			if b, err = skipField(b, wire); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		b0 := b
+		var err error
+		if wire == WireStartGroup {
+			if err := o.checkDepth(depth+1, fi.name); err != nil {
+				return nil, err
+			}
+			if b, err = o.checkLimits(b, fi.sub, depth+1, true); err != nil {
+				return nil, err
+			}
+		} else if b, err = skipField(b, wire); err != nil {
+			return nil, err
+		}
+
+		if fi.repeated && o.MaxElements > 0 {
+			if counts == nil {
+				counts = make(map[uint64]int)
+			}
+			k := 1
+			if wire == WireBytes && fi.packable {
+				k = countPacked(payload(b0, b), fi.elemWire)
+			}
+			counts[tag] += k
+			if err := o.checkElements(counts[tag], fi.name); err != nil {
+				return nil, err
+			}
+		}
+
+		if wire != WireBytes {
+			continue
+		}
+		switch {
+		case fi.entry != nil:
+			// Map entries don't count toward the depth; their values do.
+			if _, err := o.checkLimits(payload(b0, b), fi.entry, depth, false); err != nil {
+				return nil, err
+			}
+		case fi.sub != nil:
+			if err := o.checkDepth(depth+1, fi.name); err != nil {
+				return nil, err
+			}
+			if _, err := o.checkLimits(payload(b0, b), fi.sub, depth+1, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if group {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return nil, nil
+}
+
+// payload returns the contents of the length-delimited field that was
+// skipped from b0, leaving b.
+func payload(b0, b []byte) []byte {
+	_, n := decodeVarint(b0)
+	return b0[n : len(b0)-len(b)]
+}
+
+// countPacked returns the number of elements in a packed repeated field.
+func countPacked(b []byte, wire int) int {
+	switch wire {
+	case WireFixed32:
+		return len(b) / 4
+	case WireFixed64:
+		return len(b) / 8
+	}
+	n := 0
+	for _, c := range b {
+		if c < 0x80 {
+			n++
+		}
+	}
+	return n
+}
+
+// limitInfo describes the fields of a message type that matter
+// when checking limits.
+type limitInfo struct {
+	typ    reflect.Type // nil for map entries
+	fields map[uint64]*limitFieldInfo
+}
+
+type limitFieldInfo struct {
+	name     string     // qualified name, for error reporting
+	repeated bool       // repeated field or map
+	packable bool       // repeated scalar field that may be packed
+	elemWire int        // wire type of the elements of a packable field
+	sub      *limitInfo // message or group type, or nil
+	entry    *limitInfo // map entry, or nil
+}
+
+var (
+	limitInfoMap  = map[reflect.Type]*limitInfo{}
+	limitInfoLock sync.Mutex
+)
+
+// getLimitInfo returns the limitInfo for the struct type t.
+func getLimitInfo(t reflect.Type) *limitInfo {
+	limitInfoLock.Lock()
+	defer limitInfoLock.Unlock()
+	return getLimitInfoLocked(t)
+}
+
+func getLimitInfoLocked(t reflect.Type) *limitInfo {
+	if li, ok := limitInfoMap[t]; ok {
+		return li
+	}
+	li := &limitInfo{typ: t, fields: map[uint64]*limitFieldInfo{}}
+	limitInfoMap[t] = li
+
+	prefix := revProtoTypes[reflect.PtrTo(t)]
+	if prefix == "" {
+		prefix = t.Name()
+	}
+	sprop := GetProperties(t)
+	for i, p := range sprop.Prop {
+		if p.Tag > 0 {
+			li.fields[uint64(p.Tag)] = newLimitFieldInfo(prefix+"."+p.OrigName, p, t.Field(i).Type)
+		}
+	}
+	for _, oop := range sprop.OneofTypes {
+		li.fields[uint64(oop.Prop.Tag)] = newLimitFieldInfo(prefix+"."+oop.Prop.OrigName, oop.Prop, oop.Type.Elem().Field(0).Type)
+	}
+	return li
+}
+
+func newLimitFieldInfo(name string, p *Properties, t reflect.Type) *limitFieldInfo {
+	fi := &limitFieldInfo{name: name}
+	switch t.Kind() {
+	case reflect.Map:
+		fi.repeated = true
+		fi.entry = &limitInfo{fields: map[uint64]*limitFieldInfo{}}
+		if vt := t.Elem(); vt.Kind() == reflect.Ptr && vt.Elem().Kind() == reflect.Struct {
+			fi.entry.fields[2] = &limitFieldInfo{name: fi.name, sub: getLimitInfoLocked(vt.Elem())}
+		}
+		return fi
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return fi // bytes
+		}
+		fi.repeated = true
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+		fi.sub = getLimitInfoLocked(t.Elem())
+		return fi
+	}
+	if fi.repeated {
+		switch p.Wire {
+		case "fixed32":
+			fi.packable, fi.elemWire = true, WireFixed32
+		case "fixed64":
+			fi.packable, fi.elemWire = true, WireFixed64
+		case "varint", "zigzag32", "zigzag64":
+			fi.packable, fi.elemWire = true, WireVarint
+		}
+	}
+	return fi
+}
+
+// field returns the information for the field with the given tag,
+// consulting the registered extensions and extension resolvers if the
+// tag is not a regular field.
+// It returns nil for unknown fields.
+func (li *limitInfo) field(tag uint64) *limitFieldInfo {
+	if li == nil {
+		return nil
+	}
+	if fi := li.fields[tag]; fi != nil {
+		return fi
+	}
+	if li.typ == nil {
+		return nil
+	}
+	desc := registeredExtension(li.typ, int32(tag))
+	if desc == nil {
+		desc = resolveExtension(reflect.Zero(reflect.PtrTo(li.typ)).Interface().(Message), int32(tag))
+	}
+	if desc == nil {
+		return nil
+	}
+	var p Properties
+	p.Parse(desc.Tag)
+	limitInfoLock.Lock()
+	defer limitInfoLock.Unlock()
+	return newLimitFieldInfo(desc.Name, &p, reflect.TypeOf(desc.ExtensionType))
+}
@@ -0,0 +1,245 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/test_proto"
+)
+
+// nestedMessage returns a chain of depth messages linked through Submessage.
+func nestedMessage(depth int) *proto3pb.Message {
+	m := &proto3pb.Message{Name: "leaf"}
+	for i := 1; i < depth; i++ {
+		m = &proto3pb.Message{Submessage: m}
+	}
+	return m
+}
+
+func TestUnmarshalOptionsLimits(t *testing.T) {
+	withMap := &proto3pb.Message{Terrain: map[string]*proto3pb.Nested{
+		"a": {Bunny: "a"}, "b": {Bunny: "b"}, "c": {Cute: true},
+	}}
+	tests := []struct {
+		desc  string
+		opts  proto.UnmarshalOptions
+		in    proto.Message
+		limit string // expected LimitError.Limit, or "" for success
+	}{
+		{"no limits", proto.UnmarshalOptions{}, nestedMessage(50), ""},
+		{"depth within limit", proto.UnmarshalOptions{MaxDepth: 5}, nestedMessage(5), ""},
+		{"depth exceeded", proto.UnmarshalOptions{MaxDepth: 5}, nestedMessage(6), proto.LimitDepth},
+		{"depth in repeated", proto.UnmarshalOptions{MaxDepth: 2}, &proto3pb.Message{
+			Children: []*proto3pb.Message{{Nested: &proto3pb.Nested{}}},
+		}, proto.LimitDepth},
+		{"depth in map value", proto.UnmarshalOptions{MaxDepth: 1}, withMap, proto.LimitDepth},
+		{"bytes within limit", proto.UnmarshalOptions{MaxBytes: 100}, &proto3pb.Message{Name: "x"}, ""},
+		{"bytes exceeded", proto.UnmarshalOptions{MaxBytes: 10}, &proto3pb.Message{Name: strings.Repeat("x", 10)}, proto.LimitBytes},
+		{"elements within limit", proto.UnmarshalOptions{MaxElements: 3}, &proto3pb.Message{Key: []uint64{1, 2, 300}}, ""},
+		{"packed elements exceeded", proto.UnmarshalOptions{MaxElements: 3}, &proto3pb.Message{Key: []uint64{1, 2, 300, 4}}, proto.LimitElements},
+		{"unpacked elements exceeded", proto.UnmarshalOptions{MaxElements: 1}, &proto3pb.Message{
+			Children: []*proto3pb.Message{{}, {}},
+		}, proto.LimitElements},
+		{"map entries exceeded", proto.UnmarshalOptions{MaxElements: 2}, withMap, proto.LimitElements},
+		{"nested elements exceeded", proto.UnmarshalOptions{MaxElements: 2}, &proto3pb.Message{
+			Submessage: &proto3pb.Message{ShortKey: []int32{1, 2, 3}},
+		}, proto.LimitElements},
+		{"group depth exceeded", proto.UnmarshalOptions{MaxDepth: 1}, &pb.GoTestRequiredGroupField{
+			Group: &pb.GoTestRequiredGroupField_Group{Field: proto.Int32(1)},
+		}, proto.LimitDepth},
+	}
+	for _, tt := range tests {
+		b, err := proto.Marshal(tt.in)
+		if err != nil {
+			t.Fatalf("%s: Marshal: %v", tt.desc, err)
+		}
+		got := proto.Clone(tt.in)
+		err = tt.opts.Unmarshal(b, got)
+		if tt.limit == "" {
+			if err != nil {
+				t.Errorf("%s: Unmarshal: %v", tt.desc, err)
+			} else if !proto.Equal(got, tt.in) {
+				t.Errorf("%s: Unmarshal: got %v, want %v", tt.desc, got, tt.in)
+			}
+			continue
+		}
+		le, ok := err.(*proto.LimitError)
+		if !ok || le.Limit != tt.limit {
+			t.Errorf("%s: Unmarshal: got error %v, want %s LimitError", tt.desc, err, tt.limit)
+		}
+	}
+}
+
+func TestUnmarshalOptionsUnknownGroups(t *testing.T) {
+	// Unknown field 5, nested in three groups.
+	b := []byte{0x2b, 0x2b, 0x2b, 0x2c, 0x2c, 0x2c}
+	opts := proto.UnmarshalOptions{MaxDepth: 3}
+	if err := opts.Unmarshal(b, new(proto3pb.Nested)); err == nil {
+		t.Errorf("Unmarshal: got nil error, want depth LimitError")
+	}
+	opts.MaxDepth = 4
+	if err := opts.Unmarshal(b, new(proto3pb.Nested)); err != nil {
+		t.Errorf("Unmarshal: %v", err)
+	}
+}
+
+func TestUnmarshalOptionsDiscardUnknown(t *testing.T) {
+	b, err := proto.Marshal(&proto3pb.Message{Name: "a", ResultCount: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := proto.UnmarshalOptions{DiscardUnknown: true}
+	got := new(proto3pb.Nested)
+	if err := opts.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if want := (&proto3pb.Nested{Bunny: "a"}); !proto.Equal(got, want) || got.XXX_unrecognized != nil {
+		t.Errorf("Unmarshal: got %#v, want %#v", got, want)
+	}
+
+	// Merging drops the unknown fields the message already held, too.
+	got = &proto3pb.Nested{Cute: true, XXX_unrecognized: []byte{0x98, 0x06, 0x01}}
+	if err := opts.UnmarshalMerge(b, got); err != nil {
+		t.Fatalf("UnmarshalMerge: %v", err)
+	}
+	if want := (&proto3pb.Nested{Bunny: "a", Cute: true}); !proto.Equal(got, want) || got.XXX_unrecognized != nil {
+		t.Errorf("UnmarshalMerge: got %#v, want %#v", got, want)
+	}
+}
+
+func TestUnmarshalOptionsText(t *testing.T) {
+	tests := []struct {
+		desc  string
+		opts  proto.UnmarshalOptions
+		in    string
+		limit string
+		want  proto.Message
+	}{
+		{"depth within limit", proto.UnmarshalOptions{MaxDepth: 3}, `submessage { submessage { name: "x" } }`, "",
+			&proto3pb.Message{Submessage: &proto3pb.Message{Submessage: &proto3pb.Message{Name: "x"}}}},
+		{"depth exceeded", proto.UnmarshalOptions{MaxDepth: 2}, `submessage { submessage { name: "x" } }`, proto.LimitDepth, nil},
+		{"bytes exceeded", proto.UnmarshalOptions{MaxBytes: 5}, `name: "abcdef"`, proto.LimitBytes, nil},
+		{"list elements exceeded", proto.UnmarshalOptions{MaxElements: 2}, `key: [1, 2, 3]`, proto.LimitElements, nil},
+		{"repeated elements exceeded", proto.UnmarshalOptions{MaxElements: 2}, `key: 1 key: 2 key: 3`, proto.LimitElements, nil},
+		{"map entries exceeded", proto.UnmarshalOptions{MaxElements: 1}, `string_map { key: "a" value: "b" } string_map { key: "c" value: "d" }`, proto.LimitElements, nil},
+		{"unknown fields discarded", proto.UnmarshalOptions{DiscardUnknown: true}, `name: "a" bogus: 1 other { x: [1, { y: 2 }] } [ext.name]: 3; height_in_cm: 4`, "",
+			&proto3pb.Message{Name: "a", HeightInCm: 4}},
+	}
+	for _, tt := range tests {
+		got := new(proto3pb.Message)
+		err := tt.opts.UnmarshalText(tt.in, got)
+		if tt.limit == "" {
+			if err != nil {
+				t.Errorf("%s: UnmarshalText: %v", tt.desc, err)
+			} else if !proto.Equal(got, tt.want) {
+				t.Errorf("%s: UnmarshalText: got %v, want %v", tt.desc, got, tt.want)
+			}
+			continue
+		}
+		le, ok := err.(*proto.LimitError)
+		if !ok || le.Limit != tt.limit {
+			t.Errorf("%s: UnmarshalText: got error %v, want %s LimitError", tt.desc, err, tt.limit)
+		}
+	}
+
+	if err := new(proto.UnmarshalOptions).UnmarshalText(`bogus: 1`, new(proto3pb.Message)); err == nil {
+		t.Errorf("UnmarshalText of unknown field without DiscardUnknown: got nil error")
+	}
+}
+
+func TestUnmarshalOptionsAliasBuffer(t *testing.T) {
+	in := &proto3pb.Message{
+		Name:       "name",
+		Data:       []byte("payload"),
+		StringMap:  map[string]string{"k": "v"},
+		Submessage: &proto3pb.Message{Name: "sub", Data: []byte("nested")},
+	}
+	b, err := proto.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	opts := proto.UnmarshalOptions{AliasBuffer: true}
+	got := new(proto3pb.Message)
+	if err := opts.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !proto.Equal(got, in) {
+		t.Fatalf("Unmarshal: got %v, want %v", got, in)
+	}
+	if cap(got.Data) != len(got.Data) {
+		t.Errorf("Data has capacity %d beyond its length %d; appending would overwrite the input", cap(got.Data), len(got.Data))
+	}
+
+	copied := new(proto3pb.Message)
+	if err := proto.Unmarshal(b, copied); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	// Modifying the input is visible through aliased fields only.
+	i := bytes.Index(b, []byte("nested"))
+	b[i] = 'N'
+	i = bytes.Index(b, []byte("sub"))
+	b[i] = 'S'
+	if got, want := got.Submessage.Name, "sub"; got != want {
+		t.Errorf("Submessage.Name after modifying input: got %q, want %q", got, want)
+	}
+	if got, want := string(got.Submessage.Data), "Nested"; got != want {
+		t.Errorf("aliased Submessage.Data after modifying input: got %q, want %q", got, want)
+	}
+	if got, want := string(copied.Submessage.Data), "nested"; got != want {
+		t.Errorf("copied Submessage.Data after modifying input: got %q, want %q", got, want)
+	}
+}
+
+func TestUnmarshalOptionsAliasBufferProto2(t *testing.T) {
+	in := initGoTest(true)
+	in.F_BytesRepeated = [][]byte{[]byte("a"), {}, []byte("c")}
+	in.F_StringRepeated = []string{"x", "", "z"}
+	b, err := proto.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	got := new(pb.GoTest)
+	opts := proto.UnmarshalOptions{AliasBuffer: true}
+	if err := opts.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !proto.Equal(got, in) {
+		t.Errorf("Unmarshal: got %v, want %v", got, in)
+	}
+}
@@ -61,6 +61,7 @@ import (
 
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
 )
 
 // generatedCodeVersion indicates a version of the generated code.
@@ -85,6 +86,16 @@ type Plugin interface {
 	GenerateImports(file *FileDescriptor)
 }
 
+// A FilesPlugin is a Plugin that also writes files of its own, such as
+// schemas, for the files that Go code is generated for.
+type FilesPlugin interface {
+	Plugin
+	// GenerateFiles returns the files written for this file, whose Go
+	// code is written to goFileName. Their names are relative to the
+	// output directory, like goFileName.
+	GenerateFiles(file *FileDescriptor, goFileName string) []*plugin.CodeGeneratorResponse_File
+}
+
 var plugins []Plugin
 
 // RegisterPlugin installs a (second-order) plugin to be run when the Go output is generated.
@@ -119,6 +130,23 @@ func (c *common) GoImportPath() GoImportPath {
 
 func (c *common) File() *FileDescriptor { return c.file }
 
+// Comments returns the leading comments of the element of the file at the
+// given path, without their comment markers, or "" if it has none.
+// The path is a comma-separated list of integers; see descriptor.proto
+// for its format.
+func (d *FileDescriptor) Comments(path string) string {
+	loc, ok := d.comments[path]
+	if !ok {
+		return ""
+	}
+	text := strings.TrimSuffix(loc.GetLeadingComments(), "\n")
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
 func fileIsProto3(file *descriptor.FileDescriptorProto) bool {
 	return file.GetSyntax() == "proto3"
 }
@@ -139,6 +167,10 @@ type Descriptor struct {
 	group    bool
 }
 
+// Path returns the SourceCodeInfo path of the message in its file,
+// as comma-separated integers.
+func (d *Descriptor) Path() string { return d.path }
+
 // TypeName returns the elements of the dotted type name.
 // The package name is not part of this name.
 func (d *Descriptor) TypeName() []string {
@@ -169,6 +201,10 @@ type EnumDescriptor struct {
 	path     string      // The SourceCodeInfo path as comma-separated integers.
 }
 
+// Path returns the SourceCodeInfo path of the enum in its file,
+// as comma-separated integers.
+func (e *EnumDescriptor) Path() string { return e.path }
+
 // TypeName returns the elements of the dotted type name.
 // The package name is not part of this name.
 func (e *EnumDescriptor) TypeName() (s []string) {
@@ -931,6 +967,14 @@ func (g *Generator) BuildTypeNameMap() {
 	}
 }
 
+// DefinedObject, given a fully-qualified input type name as it appears in the input data,
+// returns the descriptor for the message or enum with that name in the file that defines it,
+// or nil if there is none. Unlike ObjectNamed, it does not depend on the imports of the
+// current file.
+func (g *Generator) DefinedObject(typeName string) Object {
+	return g.typeNameToObject[typeName]
+}
+
 // ObjectNamed, given a fully-qualified input type name as it appears in the input data,
 // returns the descriptor for the message or enum with that name.
 func (g *Generator) ObjectNamed(typeName string) Object {
@@ -1107,6 +1151,11 @@ func (g *Generator) GenerateAllFiles() {
 				Content: proto.String(proto.CompactTextString(&descriptor.GeneratedCodeInfo{Annotation: g.annotations})),
 			})
 		}
+		for _, p := range plugins {
+			if fp, ok := p.(FilesPlugin); ok {
+				g.Response.File = append(g.Response.File, fp.GenerateFiles(file, fname)...)
+			}
+		}
 	}
 }
 
@@ -1480,6 +1529,7 @@ func (g *Generator) generateEnum(enum *EnumDescriptor) {
 //	name= the original declared name
 //	enum= the name of the enum type if it is an enum-typed field.
 //	proto3 if this field is in a proto3 message
+//	sensitive if this field has the network.api.sensitive option
 //	def= string representation of the default value, if any.
 // The default value must be in a representation that can be used at run-time
 // to generate the default value. Thus bools become 0 and 1, for instance.
@@ -1574,7 +1624,11 @@ func (g *Generator) goTag(message *Descriptor, field *descriptor.FieldDescriptor
 	if field.OneofIndex != nil {
 		oneof = ",oneof"
 	}
-	return strconv.Quote(fmt.Sprintf("%s,%d,%s%s%s%s%s%s",
+	sensitive := ""
+	if IsSensitive(field) {
+		sensitive = ",sensitive"
+	}
+	return strconv.Quote(fmt.Sprintf("%s,%d,%s%s%s%s%s%s%s",
 		wiretype,
 		field.GetNumber(),
 		optrepreq,
@@ -1582,9 +1636,20 @@ func (g *Generator) goTag(message *Descriptor, field *descriptor.FieldDescriptor
 		name,
 		enum,
 		oneof,
+		sensitive,
 		defaultValue))
 }
 
+// IsSensitive reports whether field has the network.api.sensitive option.
+// Plugins use it to treat such fields as the generated struct tags do.
+func IsSensitive(field *descriptor.FieldDescriptorProto) bool {
+	if field.Options == nil || !proto.HasExtension(field.Options, network_api.E_Sensitive) {
+		return false
+	}
+	ext, err := proto.GetExtension(field.Options, network_api.E_Sensitive)
+	return err == nil && *ext.(*bool)
+}
+
 func needsStar(typ descriptor.FieldDescriptorProto_Type) bool {
 	switch typ {
 	case descriptor.FieldDescriptorProto_TYPE_GROUP:
@@ -0,0 +1,533 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package jsonpb outputs MarshalJSONPB and UnmarshalJSONPB methods for
+// messages, which convert them to and from JSON without reflection.
+// The output is the same as that of the jsonpb package.
+// It runs as a plugin for the Go protocol buffer compiler plugin.
+// It is linked in to protoc-gen-go.
+package jsonpb
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+)
+
+// Paths for packages used by code generated in this file,
+// relative to the import_prefix of the generator.Generator.
+const (
+	sortPkgPath   = "sort"
+	jsonpbPkgPath = "github.com/golang/protobuf/jsonpb"
+)
+
+func init() {
+	generator.RegisterPlugin(new(jsonpb))
+}
+
+// jsonpb is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates JSON methods for every message.
+type jsonpb struct {
+	gen *generator.Generator
+
+	// The packages used by the code generated for the current file.
+	usedPkgs map[string]bool
+}
+
+// Name returns the name of this plugin, "jsonpb".
+func (g *jsonpb) Name() string {
+	return "jsonpb"
+}
+
+// The names for packages imported in the generated code.
+// They may vary from the final path component of the import path
+// if the name is used by other packages.
+var (
+	sortPkg   string
+	jsonpbPkg string
+)
+
+// Init initializes the plugin.
+func (g *jsonpb) Init(gen *generator.Generator) {
+	g.gen = gen
+	sortPkg = generator.RegisterUniquePackageName("sort", nil)
+	jsonpbPkg = generator.RegisterUniquePackageName("jsonpb", nil)
+}
+
+// Given a type name defined in a .proto, return its object.
+// Also record that we're using it, to guarantee the associated import.
+func (g *jsonpb) objectNamed(name string) generator.Object {
+	g.gen.RecordTypeUse(name)
+	return g.gen.ObjectNamed(name)
+}
+
+// Given a type name defined in a .proto, return its name as we will print it.
+func (g *jsonpb) typeName(str string) string {
+	return g.gen.TypeName(g.objectNamed(str))
+}
+
+// P forwards to g.gen.P.
+func (g *jsonpb) P(args ...interface{}) { g.gen.P(args...) }
+
+// use records that the generated code refers to the package named pkg
+// and returns pkg.
+func (g *jsonpb) use(pkg string) string {
+	g.usedPkgs[pkg] = true
+	return pkg
+}
+
+// Generate generates JSON methods for the messages in the given file.
+func (g *jsonpb) Generate(file *generator.FileDescriptor) {
+	g.usedPkgs = make(map[string]bool)
+	// The well-known types have their own JSON mapping,
+	// which the jsonpb package implements.
+	if file.GetPackage() == "google.protobuf" || len(file.MessageType) == 0 {
+		return
+	}
+
+	prefix := ""
+	if file.GetPackage() != "" {
+		prefix = "." + file.GetPackage()
+	}
+	for _, msg := range file.MessageType {
+		g.generateMessage(file, prefix, msg)
+	}
+}
+
+// GenerateImports generates the import declaration for this file.
+func (g *jsonpb) GenerateImports(file *generator.FileDescriptor) {
+	if len(g.usedPkgs) == 0 {
+		return
+	}
+	g.P("import (")
+	for _, imp := range []struct{ name, path string }{
+		{jsonpbPkg, jsonpbPkgPath},
+		{sortPkg, sortPkgPath},
+	} {
+		if g.usedPkgs[imp.name] {
+			g.P(imp.name, " ", generator.GoImportPath(path.Join(string(g.gen.ImportPrefix), imp.path)))
+		}
+	}
+	g.P(")")
+	g.P()
+}
+
+// isMapEntry reports whether msg is the entry type of a map field.
+func isMapEntry(msg *pb.DescriptorProto) bool {
+	return msg.GetOptions().GetMapEntry()
+}
+
+// isMessage reports whether field holds a message or a group.
+func isMessage(field *pb.FieldDescriptorProto) bool {
+	t := field.GetType()
+	return t == pb.FieldDescriptorProto_TYPE_MESSAGE || t == pb.FieldDescriptorProto_TYPE_GROUP
+}
+
+// fieldNames returns the original and JSON names of field,
+// as recorded in the protobuf struct tag of the field.
+func fieldNames(field *pb.FieldDescriptorProto) (name, jsonName string) {
+	name = field.GetName()
+	if field.GetType() == pb.FieldDescriptorProto_TYPE_GROUP {
+		// Groups are named after their type.
+		name = field.GetTypeName()
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+	}
+	jsonName = name
+	if json := field.GetJsonName(); json != "" {
+		jsonName = json
+	}
+	return name, jsonName
+}
+
+// generateMessage generates the JSON methods of msg, whose enclosing
+// scope has the fully-qualified name prefix, and of its nested messages.
+func (g *jsonpb) generateMessage(file *generator.FileDescriptor, prefix string, msg *pb.DescriptorProto) {
+	if isMapEntry(msg) {
+		return
+	}
+	fullName := prefix + "." + msg.GetName()
+	typeName := g.typeName(fullName)
+	proto3 := file.GetSyntax() == "proto3"
+
+	g.P("// MarshalJSONPB marshals m to JSON with jm, as ", g.use(jsonpbPkg), ".Marshaler would")
+	g.P("// without this method.")
+	g.P("func (m *", typeName, ") MarshalJSONPB(jm *", jsonpbPkg, ".Marshaler) ([]byte, error) {")
+	g.P("e := jm.XXX_Encoder(m)")
+	seen := make(map[int32]bool)
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil {
+			g.marshalField(msg, proto3, field)
+			continue
+		}
+		// A oneof is written where its first field is declared.
+		if i := field.GetOneofIndex(); !seen[i] {
+			seen[i] = true
+			g.marshalOneof(msg, typeName, i)
+		}
+	}
+	g.P("return e.End()")
+	g.P("}")
+	g.P()
+
+	g.P("// UnmarshalJSONPB unmarshals JSON into m with ju, as ", jsonpbPkg, ".Unmarshaler would")
+	g.P("// without this method.")
+	g.P("func (m *", typeName, ") UnmarshalJSONPB(ju *", jsonpbPkg, ".Unmarshaler, b []byte) error {")
+	g.P("d, err := ju.XXX_Decoder(m, b)")
+	g.P("if d == nil {")
+	g.P("return err")
+	g.P("}")
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil {
+			g.unmarshalField(msg, proto3, field)
+		}
+	}
+	for _, field := range msg.Field {
+		if field.OneofIndex != nil {
+			g.unmarshalOneofField(msg, typeName, field)
+		}
+	}
+	g.P("return d.End()")
+	g.P("}")
+	g.P()
+
+	for _, nested := range msg.NestedType {
+		g.generateMessage(file, fullName, nested)
+	}
+}
+
+// mapEntry returns the entry type of the map field, or nil if field is not a map.
+func (g *jsonpb) mapEntry(msg *pb.DescriptorProto, field *pb.FieldDescriptorProto) *pb.DescriptorProto {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE || field.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+	name := field.GetTypeName()
+	for _, nested := range msg.NestedType {
+		if isMapEntry(nested) && strings.HasSuffix(name, "."+nested.GetName()) {
+			return nested
+		}
+	}
+	return nil
+}
+
+// goType returns the Go type of a single value of field.
+func (g *jsonpb) goType(field *pb.FieldDescriptorProto) string {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return "float64"
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return "float32"
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "int64"
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return "uint64"
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "int32"
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return "uint32"
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return "bool"
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return "string"
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return "[]byte"
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		return g.typeName(field.GetTypeName())
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return "*" + g.typeName(field.GetTypeName())
+	}
+	g.gen.Fail("unknown type for", field.GetName())
+	return ""
+}
+
+// valueMethod returns the name of the encoder and decoder methods for
+// the values of field, which does not hold messages.
+func valueMethod(field *pb.FieldDescriptorProto) string {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return "Float64"
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return "Float32"
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "Int64"
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return "Uint64"
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "Int32"
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return "Uint32"
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return "Bool"
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return "String"
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return "Bytes"
+	}
+	return "Enum"
+}
+
+// fieldMethod returns the encoder method that starts field, and the
+// condition preceding it. Sensitive string and bytes fields are redacted
+// by the encoder; other sensitive fields are left out when redacting.
+func fieldMethod(field *pb.FieldDescriptorProto) (cond, method string) {
+	if !generator.IsSensitive(field) {
+		return "", "Field"
+	}
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		return "", "SensitiveField"
+	}
+	return "!e.Redact() && ", "Field"
+}
+
+// marshalField generates the code writing field, which is not in a oneof.
+func (g *jsonpb) marshalField(msg *pb.DescriptorProto, proto3 bool, field *pb.FieldDescriptorProto) {
+	name, jsonName := fieldNames(field)
+	val := "m." + generator.CamelCase(field.GetName())
+	redact, method := fieldMethod(field)
+	pointer := false
+	zero := val + " == nil"
+	switch {
+	case field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED,
+		isMessage(field), field.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+	case !proto3:
+		pointer = true
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_BOOL:
+		zero = "!" + val
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_STRING:
+		zero = val + ` == ""`
+	default:
+		zero = val + " == 0"
+	}
+	g.P("if ", redact, "e.", method, "(", strconv.Quote(name), ", ", strconv.Quote(jsonName), ", ", zero, ") {")
+	switch entry := g.mapEntry(msg, field); {
+	case entry != nil:
+		g.marshalMap(entry, val)
+	case field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED:
+		g.P("e.BeginList()")
+		g.P("for _, x := range ", val, " {")
+		g.P("e.Elem()")
+		g.marshalValue(field, "x", false)
+		g.P("}")
+		g.P("e.EndList()")
+	case pointer:
+		g.P("if ", val, " == nil {")
+		g.P("e.Null()")
+		g.P("} else {")
+		g.marshalValue(field, "*"+val, false)
+		g.P("}")
+	default:
+		g.marshalValue(field, val, false)
+	}
+	g.P("}")
+}
+
+// marshalMap generates the code writing the map held in val, whose entry
+// type is entry. The keys are sorted as they are by the jsonpb package.
+func (g *jsonpb) marshalMap(entry *pb.DescriptorProto, val string) {
+	key, value := entry.Field[0], entry.Field[1]
+	keyType := g.goType(key)
+	g.P("keys := make([]", keyType, ", 0, len(", val, "))")
+	g.P("for k := range ", val, " {")
+	g.P("keys = append(keys, k)")
+	g.P("}")
+	keyMethod := "IntKey(int64(k))"
+	switch keyType {
+	case "string":
+		g.P(g.use(sortPkg), ".Strings(keys)")
+		keyMethod = "Key(k)"
+	case "bool":
+		g.P(g.use(sortPkg), ".Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })")
+		keyMethod = "BoolKey(k)"
+	default:
+		g.P(g.use(sortPkg), ".Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })")
+		if strings.HasPrefix(keyType, "uint") {
+			keyMethod = "UintKey(uint64(k))"
+		}
+	}
+	g.P("e.BeginMap()")
+	g.P("for _, k := range keys {")
+	g.P("e.", keyMethod)
+	g.P("v := ", val, "[k]")
+	g.marshalValue(value, "v", true)
+	g.P("}")
+	g.P("e.EndMap()")
+}
+
+// marshalOneof generates the code writing the oneof of msg with the given index.
+func (g *jsonpb) marshalOneof(msg *pb.DescriptorProto, typeName string, index int32) {
+	oneofName := generator.CamelCase(msg.OneofDecl[index].GetName())
+	g.P("switch x := m.", oneofName, ".(type) {")
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil || field.GetOneofIndex() != index {
+			continue
+		}
+		name, jsonName := fieldNames(field)
+		goName := generator.CamelCase(field.GetName())
+		redact, method := fieldMethod(field)
+		g.P("case *", typeName, "_", goName, ":")
+		g.P("if ", redact, "e.", method, "(", strconv.Quote(name), ", ", strconv.Quote(jsonName), ", false) {")
+		g.marshalValue(field, "x."+goName, false)
+		g.P("}")
+	}
+	g.P("}")
+}
+
+// marshalValue generates the code writing val, a single value of field.
+// Enums in map values are written as numbers, as they are by the jsonpb
+// package.
+func (g *jsonpb) marshalValue(field *pb.FieldDescriptorProto, val string, mapValue bool) {
+	switch {
+	case isMessage(field):
+		g.P("if ", val, " == nil {")
+		g.P("e.Null()")
+		g.P("} else if err := e.Message(", val, "); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+	case field.GetTypeName() == ".google.protobuf.NullValue":
+		g.P("e.Null()")
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_ENUM && mapValue:
+		g.P("e.Int32(int32(", val, "))")
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_ENUM:
+		g.P("e.Enum(int32(", val, "), ", g.typeName(field.GetTypeName()), "_name)")
+	default:
+		g.P("e.", valueMethod(field), "(", val, ")")
+	}
+}
+
+// unmarshalField generates the code reading field, which is not in a oneof.
+func (g *jsonpb) unmarshalField(msg *pb.DescriptorProto, proto3 bool, field *pb.FieldDescriptorProto) {
+	name, jsonName := fieldNames(field)
+	val := "m." + generator.CamelCase(field.GetName())
+	g.P("if raw, ok := d.Field(", strconv.Quote(name), ", ", strconv.Quote(jsonName), "); ok {")
+	switch entry := g.mapEntry(msg, field); {
+	case entry != nil:
+		key, value := entry.Field[0], entry.Field[1]
+		g.P("elems, err := d.Map(raw, ", strconv.Quote(name), ")")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("if elems != nil {")
+		g.P(val, " = make(map[", g.goType(key), "]", g.goType(value), ", len(elems))")
+		if key.GetType() == pb.FieldDescriptorProto_TYPE_STRING {
+			g.P("for k, r := range elems {")
+		} else {
+			g.P("for s, r := range elems {")
+			g.P("var k ", g.goType(key))
+			g.P("if err := d.", valueMethod(key), "([]byte(s), &k); err != nil {")
+			g.P("return err")
+			g.P("}")
+		}
+		g.P("var v ", g.goType(value))
+		g.unmarshalValue(value, "r", "v", `""`, true)
+		g.P(val, "[k] = v")
+		g.P("}")
+		g.P("}")
+	case field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED:
+		g.P("elems, err := d.List(raw, ", strconv.Quote(name), ")")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("if elems != nil {")
+		g.P(val, " = make([]", g.goType(field), ", len(elems))")
+		g.P("for i, r := range elems {")
+		g.unmarshalValue(field, "r", val+"[i]", strconv.Quote(name), false)
+		g.P("}")
+		g.P("}")
+	case !proto3 && !isMessage(field) && field.GetType() != pb.FieldDescriptorProto_TYPE_BYTES:
+		g.P("if !d.Null(raw) {")
+		g.P(val, " = new(", g.goType(field), ")")
+		g.unmarshalScalar(field, "raw", val, false)
+		g.P("}")
+	default:
+		g.unmarshalValue(field, "raw", val, strconv.Quote(name), false)
+	}
+	g.P("}")
+}
+
+// unmarshalOneofField generates the code reading field, which is in a oneof.
+func (g *jsonpb) unmarshalOneofField(msg *pb.DescriptorProto, typeName string, field *pb.FieldDescriptorProto) {
+	name, jsonName := fieldNames(field)
+	oneofName := generator.CamelCase(msg.OneofDecl[field.GetOneofIndex()].GetName())
+	goName := generator.CamelCase(field.GetName())
+	g.P("if raw, ok := d.Field(", strconv.Quote(name), ", ", strconv.Quote(jsonName), "); ok {")
+	g.P("x := new(", typeName, "_", goName, ")")
+	g.P("m.", oneofName, " = x")
+	g.unmarshalValue(field, "raw", "x."+goName, strconv.Quote(name), false)
+	g.P("}")
+}
+
+// unmarshalValue generates the code reading a single value of field from
+// the variable raw into val. The quoted original name of the field is
+// used in errors.
+func (g *jsonpb) unmarshalValue(field *pb.FieldDescriptorProto, raw, val, quoted string, mapValue bool) {
+	if !isMessage(field) {
+		g.unmarshalScalar(field, raw, "&"+val, mapValue)
+		return
+	}
+	// A null Value is not a missing message but a null value.
+	nullable := field.GetTypeName() != ".google.protobuf.Value"
+	if nullable {
+		g.P("if !d.Null(", raw, ") {")
+	}
+	g.P(val, " = new(", strings.TrimPrefix(g.goType(field), "*"), ")")
+	g.P("if err := d.Message(", raw, ", ", val, ", ", quoted, "); err != nil {")
+	g.P("return err")
+	g.P("}")
+	if nullable {
+		g.P("}")
+	}
+}
+
+// unmarshalScalar generates the code reading a single value of field, which
+// does not hold messages, from the variable raw into the pointer ptr.
+// Enums in map values are read as numbers, as they are by the jsonpb package.
+func (g *jsonpb) unmarshalScalar(field *pb.FieldDescriptorProto, raw, ptr string, mapValue bool) {
+	switch {
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_ENUM && mapValue:
+		g.P("if err := d.Int32(", raw, ", (*int32)(", ptr, ")); err != nil {")
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_ENUM:
+		enum := g.objectNamed(field.GetTypeName())
+		fullName := generator.CamelCaseSlice(enum.TypeName())
+		if pkg := enum.File().GetPackage(); pkg != "" {
+			fullName = pkg + "." + fullName
+		}
+		g.P("if err := d.Enum(", raw, ", (*int32)(", ptr, "), ", g.gen.TypeName(enum), "_value, ", strconv.Quote(fullName), "); err != nil {")
+	default:
+		g.P("if err := d.", valueMethod(field), "(", raw, ", ", ptr, "); err != nil {")
+	}
+	g.P("return err")
+	g.P("}")
+}
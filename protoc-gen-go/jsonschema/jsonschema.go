@@ -0,0 +1,434 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package jsonschema outputs a JSON Schema (draft 2020-12) for every
+// message, which describes the JSON that the jsonpb package writes for it.
+// The schema of message pkg.Msg is written to pkg.Msg.schema.json, next to
+// the Go file. The messages and enums it refers to are among its $defs.
+// It runs as a plugin for the Go protocol buffer compiler plugin.
+// It is linked in to protoc-gen-go.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+)
+
+// The dialect of the schemas.
+const schemaURI = "https://json-schema.org/draft/2020-12/schema"
+
+// The SourceCodeInfo path of the fields of a message, relative to the message.
+const messageFieldPath = 2
+
+func init() {
+	generator.RegisterPlugin(new(jsonschema))
+}
+
+// jsonschema is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates a JSON Schema file for every message.
+type jsonschema struct {
+	gen *generator.Generator
+}
+
+// Name returns the name of this plugin, "jsonschema".
+func (g *jsonschema) Name() string {
+	return "jsonschema"
+}
+
+// Init initializes the plugin.
+func (g *jsonschema) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// Generate generates nothing; the schemas are written by GenerateFiles.
+func (g *jsonschema) Generate(file *generator.FileDescriptor) {}
+
+// GenerateImports generates nothing.
+func (g *jsonschema) GenerateImports(file *generator.FileDescriptor) {}
+
+// GenerateFiles returns the schemas of the messages in the given file.
+func (g *jsonschema) GenerateFiles(file *generator.FileDescriptor, goFileName string) []*plugin.CodeGeneratorResponse_File {
+	// The well-known types have their own JSON mapping,
+	// which other schemas describe inline.
+	if file.GetPackage() == "google.protobuf" {
+		return nil
+	}
+	prefix := ""
+	if file.GetPackage() != "" {
+		prefix = "." + file.GetPackage()
+	}
+	var files []*plugin.CodeGeneratorResponse_File
+	var walk func(prefix string, msgs []*pb.DescriptorProto)
+	walk = func(prefix string, msgs []*pb.DescriptorProto) {
+		for _, msg := range msgs {
+			fullName := prefix + "." + msg.GetName()
+			if !msg.GetOptions().GetMapEntry() {
+				files = append(files, &plugin.CodeGeneratorResponse_File{
+					Name:    proto.String(path.Join(path.Dir(goFileName), fullName[1:]+".schema.json")),
+					Content: proto.String(g.schema(fullName)),
+				})
+			}
+			walk(fullName, msg.NestedType)
+		}
+	}
+	walk(prefix, file.MessageType)
+	return files
+}
+
+// An object is a JSON object whose members keep their order.
+type object []member
+
+type member struct {
+	key   string
+	value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// byKey sorts members by key.
+type byKey []member
+
+func (b byKey) Len() int           { return len(b) }
+func (b byKey) Less(i, j int) bool { return b[i].key < b[j].key }
+func (b byKey) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+// schema returns the schema of the message with the given fully-qualified name.
+func (g *jsonschema) schema(fullName string) string {
+	s := &schemaBuilder{gen: g.gen, defined: make(map[string]bool)}
+	root := object{
+		{"$schema", schemaURI},
+		{"$ref", s.ref(fullName)},
+	}
+	// Define the types that are referred to, and those that they refer to.
+	for len(s.queue) > 0 {
+		name := s.queue[0]
+		s.queue = s.queue[1:]
+		s.defs = append(s.defs, member{name[1:], s.define(name)})
+	}
+	sort.Sort(byKey(s.defs))
+	root = append(root, member{"$defs", object(s.defs)})
+
+	b, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		g.gen.Error(err, "marshaling the schema of "+fullName)
+	}
+	return string(b) + "\n"
+}
+
+// A schemaBuilder builds the schema of a message.
+type schemaBuilder struct {
+	gen     *generator.Generator
+	defs    []member        // the definitions of messages and enums
+	defined map[string]bool // the types that are or will be defined
+	queue   []string        // the types that are still to be defined
+}
+
+// ref returns a reference to the definition of the message or enum with
+// the given fully-qualified name.
+func (s *schemaBuilder) ref(name string) string {
+	if !s.defined[name] {
+		s.defined[name] = true
+		s.queue = append(s.queue, name)
+	}
+	return "#/$defs/" + name[1:]
+}
+
+// define returns the definition of the message or enum with the given
+// fully-qualified name.
+func (s *schemaBuilder) define(name string) object {
+	switch o := s.gen.DefinedObject(name).(type) {
+	case *generator.Descriptor:
+		return s.message(o)
+	case *generator.EnumDescriptor:
+		def := object{{"title", o.GetName()}}
+		if c := o.File().Comments(o.Path()); c != "" {
+			def = append(def, member{"description", c})
+		}
+		var names []string
+		for _, v := range o.Value {
+			names = append(names, v.GetName())
+		}
+		return append(def, member{"enum", names})
+	}
+	s.gen.Fail("can't find object with type", name)
+	return nil
+}
+
+// message returns the definition of the message.
+func (s *schemaBuilder) message(d *generator.Descriptor) object {
+	def := object{{"title", d.GetName()}}
+	if c := d.File().Comments(d.Path()); c != "" {
+		def = append(def, member{"description", c})
+	}
+	def = append(def, member{"type", "object"})
+
+	var props, required []string
+	var propSchemas object
+	oneofs := make([][]string, len(d.OneofDecl))
+	for i, field := range d.Field {
+		name := jsonName(field)
+		fs := s.field(field)
+		if c := d.File().Comments(d.Path() + "," + strconv.Itoa(messageFieldPath) + "," + strconv.Itoa(i)); c != "" {
+			fs = append(fs, member{"description", c})
+		}
+		props = append(props, name)
+		propSchemas = append(propSchemas, member{name, fs})
+		if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED {
+			required = append(required, name)
+		}
+		if field.OneofIndex != nil {
+			oneofs[field.GetOneofIndex()] = append(oneofs[field.GetOneofIndex()], name)
+		}
+	}
+	if len(props) > 0 {
+		def = append(def, member{"properties", propSchemas})
+	}
+	if len(required) > 0 {
+		def = append(def, member{"required", required})
+	}
+	if len(d.ExtensionRange) > 0 {
+		// Extensions are written with their full names in brackets.
+		def = append(def, member{"patternProperties", object{{`^\[.+\]$`, object{}}}})
+	}
+	def = append(def, member{"additionalProperties", false})
+
+	// At most one field of a oneof is set: either one of them is present,
+	// or none is.
+	var exclusive []object
+	for _, names := range oneofs {
+		if len(names) < 2 {
+			continue
+		}
+		var one, some []object
+		for _, name := range names {
+			one = append(one, object{{"required", []string{name}}})
+			some = append(some, object{{"required", []string{name}}})
+		}
+		one = append(one, object{{"not", object{{"anyOf", some}}}})
+		exclusive = append(exclusive, object{{"oneOf", one}})
+	}
+	if len(exclusive) > 0 {
+		def = append(def, member{"allOf", exclusive})
+	}
+	return def
+}
+
+// jsonName returns the JSON name of the field, which the jsonpb package
+// writes by default.
+func jsonName(field *pb.FieldDescriptorProto) string {
+	if json := field.GetJsonName(); json != "" {
+		return json
+	}
+	if field.GetType() == pb.FieldDescriptorProto_TYPE_GROUP {
+		// Groups are named after their type.
+		name := field.GetTypeName()
+		return name[strings.LastIndex(name, ".")+1:]
+	}
+	return field.GetName()
+}
+
+// jsonOptions returns the network.api.json options of the field, or nil if
+// it has none.
+func jsonOptions(field *pb.FieldDescriptorProto) *network_api.JsonFieldOptions {
+	if field.Options == nil || !proto.HasExtension(field.Options, network_api.E_Json) {
+		return nil
+	}
+	ext, err := proto.GetExtension(field.Options, network_api.E_Json)
+	if err != nil {
+		return nil
+	}
+	return ext.(*network_api.JsonFieldOptions)
+}
+
+// field returns the schema of the value of the field.
+func (s *schemaBuilder) field(field *pb.FieldDescriptorProto) object {
+	opts := jsonOptions(field)
+	if field.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+		if entry, ok := s.gen.DefinedObject(field.GetTypeName()).(*generator.Descriptor); ok && entry.GetOptions().GetMapEntry() {
+			// Map keys are strings in JSON.
+			keys := object{{"type", "string"}}
+			switch entry.Field[0].GetType() {
+			case pb.FieldDescriptorProto_TYPE_BOOL:
+				keys = append(keys, member{"enum", []string{"true", "false"}})
+			case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32,
+				pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+				keys = append(keys, member{"pattern", "^[0-9]+$"})
+			case pb.FieldDescriptorProto_TYPE_STRING:
+			default:
+				keys = append(keys, member{"pattern", "^-?[0-9]+$"})
+			}
+			var values object
+			if v := entry.Field[1]; v.GetType() == pb.FieldDescriptorProto_TYPE_ENUM && v.GetTypeName() != ".google.protobuf.NullValue" {
+				// The jsonpb package writes the enum values of maps as numbers.
+				values = scalar(pb.FieldDescriptorProto_TYPE_INT32)
+			} else {
+				// The options of a map apply to its values.
+				values = s.value(v, opts)
+			}
+			return object{
+				{"type", "object"},
+				{"propertyNames", keys},
+				{"additionalProperties", values},
+			}
+		}
+	}
+	if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED {
+		return object{{"type", "array"}, {"items", s.value(field, opts)}}
+	}
+	return s.value(field, opts)
+}
+
+// value returns the schema of a single value of the field, which is written
+// with the given network.api.json options.
+func (s *schemaBuilder) value(field *pb.FieldDescriptorProto, opts *network_api.JsonFieldOptions) object {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		if field.GetTypeName() == ".google.protobuf.NullValue" {
+			return object{{"type", "null"}}
+		}
+		if opts.GetEnumAsInt() {
+			return scalar(pb.FieldDescriptorProto_TYPE_INT32)
+		}
+		return object{{"$ref", s.ref(field.GetTypeName())}}
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		if opts.GetInt64AsNumber() {
+			return object{{"type", "integer"}, {"minimum", int64(-1 << 63)}, {"maximum", int64(1<<63 - 1)}}
+		}
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		if opts.GetInt64AsNumber() {
+			return object{{"type", "integer"}, {"minimum", 0}, {"maximum", uint64(1<<64 - 1)}}
+		}
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		if wkt := wellKnownType(field.GetTypeName()); wkt != nil {
+			return wkt
+		}
+		return object{{"$ref", s.ref(field.GetTypeName())}}
+	}
+	return scalar(field.GetType())
+}
+
+// scalar returns the schema of a value of a scalar type.
+func scalar(t pb.FieldDescriptorProto_Type) object {
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_DOUBLE, pb.FieldDescriptorProto_TYPE_FLOAT:
+		return object{{"anyOf", []object{
+			{{"type", "number"}},
+			{{"enum", []string{"NaN", "Infinity", "-Infinity"}}},
+		}}}
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return object{{"type", "integer"}, {"minimum", -1 << 31}, {"maximum", 1<<31 - 1}}
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return object{{"type", "integer"}, {"minimum", 0}, {"maximum", 1<<32 - 1}}
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		// 64-bit integers are strings, which keep their precision.
+		return object{{"type", "string"}, {"pattern", "^-?[0-9]+$"}}
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return object{{"type", "string"}, {"pattern", "^[0-9]+$"}}
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return object{{"type", "boolean"}}
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return object{{"type", "string"}, {"contentEncoding", "base64"}}
+	}
+	return object{{"type", "string"}}
+}
+
+// wrappers maps the wrapper types to the types of their values.
+var wrappers = map[string]pb.FieldDescriptorProto_Type{
+	".google.protobuf.DoubleValue": pb.FieldDescriptorProto_TYPE_DOUBLE,
+	".google.protobuf.FloatValue":  pb.FieldDescriptorProto_TYPE_FLOAT,
+	".google.protobuf.Int64Value":  pb.FieldDescriptorProto_TYPE_INT64,
+	".google.protobuf.UInt64Value": pb.FieldDescriptorProto_TYPE_UINT64,
+	".google.protobuf.Int32Value":  pb.FieldDescriptorProto_TYPE_INT32,
+	".google.protobuf.UInt32Value": pb.FieldDescriptorProto_TYPE_UINT32,
+	".google.protobuf.BoolValue":   pb.FieldDescriptorProto_TYPE_BOOL,
+	".google.protobuf.StringValue": pb.FieldDescriptorProto_TYPE_STRING,
+	".google.protobuf.BytesValue":  pb.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// wellKnownType returns the schema of the well-known type with the given
+// fully-qualified name, or nil if it has no special JSON mapping.
+func wellKnownType(name string) object {
+	if t, ok := wrappers[name]; ok {
+		// Wrappers are written as the value they wrap, or null.
+		return object{{"anyOf", []object{scalar(t), {{"type", "null"}}}}}
+	}
+	switch name {
+	case ".google.protobuf.Any":
+		return object{
+			{"type", "object"},
+			{"properties", object{{"@type", object{{"type", "string"}}}}},
+			{"required", []string{"@type"}},
+		}
+	case ".google.protobuf.Duration":
+		return object{{"type", "string"}, {"pattern", `^-?[0-9]+(\.[0-9]{1,9})?s$`}}
+	case ".google.protobuf.Timestamp":
+		return object{{"type", "string"}, {"format", "date-time"}}
+	case ".google.protobuf.Struct":
+		return object{{"type", "object"}}
+	case ".google.protobuf.ListValue":
+		return object{{"type", "array"}}
+	case ".google.protobuf.Value":
+		return object{}
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonschema
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/jsonschema/jsonschema_test_proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/golang/protobuf/ptypes"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	stpb "github.com/golang/protobuf/ptypes/struct"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+const testdata = "jsonschema_test_proto"
+
+// fileDescriptor returns the registered descriptor of the named file.
+func fileDescriptor(t *testing.T, name string) *descpb.FileDescriptorProto {
+	gz := proto.FileDescriptor(name)
+	if gz == nil {
+		t.Fatalf("file %q is not registered", name)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd := new(descpb.FileDescriptorProto)
+	if err := proto.Unmarshal(b, fd); err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+var fdescRE = regexp.MustCompile(`(?ms)^var fileDescriptor.*}`)
+
+// TestGolden checks that the code and schemas in jsonschema_test_proto are
+// what the plugin generates for its files now. Run regenerate.sh to update
+// them.
+func TestGolden(t *testing.T) {
+	req := &plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"types.proto", "legacy.proto"},
+		Parameter:      proto.String("plugins=jsonschema,paths=source_relative"),
+	}
+	for _, name := range []string{
+		"google/protobuf/descriptor.proto",
+		"google/protobuf/any.proto",
+		"google/protobuf/duration.proto",
+		"google/protobuf/struct.proto",
+		"google/protobuf/timestamp.proto",
+		"google/protobuf/wrappers.proto",
+		"json.proto",
+		"types.proto",
+		"legacy.proto",
+	} {
+		req.ProtoFile = append(req.ProtoFile, fileDescriptor(t, name))
+	}
+	g := generator.New()
+	g.Request = req
+	g.CommandLineParameters(req.GetParameter())
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	if g.Response.Error != nil {
+		t.Fatalf("generator error: %s", g.Response.GetError())
+	}
+	generated := make(map[string]bool)
+	for _, f := range g.Response.File {
+		generated[f.GetName()] = true
+		want, err := ioutil.ReadFile(filepath.Join(testdata, f.GetName()))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got := fdescRE.ReplaceAll([]byte(f.GetContent()), nil)
+		want = fdescRE.ReplaceAll(want, nil)
+		if !bytes.Equal(got, want) {
+			t.Errorf("generated %s differs from %s:\n%s", f.GetName(), testdata, got)
+		}
+	}
+	schemas, err := filepath.Glob(filepath.Join(testdata, "*.schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range schemas {
+		if !generated[filepath.Base(name)] {
+			t.Errorf("%s is no longer generated", name)
+		}
+	}
+}
+
+// A validator checks JSON values against a schema. It knows the keywords
+// that the plugin writes, and no others.
+type validator struct {
+	defs map[string]interface{}
+}
+
+// readSchema returns the schema of the message with the given name.
+func readSchema(t *testing.T, msgName string) (*validator, interface{}) {
+	b, err := ioutil.ReadFile(filepath.Join(testdata, msgName+".schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := decode(b)
+	if err != nil {
+		t.Fatalf("schema of %s: %v", msgName, err)
+	}
+	defs, _ := schema.(map[string]interface{})["$defs"].(map[string]interface{})
+	return &validator{defs: defs}, schema
+}
+
+// decode decodes JSON, keeping the text of numbers.
+func decode(b []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	return v, err
+}
+
+// validate returns the ways in which the value at the path breaks the schema.
+func (v *validator) validate(schema, value interface{}, path string) []string {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return []string{fmt.Sprintf("%s: schema is %T", path, schema)}
+	}
+	var errs []string
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, path+": "+fmt.Sprintf(format, args...))
+	}
+	obj, isObj := value.(map[string]interface{})
+	for key, kw := range s {
+		switch key {
+		case "$schema", "$defs", "title", "description", "format", "contentEncoding":
+			// Annotations.
+		case "$ref":
+			name := strings.TrimPrefix(kw.(string), "#/$defs/")
+			def, ok := v.defs[name]
+			if !ok {
+				fail("undefined reference %s", kw)
+				continue
+			}
+			errs = append(errs, v.validate(def, value, path)...)
+		case "type":
+			if !hasType(value, kw.(string)) {
+				fail("%v is not of type %s", value, kw)
+			}
+		case "enum":
+			found := false
+			for _, e := range kw.([]interface{}) {
+				found = found || reflect.DeepEqual(e, value)
+			}
+			if !found {
+				fail("%v is not one of %v", value, kw)
+			}
+		case "pattern":
+			if str, ok := value.(string); ok && !regexp.MustCompile(kw.(string)).MatchString(str) {
+				fail("%q does not match %s", str, kw)
+			}
+		case "minimum", "maximum":
+			n, ok := number(value)
+			if !ok {
+				continue
+			}
+			limit, _ := number(kw)
+			if c := n.Cmp(limit); key == "minimum" && c < 0 || key == "maximum" && c > 0 {
+				fail("%v is out of range (%s %v)", value, key, kw)
+			}
+		case "required":
+			for _, name := range kw.([]interface{}) {
+				if _, ok := obj[name.(string)]; isObj && !ok {
+					fail("%s is missing", name)
+				}
+			}
+		case "properties", "patternProperties", "additionalProperties":
+			if !isObj {
+				continue
+			}
+			props, _ := s["properties"].(map[string]interface{})
+			patterns, _ := s["patternProperties"].(map[string]interface{})
+			for name, elem := range obj {
+				matched := false
+				if key == "properties" || key == "additionalProperties" {
+					if ps, ok := props[name]; ok {
+						matched = true
+						if key == "properties" {
+							errs = append(errs, v.validate(ps, elem, path+"."+name)...)
+						}
+					}
+				}
+				for pattern, ps := range patterns {
+					if regexp.MustCompile(pattern).MatchString(name) {
+						matched = true
+						if key == "patternProperties" {
+							errs = append(errs, v.validate(ps, elem, path+"."+name)...)
+						}
+					}
+				}
+				if key != "additionalProperties" || matched {
+					continue
+				}
+				if kw == false {
+					fail("unexpected property %s", name)
+				} else if kw != true {
+					errs = append(errs, v.validate(kw, elem, path+"."+name)...)
+				}
+			}
+		case "propertyNames":
+			for name := range obj {
+				errs = append(errs, v.validate(kw, name, path+"."+name)...)
+			}
+		case "items":
+			if list, ok := value.([]interface{}); ok {
+				for i, elem := range list {
+					errs = append(errs, v.validate(kw, elem, fmt.Sprintf("%s[%d]", path, i))...)
+				}
+			}
+		case "allOf":
+			for _, sub := range kw.([]interface{}) {
+				errs = append(errs, v.validate(sub, value, path)...)
+			}
+		case "anyOf", "oneOf":
+			valid := 0
+			for _, sub := range kw.([]interface{}) {
+				if len(v.validate(sub, value, path)) == 0 {
+					valid++
+				}
+			}
+			if valid == 0 || key == "oneOf" && valid > 1 {
+				fail("%v matches %d schemas of %s", value, valid, key)
+			}
+		case "not":
+			if len(v.validate(kw, value, path)) == 0 {
+				fail("%v matches %v", value, kw)
+			}
+		default:
+			fail("unsupported keyword %s", key)
+		}
+	}
+	return errs
+}
+
+// hasType reports whether the decoded JSON value is of the JSON Schema type.
+func hasType(value interface{}, typ string) bool {
+	switch value.(type) {
+	case nil:
+		return typ == "null"
+	case bool:
+		return typ == "boolean"
+	case string:
+		return typ == "string"
+	case []interface{}:
+		return typ == "array"
+	case map[string]interface{}:
+		return typ == "object"
+	case json.Number:
+		n, _ := number(value)
+		return typ == "number" || typ == "integer" && n.IsInt()
+	}
+	return false
+}
+
+// number returns the exact value of a decoded JSON number.
+func number(value interface{}) (*big.Rat, bool) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return nil, false
+	}
+	return new(big.Rat).SetString(string(n))
+}
+
+// mustMarshalAny returns an Any holding the message.
+func mustMarshalAny(pb proto.Message) *anypb.Any {
+	a, err := ptypes.MarshalAny(pb)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// TestSchemaAcceptsJSON checks that the schemas accept what the jsonpb
+// package writes.
+func TestSchemaAcceptsJSON(t *testing.T) {
+	legacy := &pb.Legacy{
+		Id:      proto.String("x"),
+		Count:   proto.Int64(-1 << 63),
+		Details: &pb.Legacy_Details{Note: proto.String("n")},
+		Scalars: []*pb.Scalars{{I64: 1}},
+	}
+	if err := proto.SetExtension(legacy, pb.E_Tag, proto.String("t")); err != nil {
+		t.Fatal(err)
+	}
+	tests := []proto.Message{
+		&pb.Scalars{},
+		&pb.Scalars{
+			D: math.NaN(), F: float32(math.Inf(-1)),
+			I32: -1 << 31, S32: 1<<31 - 1, Sf32: -1, U32: 1<<32 - 1, F32: 1,
+			I64: -1 << 63, S64: 1<<63 - 1, Sf64: -1, U64: 1<<64 - 1, F64: 1,
+			B: true, S: "s", By: []byte{0xff}, Color: pb.Color_GREEN, CustomName: "c",
+		},
+		&pb.Scalars{D: 1.5, F: -2.5e10},
+		&pb.Collections{
+			Ints:         []int32{1, -1},
+			Longs:        []int64{1 << 62, -1},
+			Colors:       []pb.Color{pb.Color_RED, pb.Color_COLOR_UNSPECIFIED},
+			Scalars:      []*pb.Scalars{{S: "a"}, {}},
+			ByString:     map[string]string{"": "a", "k": "b"},
+			ByBool:       map[bool]int32{true: 1, false: 0},
+			ByInt32:      map[int32]string{-1: "a", 1: "b"},
+			ByInt64:      map[int64]string{-1 << 63: "a"},
+			ByUint32:     map[uint32]string{1<<32 - 1: "a"},
+			ByUint64:     map[uint64]string{1<<64 - 1: "a"},
+			BySint64:     map[int64]*pb.Scalars{-5: {I64: 5}},
+			ColorsByName: map[string]pb.Color{"r": pb.Color_RED},
+		},
+		&pb.Choice{},
+		&pb.Choice{Kind: &pb.Choice_Name{Name: "n"}},
+		&pb.Choice{Kind: &pb.Choice_Id{Id: -1}, Single: &pb.Choice_Only{Only: true}},
+		&pb.Choice{Kind: &pb.Choice_Scalars{Scalars: &pb.Scalars{}}},
+		&pb.WellKnown{
+			Any:       mustMarshalAny(&pb.Scalars{I64: 3, Color: pb.Color_RED}),
+			Duration:  &durpb.Duration{Seconds: -1, Nanos: -500000000},
+			Timestamp: &tspb.Timestamp{Seconds: 1e9, Nanos: 1},
+			Struct: &stpb.Struct{Fields: map[string]*stpb.Value{
+				"a": {Kind: &stpb.Value_NumberValue{NumberValue: 1}},
+			}},
+			Value: &stpb.Value{Kind: &stpb.Value_StringValue{StringValue: "v"}},
+			List: &stpb.ListValue{Values: []*stpb.Value{
+				{Kind: &stpb.Value_BoolValue{BoolValue: true}},
+				{Kind: &stpb.Value_NullValue{}},
+			}},
+			DoubleValue: &wpb.DoubleValue{Value: math.Inf(1)},
+			FloatValue:  &wpb.FloatValue{Value: 1.5},
+			Int64Value:  &wpb.Int64Value{Value: -1 << 63},
+			Uint64Value: &wpb.UInt64Value{Value: 1<<64 - 1},
+			Int32Value:  &wpb.Int32Value{Value: -1},
+			Uint32Value: &wpb.UInt32Value{Value: 1},
+			BoolValue:   &wpb.BoolValue{},
+			StringValue: &wpb.StringValue{Value: "s"},
+			BytesValue:  &wpb.BytesValue{Value: []byte("b")},
+			Int64Values: []*wpb.Int64Value{{Value: 1}, {}},
+		},
+		&pb.WellKnown{Any: mustMarshalAny(&durpb.Duration{Seconds: 1})},
+		&pb.Options{
+			Color:    pb.Color_GREEN,
+			Colors:   []pb.Color{pb.Color_RED, 7},
+			ColorMap: map[string]pb.Color{"g": pb.Color_GREEN},
+			Count:    -1 << 63,
+			Counts:   []uint64{0, 1<<64 - 1},
+			CountMap: map[string]int64{"max": 1<<63 - 1},
+			Small:    -1,
+			Wrapped:  &wpb.Int64Value{Value: 1},
+			Note:     "n",
+			Plain:    1,
+		},
+		&pb.Outer{
+			Inner:    &pb.Outer_Inner{Name: "i"},
+			Children: []*pb.Outer{{Inner: &pb.Outer_Inner{}}, {Children: []*pb.Outer{{}}}},
+		},
+		legacy,
+	}
+	for _, msg := range tests {
+		js, err := new(jsonpb.Marshaler).MarshalToString(msg)
+		if err != nil {
+			t.Errorf("marshaling %v: %v", msg, err)
+			continue
+		}
+		v, schema := readSchema(t, proto.MessageName(msg))
+		value, err := decode([]byte(js))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if errs := v.validate(schema, value, "$"); len(errs) > 0 {
+			sort.Strings(errs)
+			t.Errorf("%s is not valid:\n%s", js, strings.Join(errs, "\n"))
+		}
+	}
+}
+
+// TestSchemaRejectsJSON checks that the schemas reject JSON that the jsonpb
+// package does not write.
+func TestSchemaRejectsJSON(t *testing.T) {
+	tests := []struct {
+		msg  string
+		json string
+	}{
+		{"jsonschema.Scalars", `{"unknown":1}`},
+		{"jsonschema.Scalars", `{"custom_name":"c"}`},
+		{"jsonschema.Scalars", `{"i32":2147483648}`},
+		{"jsonschema.Scalars", `{"u32":-1}`},
+		{"jsonschema.Scalars", `{"i32":1.5}`},
+		{"jsonschema.Scalars", `{"i64":1}`},
+		{"jsonschema.Scalars", `{"u64":"-1"}`},
+		{"jsonschema.Scalars", `{"d":"1"}`},
+		{"jsonschema.Scalars", `{"color":"BLUE"}`},
+		{"jsonschema.Scalars", `{"color":1}`},
+		{"jsonschema.Collections", `{"longs":[1]}`},
+		{"jsonschema.Collections", `{"byBool":{"yes":1}}`},
+		{"jsonschema.Collections", `{"byInt32":{"a":"b"}}`},
+		{"jsonschema.Collections", `{"byUint64":{"-1":"a"}}`},
+		{"jsonschema.Collections", `{"bySint64":{"1":{"s":1}}}`},
+		{"jsonschema.Collections", `{"colorsByName":{"r":"RED"}}`},
+		{"jsonschema.Choice", `{"name":"n","id":"1"}`},
+		{"jsonschema.Choice", `{"id":"1","scalars":{}}`},
+		{"jsonschema.WellKnown", `{"any":{"value":"1s"}}`},
+		{"jsonschema.WellKnown", `{"duration":"1m"}`},
+		{"jsonschema.WellKnown", `{"int64Value":1}`},
+		{"jsonschema.WellKnown", `{"int32Value":"1"}`},
+		{"jsonschema.WellKnown", `{"struct":[]}`},
+		{"jsonschema.WellKnown", `{"int64Values":[1]}`},
+		{"jsonschema.Options", `{"color":"RED"}`},
+		{"jsonschema.Options", `{"colors":["RED"]}`},
+		{"jsonschema.Options", `{"colorMap":{"r":"RED"}}`},
+		{"jsonschema.Options", `{"count":"1"}`},
+		{"jsonschema.Options", `{"count":9223372036854775808}`},
+		{"jsonschema.Options", `{"counts":[-1]}`},
+		{"jsonschema.Options", `{"countMap":{"a":"1"}}`},
+		{"jsonschema.Options", `{"wrapped":1}`},
+		{"jsonschema.Options", `{"plain":1}`},
+		{"jsonschema.Outer", `{"children":[{"inner":{"name":1}}]}`},
+		{"jsonschema.Legacy", `{}`},
+		{"jsonschema.Legacy", `{"id":"x","tag":"t"}`},
+	}
+	for _, tt := range tests {
+		v, schema := readSchema(t, tt.msg)
+		value, err := decode([]byte(tt.json))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if errs := v.validate(schema, value, "$"); len(errs) == 0 {
+			t.Errorf("%s accepts %s", tt.msg, tt.json)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: legacy.proto
+
+package jsonschema
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Legacy struct {
+	Id                           *string         `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	Count                        *int64          `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Details                      *Legacy_Details `protobuf:"group,3,opt,name=Details,json=details" json:"details,omitempty"`
+	Scalars                      []*Scalars      `protobuf:"bytes,5,rep,name=scalars" json:"scalars,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *Legacy) Reset()         { *m = Legacy{} }
+func (m *Legacy) String() string { return proto.CompactTextString(m) }
+func (*Legacy) ProtoMessage()    {}
+func (*Legacy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_legacy_df542fa3085ea8cb, []int{0}
+}
+
+var extRange_Legacy = []proto.ExtensionRange{
+	{Start: 100, End: 536870911},
+}
+
+func (*Legacy) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Legacy
+}
+func (m *Legacy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Legacy.Unmarshal(m, b)
+}
+func (m *Legacy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Legacy.Marshal(b, m, deterministic)
+}
+func (dst *Legacy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Legacy.Merge(dst, src)
+}
+func (m *Legacy) XXX_Size() int {
+	return xxx_messageInfo_Legacy.Size(m)
+}
+func (m *Legacy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Legacy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Legacy proto.InternalMessageInfo
+
+func (m *Legacy) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Legacy) GetCount() int64 {
+	if m != nil && m.Count != nil {
+		return *m.Count
+	}
+	return 0
+}
+
+func (m *Legacy) GetDetails() *Legacy_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *Legacy) GetScalars() []*Scalars {
+	if m != nil {
+		return m.Scalars
+	}
+	return nil
+}
+
+type Legacy_Details struct {
+	Note                 *string  `protobuf:"bytes,4,opt,name=note" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Legacy_Details) Reset()         { *m = Legacy_Details{} }
+func (m *Legacy_Details) String() string { return proto.CompactTextString(m) }
+func (*Legacy_Details) ProtoMessage()    {}
+func (*Legacy_Details) Descriptor() ([]byte, []int) {
+	return fileDescriptor_legacy_df542fa3085ea8cb, []int{0, 0}
+}
+func (m *Legacy_Details) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Legacy_Details.Unmarshal(m, b)
+}
+func (m *Legacy_Details) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Legacy_Details.Marshal(b, m, deterministic)
+}
+func (dst *Legacy_Details) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Legacy_Details.Merge(dst, src)
+}
+func (m *Legacy_Details) XXX_Size() int {
+	return xxx_messageInfo_Legacy_Details.Size(m)
+}
+func (m *Legacy_Details) XXX_DiscardUnknown() {
+	xxx_messageInfo_Legacy_Details.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Legacy_Details proto.InternalMessageInfo
+
+func (m *Legacy_Details) GetNote() string {
+	if m != nil && m.Note != nil {
+		return *m.Note
+	}
+	return ""
+}
+
+var E_Tag = &proto.ExtensionDesc{
+	ExtendedType:  (*Legacy)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         100,
+	Name:          "jsonschema.tag",
+	Tag:           "bytes,100,opt,name=tag",
+	Filename:      "legacy.proto",
+}
+
+func init() {
+	proto.RegisterType((*Legacy)(nil), "jsonschema.Legacy")
+	proto.RegisterType((*Legacy_Details)(nil), "jsonschema.Legacy.Details")
+	proto.RegisterExtension(E_Tag)
+}
+
+func init() { proto.RegisterFile("legacy.proto", fileDescriptor_legacy_df542fa3085ea8cb) }
+
+var fileDescriptor_legacy_df542fa3085ea8cb = []byte{
+	// 206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x8e, 0xb1, 0x4a, 0xc5, 0x30,
+	0x14, 0x86, 0x49, 0x72, 0xaf, 0xbd, 0xf7, 0x54, 0x44, 0x8e, 0x0e, 0xa1, 0x20, 0x04, 0x71, 0x08,
+	0x82, 0x19, 0x8a, 0x93, 0xb3, 0xa3, 0x53, 0x7c, 0x82, 0x90, 0x84, 0x5a, 0xa9, 0x4d, 0x69, 0xe2,
+	0xd0, 0x2d, 0x0f, 0xe6, 0xc3, 0x89, 0x8d, 0x45, 0xe1, 0x6e, 0x09, 0xdf, 0xf7, 0x7f, 0x1c, 0x38,
+	0x1f, 0x7c, 0x67, 0xec, 0xa2, 0xa6, 0x39, 0xa4, 0x80, 0xf0, 0x1e, 0xc3, 0x18, 0xed, 0x9b, 0xff,
+	0x30, 0x4d, 0x9d, 0x96, 0xc9, 0xc7, 0x02, 0x6e, 0xbf, 0x08, 0x9c, 0xbd, 0xac, 0x26, 0x5e, 0x00,
+	0xed, 0x1d, 0x27, 0x82, 0xca, 0xa3, 0xa6, 0xbd, 0xc3, 0x6b, 0xd8, 0xdb, 0xf0, 0x39, 0x26, 0x4e,
+	0x05, 0x91, 0x4c, 0x97, 0x0f, 0x3e, 0x42, 0xe5, 0x7c, 0x32, 0xfd, 0x10, 0x39, 0x13, 0x44, 0x42,
+	0xdb, 0xa8, 0xbf, 0xb6, 0x2a, 0x29, 0xf5, 0x5c, 0x0c, 0xbd, 0xa9, 0xf8, 0x00, 0x55, 0xb4, 0x66,
+	0x30, 0x73, 0xe4, 0x7b, 0xc1, 0x64, 0xdd, 0x5e, 0xfd, 0x5f, 0xbd, 0x16, 0xa4, 0x37, 0xa7, 0xb9,
+	0x81, 0xea, 0x37, 0x81, 0x08, 0xbb, 0x31, 0x24, 0xcf, 0x77, 0x82, 0xc8, 0xa3, 0x5e, 0xdf, 0xf7,
+	0x87, 0x83, 0xbb, 0xcc, 0x39, 0x67, 0xfa, 0x74, 0x07, 0x2c, 0x99, 0x0e, 0xf1, 0xf4, 0x06, 0xee,
+	0xd6, 0xc1, 0x0f, 0xfe, 0x1e, 0x00, 0xcc, 0x21, 0xd4, 0x90, 0x0c, 0x01, 0x00, 0x00,
+}
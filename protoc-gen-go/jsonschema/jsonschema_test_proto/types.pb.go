@@ -0,0 +1,1034 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: types.proto
+
+package jsonschema
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import any "github.com/golang/protobuf/ptypes/any"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import _ "github.com/golang/protobuf/ptypes/network/api"
+import _struct "github.com/golang/protobuf/ptypes/struct"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_RED               Color = 1
+	Color_GREEN             Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "COLOR_UNSPECIFIED",
+	1: "RED",
+	2: "GREEN",
+}
+var Color_value = map[string]int32{
+	"COLOR_UNSPECIFIED": 0,
+	"RED":               1,
+	"GREEN":             2,
+}
+
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{0}
+}
+
+type Scalars struct {
+	D                    float64  `protobuf:"fixed64,1,opt,name=d,proto3" json:"d,omitempty"`
+	F                    float32  `protobuf:"fixed32,2,opt,name=f,proto3" json:"f,omitempty"`
+	I32                  int32    `protobuf:"varint,3,opt,name=i32,proto3" json:"i32,omitempty"`
+	S32                  int32    `protobuf:"zigzag32,4,opt,name=s32,proto3" json:"s32,omitempty"`
+	Sf32                 int32    `protobuf:"fixed32,5,opt,name=sf32,proto3" json:"sf32,omitempty"`
+	U32                  uint32   `protobuf:"varint,6,opt,name=u32,proto3" json:"u32,omitempty"`
+	F32                  uint32   `protobuf:"fixed32,7,opt,name=f32,proto3" json:"f32,omitempty"`
+	I64                  int64    `protobuf:"varint,8,opt,name=i64,proto3" json:"i64,omitempty"`
+	S64                  int64    `protobuf:"zigzag64,9,opt,name=s64,proto3" json:"s64,omitempty"`
+	Sf64                 int64    `protobuf:"fixed64,10,opt,name=sf64,proto3" json:"sf64,omitempty"`
+	U64                  uint64   `protobuf:"varint,11,opt,name=u64,proto3" json:"u64,omitempty"`
+	F64                  uint64   `protobuf:"fixed64,12,opt,name=f64,proto3" json:"f64,omitempty"`
+	B                    bool     `protobuf:"varint,13,opt,name=b,proto3" json:"b,omitempty"`
+	S                    string   `protobuf:"bytes,14,opt,name=s,proto3" json:"s,omitempty"`
+	By                   []byte   `protobuf:"bytes,15,opt,name=by,proto3" json:"by,omitempty"`
+	Color                Color    `protobuf:"varint,16,opt,name=color,proto3,enum=jsonschema.Color" json:"color,omitempty"`
+	CustomName           string   `protobuf:"bytes,17,opt,name=custom_name,json=renamed,proto3" json:"custom_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Scalars) Reset()         { *m = Scalars{} }
+func (m *Scalars) String() string { return proto.CompactTextString(m) }
+func (*Scalars) ProtoMessage()    {}
+func (*Scalars) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{0}
+}
+func (m *Scalars) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Scalars.Unmarshal(m, b)
+}
+func (m *Scalars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Scalars.Marshal(b, m, deterministic)
+}
+func (dst *Scalars) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Scalars.Merge(dst, src)
+}
+func (m *Scalars) XXX_Size() int {
+	return xxx_messageInfo_Scalars.Size(m)
+}
+func (m *Scalars) XXX_DiscardUnknown() {
+	xxx_messageInfo_Scalars.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Scalars proto.InternalMessageInfo
+
+func (m *Scalars) GetD() float64 {
+	if m != nil {
+		return m.D
+	}
+	return 0
+}
+
+func (m *Scalars) GetF() float32 {
+	if m != nil {
+		return m.F
+	}
+	return 0
+}
+
+func (m *Scalars) GetI32() int32 {
+	if m != nil {
+		return m.I32
+	}
+	return 0
+}
+
+func (m *Scalars) GetS32() int32 {
+	if m != nil {
+		return m.S32
+	}
+	return 0
+}
+
+func (m *Scalars) GetSf32() int32 {
+	if m != nil {
+		return m.Sf32
+	}
+	return 0
+}
+
+func (m *Scalars) GetU32() uint32 {
+	if m != nil {
+		return m.U32
+	}
+	return 0
+}
+
+func (m *Scalars) GetF32() uint32 {
+	if m != nil {
+		return m.F32
+	}
+	return 0
+}
+
+func (m *Scalars) GetI64() int64 {
+	if m != nil {
+		return m.I64
+	}
+	return 0
+}
+
+func (m *Scalars) GetS64() int64 {
+	if m != nil {
+		return m.S64
+	}
+	return 0
+}
+
+func (m *Scalars) GetSf64() int64 {
+	if m != nil {
+		return m.Sf64
+	}
+	return 0
+}
+
+func (m *Scalars) GetU64() uint64 {
+	if m != nil {
+		return m.U64
+	}
+	return 0
+}
+
+func (m *Scalars) GetF64() uint64 {
+	if m != nil {
+		return m.F64
+	}
+	return 0
+}
+
+func (m *Scalars) GetB() bool {
+	if m != nil {
+		return m.B
+	}
+	return false
+}
+
+func (m *Scalars) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *Scalars) GetBy() []byte {
+	if m != nil {
+		return m.By
+	}
+	return nil
+}
+
+func (m *Scalars) GetColor() Color {
+	if m != nil {
+		return m.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (m *Scalars) GetCustomName() string {
+	if m != nil {
+		return m.CustomName
+	}
+	return ""
+}
+
+type Collections struct {
+	Ints                 []int32            `protobuf:"varint,1,rep,packed,name=ints,proto3" json:"ints,omitempty"`
+	Longs                []int64            `protobuf:"varint,2,rep,packed,name=longs,proto3" json:"longs,omitempty"`
+	Colors               []Color            `protobuf:"varint,3,rep,packed,name=colors,proto3,enum=jsonschema.Color" json:"colors,omitempty"`
+	Scalars              []*Scalars         `protobuf:"bytes,4,rep,name=scalars,proto3" json:"scalars,omitempty"`
+	ByString             map[string]string  `protobuf:"bytes,5,rep,name=by_string,json=byString,proto3" json:"by_string,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ByBool               map[bool]int32     `protobuf:"bytes,6,rep,name=by_bool,json=byBool,proto3" json:"by_bool,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ByInt32              map[int32]string   `protobuf:"bytes,7,rep,name=by_int32,json=byInt32,proto3" json:"by_int32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ByInt64              map[int64]string   `protobuf:"bytes,8,rep,name=by_int64,json=byInt64,proto3" json:"by_int64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ByUint32             map[uint32]string  `protobuf:"bytes,9,rep,name=by_uint32,json=byUint32,proto3" json:"by_uint32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ByUint64             map[uint64]string  `protobuf:"bytes,10,rep,name=by_uint64,json=byUint64,proto3" json:"by_uint64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BySint64             map[int64]*Scalars `protobuf:"bytes,11,rep,name=by_sint64,json=bySint64,proto3" json:"by_sint64,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ColorsByName         map[string]Color   `protobuf:"bytes,12,rep,name=colors_by_name,json=colorsByName,proto3" json:"colors_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jsonschema.Color"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Collections) Reset()         { *m = Collections{} }
+func (m *Collections) String() string { return proto.CompactTextString(m) }
+func (*Collections) ProtoMessage()    {}
+func (*Collections) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{1}
+}
+func (m *Collections) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Collections.Unmarshal(m, b)
+}
+func (m *Collections) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Collections.Marshal(b, m, deterministic)
+}
+func (dst *Collections) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collections.Merge(dst, src)
+}
+func (m *Collections) XXX_Size() int {
+	return xxx_messageInfo_Collections.Size(m)
+}
+func (m *Collections) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collections.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Collections proto.InternalMessageInfo
+
+func (m *Collections) GetInts() []int32 {
+	if m != nil {
+		return m.Ints
+	}
+	return nil
+}
+
+func (m *Collections) GetLongs() []int64 {
+	if m != nil {
+		return m.Longs
+	}
+	return nil
+}
+
+func (m *Collections) GetColors() []Color {
+	if m != nil {
+		return m.Colors
+	}
+	return nil
+}
+
+func (m *Collections) GetScalars() []*Scalars {
+	if m != nil {
+		return m.Scalars
+	}
+	return nil
+}
+
+func (m *Collections) GetByString() map[string]string {
+	if m != nil {
+		return m.ByString
+	}
+	return nil
+}
+
+func (m *Collections) GetByBool() map[bool]int32 {
+	if m != nil {
+		return m.ByBool
+	}
+	return nil
+}
+
+func (m *Collections) GetByInt32() map[int32]string {
+	if m != nil {
+		return m.ByInt32
+	}
+	return nil
+}
+
+func (m *Collections) GetByInt64() map[int64]string {
+	if m != nil {
+		return m.ByInt64
+	}
+	return nil
+}
+
+func (m *Collections) GetByUint32() map[uint32]string {
+	if m != nil {
+		return m.ByUint32
+	}
+	return nil
+}
+
+func (m *Collections) GetByUint64() map[uint64]string {
+	if m != nil {
+		return m.ByUint64
+	}
+	return nil
+}
+
+func (m *Collections) GetBySint64() map[int64]*Scalars {
+	if m != nil {
+		return m.BySint64
+	}
+	return nil
+}
+
+func (m *Collections) GetColorsByName() map[string]Color {
+	if m != nil {
+		return m.ColorsByName
+	}
+	return nil
+}
+
+type Choice struct {
+	// Types that are valid to be assigned to Kind:
+	//	*Choice_Name
+	//	*Choice_Id
+	//	*Choice_Scalars
+	Kind isChoice_Kind `protobuf_oneof:"kind"`
+	// Types that are valid to be assigned to Single:
+	//	*Choice_Only
+	Single               isChoice_Single `protobuf_oneof:"single"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Choice) Reset()         { *m = Choice{} }
+func (m *Choice) String() string { return proto.CompactTextString(m) }
+func (*Choice) ProtoMessage()    {}
+func (*Choice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{2}
+}
+func (m *Choice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Choice.Unmarshal(m, b)
+}
+func (m *Choice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Choice.Marshal(b, m, deterministic)
+}
+func (dst *Choice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Choice.Merge(dst, src)
+}
+func (m *Choice) XXX_Size() int {
+	return xxx_messageInfo_Choice.Size(m)
+}
+func (m *Choice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Choice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Choice proto.InternalMessageInfo
+
+type isChoice_Kind interface {
+	isChoice_Kind()
+}
+type isChoice_Single interface {
+	isChoice_Single()
+}
+
+type Choice_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+type Choice_Id struct {
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3,oneof"`
+}
+type Choice_Scalars struct {
+	Scalars *Scalars `protobuf:"bytes,3,opt,name=scalars,proto3,oneof"`
+}
+type Choice_Only struct {
+	Only bool `protobuf:"varint,4,opt,name=only,proto3,oneof"`
+}
+
+func (*Choice_Name) isChoice_Kind()    {}
+func (*Choice_Id) isChoice_Kind()      {}
+func (*Choice_Scalars) isChoice_Kind() {}
+func (*Choice_Only) isChoice_Single()  {}
+
+func (m *Choice) GetKind() isChoice_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+func (m *Choice) GetSingle() isChoice_Single {
+	if m != nil {
+		return m.Single
+	}
+	return nil
+}
+
+func (m *Choice) GetName() string {
+	if x, ok := m.GetKind().(*Choice_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *Choice) GetId() int64 {
+	if x, ok := m.GetKind().(*Choice_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (m *Choice) GetScalars() *Scalars {
+	if x, ok := m.GetKind().(*Choice_Scalars); ok {
+		return x.Scalars
+	}
+	return nil
+}
+
+func (m *Choice) GetOnly() bool {
+	if x, ok := m.GetSingle().(*Choice_Only); ok {
+		return x.Only
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Choice) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Choice_OneofMarshaler, _Choice_OneofUnmarshaler, _Choice_OneofSizer, []interface{}{
+		(*Choice_Name)(nil),
+		(*Choice_Id)(nil),
+		(*Choice_Scalars)(nil),
+		(*Choice_Only)(nil),
+	}
+}
+
+func _Choice_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Choice)
+	// kind
+	switch x := m.Kind.(type) {
+	case *Choice_Name:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Name)
+	case *Choice_Id:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Id))
+	case *Choice_Scalars:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Scalars); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Choice.Kind has unexpected type %T", x)
+	}
+	// single
+	switch x := m.Single.(type) {
+	case *Choice_Only:
+		t := uint64(0)
+		if x.Only {
+			t = 1
+		}
+		b.EncodeVarint(4<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("Choice.Single has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Choice_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Choice)
+	switch tag {
+	case 1: // kind.name
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Kind = &Choice_Name{x}
+		return true, err
+	case 2: // kind.id
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Kind = &Choice_Id{int64(x)}
+		return true, err
+	case 3: // kind.scalars
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Scalars)
+		err := b.DecodeMessage(msg)
+		m.Kind = &Choice_Scalars{msg}
+		return true, err
+	case 4: // single.only
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Single = &Choice_Only{x != 0}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Choice_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Choice)
+	// kind
+	switch x := m.Kind.(type) {
+	case *Choice_Name:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Name)))
+		n += len(x.Name)
+	case *Choice_Id:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Id))
+	case *Choice_Scalars:
+		s := proto.Size(x.Scalars)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	// single
+	switch x := m.Single.(type) {
+	case *Choice_Only:
+		n += 1 // tag and wire
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type WellKnown struct {
+	Any                  *any.Any               `protobuf:"bytes,1,opt,name=any,proto3" json:"any,omitempty"`
+	Duration             *duration.Duration     `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Timestamp            *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Struct               *_struct.Struct        `protobuf:"bytes,4,opt,name=struct,proto3" json:"struct,omitempty"`
+	Value                *_struct.Value         `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	List                 *_struct.ListValue     `protobuf:"bytes,6,opt,name=list,proto3" json:"list,omitempty"`
+	Null                 _struct.NullValue      `protobuf:"varint,7,opt,name=null,proto3,enum=google.protobuf.NullValue" json:"null,omitempty"`
+	DoubleValue          *wrappers.DoubleValue  `protobuf:"bytes,8,opt,name=double_value,json=doubleValue,proto3" json:"double_value,omitempty"`
+	FloatValue           *wrappers.FloatValue   `protobuf:"bytes,9,opt,name=float_value,json=floatValue,proto3" json:"float_value,omitempty"`
+	Int64Value           *wrappers.Int64Value   `protobuf:"bytes,10,opt,name=int64_value,json=int64Value,proto3" json:"int64_value,omitempty"`
+	Uint64Value          *wrappers.UInt64Value  `protobuf:"bytes,11,opt,name=uint64_value,json=uint64Value,proto3" json:"uint64_value,omitempty"`
+	Int32Value           *wrappers.Int32Value   `protobuf:"bytes,12,opt,name=int32_value,json=int32Value,proto3" json:"int32_value,omitempty"`
+	Uint32Value          *wrappers.UInt32Value  `protobuf:"bytes,13,opt,name=uint32_value,json=uint32Value,proto3" json:"uint32_value,omitempty"`
+	BoolValue            *wrappers.BoolValue    `protobuf:"bytes,14,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
+	StringValue          *wrappers.StringValue  `protobuf:"bytes,15,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	BytesValue           *wrappers.BytesValue   `protobuf:"bytes,16,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+	Int64Values          []*wrappers.Int64Value `protobuf:"bytes,17,rep,name=int64_values,json=int64Values,proto3" json:"int64_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *WellKnown) Reset()         { *m = WellKnown{} }
+func (m *WellKnown) String() string { return proto.CompactTextString(m) }
+func (*WellKnown) ProtoMessage()    {}
+func (*WellKnown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{3}
+}
+func (m *WellKnown) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WellKnown.Unmarshal(m, b)
+}
+func (m *WellKnown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WellKnown.Marshal(b, m, deterministic)
+}
+func (dst *WellKnown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WellKnown.Merge(dst, src)
+}
+func (m *WellKnown) XXX_Size() int {
+	return xxx_messageInfo_WellKnown.Size(m)
+}
+func (m *WellKnown) XXX_DiscardUnknown() {
+	xxx_messageInfo_WellKnown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WellKnown proto.InternalMessageInfo
+
+func (m *WellKnown) GetAny() *any.Any {
+	if m != nil {
+		return m.Any
+	}
+	return nil
+}
+
+func (m *WellKnown) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *WellKnown) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *WellKnown) GetStruct() *_struct.Struct {
+	if m != nil {
+		return m.Struct
+	}
+	return nil
+}
+
+func (m *WellKnown) GetValue() *_struct.Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *WellKnown) GetList() *_struct.ListValue {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *WellKnown) GetNull() _struct.NullValue {
+	if m != nil {
+		return m.Null
+	}
+	return _struct.NullValue_NULL_VALUE
+}
+
+func (m *WellKnown) GetDoubleValue() *wrappers.DoubleValue {
+	if m != nil {
+		return m.DoubleValue
+	}
+	return nil
+}
+
+func (m *WellKnown) GetFloatValue() *wrappers.FloatValue {
+	if m != nil {
+		return m.FloatValue
+	}
+	return nil
+}
+
+func (m *WellKnown) GetInt64Value() *wrappers.Int64Value {
+	if m != nil {
+		return m.Int64Value
+	}
+	return nil
+}
+
+func (m *WellKnown) GetUint64Value() *wrappers.UInt64Value {
+	if m != nil {
+		return m.Uint64Value
+	}
+	return nil
+}
+
+func (m *WellKnown) GetInt32Value() *wrappers.Int32Value {
+	if m != nil {
+		return m.Int32Value
+	}
+	return nil
+}
+
+func (m *WellKnown) GetUint32Value() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Uint32Value
+	}
+	return nil
+}
+
+func (m *WellKnown) GetBoolValue() *wrappers.BoolValue {
+	if m != nil {
+		return m.BoolValue
+	}
+	return nil
+}
+
+func (m *WellKnown) GetStringValue() *wrappers.StringValue {
+	if m != nil {
+		return m.StringValue
+	}
+	return nil
+}
+
+func (m *WellKnown) GetBytesValue() *wrappers.BytesValue {
+	if m != nil {
+		return m.BytesValue
+	}
+	return nil
+}
+
+func (m *WellKnown) GetInt64Values() []*wrappers.Int64Value {
+	if m != nil {
+		return m.Int64Values
+	}
+	return nil
+}
+
+type Options struct {
+	Color                Color                `protobuf:"varint,1,opt,name=color,proto3,enum=jsonschema.Color" json:"color,omitempty"`
+	Colors               []Color              `protobuf:"varint,2,rep,packed,name=colors,proto3,enum=jsonschema.Color" json:"colors,omitempty"`
+	ColorMap             map[string]Color     `protobuf:"bytes,3,rep,name=color_map,json=colorMap,proto3" json:"color_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=jsonschema.Color"`
+	Count                int64                `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Counts               []uint64             `protobuf:"varint,5,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	CountMap             map[string]int64     `protobuf:"bytes,6,rep,name=count_map,json=countMap,proto3" json:"count_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Small                int32                `protobuf:"varint,7,opt,name=small,proto3" json:"small,omitempty"`
+	Wrapped              *wrappers.Int64Value `protobuf:"bytes,8,opt,name=wrapped,proto3" json:"wrapped,omitempty"`
+	Note                 string               `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	Plain                uint64               `protobuf:"fixed64,10,opt,name=plain,proto3" json:"plain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Options) Reset()         { *m = Options{} }
+func (m *Options) String() string { return proto.CompactTextString(m) }
+func (*Options) ProtoMessage()    {}
+func (*Options) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{4}
+}
+func (m *Options) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Options.Unmarshal(m, b)
+}
+func (m *Options) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Options.Marshal(b, m, deterministic)
+}
+func (dst *Options) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Options.Merge(dst, src)
+}
+func (m *Options) XXX_Size() int {
+	return xxx_messageInfo_Options.Size(m)
+}
+func (m *Options) XXX_DiscardUnknown() {
+	xxx_messageInfo_Options.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Options proto.InternalMessageInfo
+
+func (m *Options) GetColor() Color {
+	if m != nil {
+		return m.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (m *Options) GetColors() []Color {
+	if m != nil {
+		return m.Colors
+	}
+	return nil
+}
+
+func (m *Options) GetColorMap() map[string]Color {
+	if m != nil {
+		return m.ColorMap
+	}
+	return nil
+}
+
+func (m *Options) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Options) GetCounts() []uint64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Options) GetCountMap() map[string]int64 {
+	if m != nil {
+		return m.CountMap
+	}
+	return nil
+}
+
+func (m *Options) GetSmall() int32 {
+	if m != nil {
+		return m.Small
+	}
+	return 0
+}
+
+func (m *Options) GetWrapped() *wrappers.Int64Value {
+	if m != nil {
+		return m.Wrapped
+	}
+	return nil
+}
+
+func (m *Options) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *Options) GetPlain() uint64 {
+	if m != nil {
+		return m.Plain
+	}
+	return 0
+}
+
+type Outer struct {
+	Inner                *Outer_Inner `protobuf:"bytes,1,opt,name=inner,proto3" json:"inner,omitempty"`
+	Children             []*Outer     `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Outer) Reset()         { *m = Outer{} }
+func (m *Outer) String() string { return proto.CompactTextString(m) }
+func (*Outer) ProtoMessage()    {}
+func (*Outer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{5}
+}
+func (m *Outer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Outer.Unmarshal(m, b)
+}
+func (m *Outer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Outer.Marshal(b, m, deterministic)
+}
+func (dst *Outer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outer.Merge(dst, src)
+}
+func (m *Outer) XXX_Size() int {
+	return xxx_messageInfo_Outer.Size(m)
+}
+func (m *Outer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outer proto.InternalMessageInfo
+
+func (m *Outer) GetInner() *Outer_Inner {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *Outer) GetChildren() []*Outer {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type Outer_Inner struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Outer_Inner) Reset()         { *m = Outer_Inner{} }
+func (m *Outer_Inner) String() string { return proto.CompactTextString(m) }
+func (*Outer_Inner) ProtoMessage()    {}
+func (*Outer_Inner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_1797c8d886146dc3, []int{5, 0}
+}
+func (m *Outer_Inner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Outer_Inner.Unmarshal(m, b)
+}
+func (m *Outer_Inner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Outer_Inner.Marshal(b, m, deterministic)
+}
+func (dst *Outer_Inner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outer_Inner.Merge(dst, src)
+}
+func (m *Outer_Inner) XXX_Size() int {
+	return xxx_messageInfo_Outer_Inner.Size(m)
+}
+func (m *Outer_Inner) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outer_Inner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outer_Inner proto.InternalMessageInfo
+
+func (m *Outer_Inner) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Scalars)(nil), "jsonschema.Scalars")
+	proto.RegisterType((*Collections)(nil), "jsonschema.Collections")
+	proto.RegisterMapType((map[bool]int32)(nil), "jsonschema.Collections.ByBoolEntry")
+	proto.RegisterMapType((map[int32]string)(nil), "jsonschema.Collections.ByInt32Entry")
+	proto.RegisterMapType((map[int64]string)(nil), "jsonschema.Collections.ByInt64Entry")
+	proto.RegisterMapType((map[int64]*Scalars)(nil), "jsonschema.Collections.BySint64Entry")
+	proto.RegisterMapType((map[string]string)(nil), "jsonschema.Collections.ByStringEntry")
+	proto.RegisterMapType((map[uint32]string)(nil), "jsonschema.Collections.ByUint32Entry")
+	proto.RegisterMapType((map[uint64]string)(nil), "jsonschema.Collections.ByUint64Entry")
+	proto.RegisterMapType((map[string]Color)(nil), "jsonschema.Collections.ColorsByNameEntry")
+	proto.RegisterType((*Choice)(nil), "jsonschema.Choice")
+	proto.RegisterType((*WellKnown)(nil), "jsonschema.WellKnown")
+	proto.RegisterType((*Options)(nil), "jsonschema.Options")
+	proto.RegisterMapType((map[string]Color)(nil), "jsonschema.Options.ColorMapEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "jsonschema.Options.CountMapEntry")
+	proto.RegisterType((*Outer)(nil), "jsonschema.Outer")
+	proto.RegisterType((*Outer_Inner)(nil), "jsonschema.Outer.Inner")
+	proto.RegisterEnum("jsonschema.Color", Color_name, Color_value)
+}
+
+func init() { proto.RegisterFile("types.proto", fileDescriptor_types_1797c8d886146dc3) }
+
+var fileDescriptor_types_1797c8d886146dc3 = []byte{
+	// 1371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xcf, 0x5a, 0x96, 0x2d, 0x3d, 0x39, 0xa9, 0xbd, 0x04, 0xaa, 0xba, 0x99, 0x22, 0xc2, 0x9f,
+	0xaa, 0x0c, 0x75, 0x06, 0xd9, 0x78, 0xda, 0xd2, 0xa1, 0x83, 0xd3, 0x94, 0x64, 0x28, 0x49, 0x67,
+	0x43, 0xe1, 0xe8, 0x91, 0x6c, 0x25, 0x15, 0x95, 0x25, 0x8f, 0x57, 0xa2, 0xa3, 0x2b, 0x37, 0xce,
+	0xdc, 0xf8, 0x38, 0x7c, 0x02, 0x66, 0xb8, 0x71, 0xe6, 0xc6, 0x97, 0x60, 0xf6, 0x8f, 0x6c, 0x39,
+	0xb6, 0xe2, 0xce, 0x70, 0x7b, 0x4f, 0xfb, 0xfb, 0xfd, 0xde, 0xdb, 0x7d, 0xbb, 0xfb, 0x56, 0x60,
+	0x24, 0xd9, 0xd4, 0xa7, 0x9d, 0xe9, 0x2c, 0x4e, 0x62, 0x0c, 0x3f, 0xd1, 0x38, 0xa2, 0xa3, 0x57,
+	0xfe, 0xc4, 0x6d, 0xdf, 0xba, 0x8c, 0xe3, 0xcb, 0xd0, 0x3f, 0xe0, 0x23, 0x5e, 0x7a, 0x71, 0xe0,
+	0x46, 0x99, 0x80, 0xb5, 0xef, 0x5c, 0x1d, 0x1a, 0xa7, 0x33, 0x37, 0x09, 0xe2, 0x48, 0x8e, 0xef,
+	0x5d, 0x1d, 0xa7, 0xc9, 0x2c, 0x1d, 0x25, 0x72, 0xf4, 0xfd, 0xab, 0xa3, 0x49, 0x30, 0xf1, 0x69,
+	0xe2, 0x4e, 0xa6, 0x65, 0xf2, 0x6f, 0x66, 0xee, 0x74, 0xea, 0xcf, 0x64, 0x96, 0x6d, 0x9e, 0xa5,
+	0xb0, 0xf7, 0xff, 0xac, 0x40, 0xfd, 0x7c, 0xe4, 0x86, 0xee, 0x8c, 0xe2, 0x06, 0xa0, 0xb1, 0x89,
+	0x2c, 0x64, 0x23, 0x82, 0xc6, 0xcc, 0xbb, 0x30, 0x2b, 0x16, 0xb2, 0x2b, 0x04, 0x5d, 0xe0, 0x26,
+	0x28, 0x41, 0xd7, 0x31, 0x15, 0x0b, 0xd9, 0x2a, 0x61, 0x26, 0xfb, 0x42, 0xbb, 0x8e, 0x59, 0xb5,
+	0x90, 0xdd, 0x22, 0xcc, 0xc4, 0x18, 0xaa, 0xf4, 0xa2, 0xeb, 0x98, 0xaa, 0x85, 0xec, 0x1b, 0x84,
+	0xdb, 0x0c, 0x95, 0x76, 0x1d, 0xb3, 0x66, 0x21, 0x7b, 0x9b, 0x28, 0xa9, 0xf8, 0xc2, 0x40, 0x75,
+	0x0b, 0xd9, 0x75, 0xa2, 0x48, 0x4c, 0xd0, 0xef, 0x99, 0x9a, 0x85, 0x6c, 0x85, 0x30, 0x93, 0x6b,
+	0xf7, 0x7b, 0xa6, 0x6e, 0x21, 0x1b, 0x13, 0x66, 0x0a, 0xed, 0x7e, 0xcf, 0x04, 0x0b, 0xd9, 0x4d,
+	0xc2, 0x6d, 0xae, 0xdd, 0xef, 0x99, 0x86, 0x85, 0xec, 0x2a, 0x51, 0x52, 0xf1, 0x85, 0x81, 0x1a,
+	0x16, 0xb2, 0x6b, 0x84, 0x99, 0x6c, 0x16, 0x9e, 0xb9, 0x6d, 0x21, 0x5b, 0x23, 0xc8, 0x63, 0x1e,
+	0x35, 0x77, 0x2c, 0x64, 0xeb, 0x04, 0x51, 0xbc, 0x03, 0x15, 0x2f, 0x33, 0x6f, 0x58, 0xc8, 0x6e,
+	0x90, 0x8a, 0x97, 0xe1, 0xbb, 0xa0, 0x8e, 0xe2, 0x30, 0x9e, 0x99, 0x4d, 0x0b, 0xd9, 0x3b, 0x4e,
+	0xab, 0xb3, 0xa8, 0x66, 0xe7, 0x90, 0x0d, 0x10, 0x31, 0x8e, 0xf7, 0xc0, 0x18, 0xa5, 0x34, 0x89,
+	0x27, 0xc3, 0xc8, 0x9d, 0xf8, 0x66, 0x8b, 0x0b, 0xd6, 0x67, 0x3e, 0xf3, 0xc6, 0xfb, 0x7f, 0xe8,
+	0x60, 0x1c, 0xc6, 0x61, 0xe8, 0x8f, 0x58, 0x49, 0x29, 0x4b, 0x3d, 0x88, 0x12, 0x6a, 0x22, 0x4b,
+	0xb1, 0x55, 0xc2, 0x6d, 0xbc, 0x0b, 0x6a, 0x18, 0x47, 0x97, 0xd4, 0xac, 0x58, 0x8a, 0xad, 0x10,
+	0xe1, 0xe0, 0x7b, 0x50, 0xe3, 0x01, 0xa8, 0xa9, 0x58, 0xca, 0xfa, 0x0c, 0x24, 0x00, 0xdf, 0x87,
+	0x3a, 0x15, 0x65, 0x33, 0xab, 0x96, 0x62, 0x1b, 0xce, 0x3b, 0x45, 0xac, 0xac, 0x28, 0xc9, 0x31,
+	0x78, 0x00, 0xba, 0x97, 0x0d, 0x69, 0x32, 0x0b, 0xa2, 0x4b, 0x53, 0xe5, 0x84, 0x8f, 0xaf, 0x88,
+	0xe7, 0xf9, 0x76, 0x06, 0xd9, 0x39, 0xc7, 0x1d, 0x45, 0xc9, 0x2c, 0x23, 0x9a, 0x27, 0x5d, 0xfc,
+	0x18, 0xea, 0x5e, 0x36, 0xf4, 0xe2, 0x38, 0x34, 0x6b, 0x5c, 0xe1, 0xc3, 0x72, 0x85, 0x41, 0x1c,
+	0x87, 0x82, 0x5f, 0xf3, 0xb8, 0x83, 0x9f, 0x80, 0xe6, 0x65, 0xc3, 0x20, 0x4a, 0x78, 0xed, 0x19,
+	0xfd, 0xa3, 0x72, 0xfa, 0x09, 0x83, 0x09, 0x7e, 0xdd, 0x13, 0xde, 0x42, 0x80, 0x6f, 0x95, 0xcd,
+	0x02, 0xfd, 0x5e, 0x51, 0xa0, 0xdf, 0x93, 0x6b, 0x90, 0x8a, 0x14, 0xf4, 0x4d, 0x6b, 0xf0, 0x32,
+	0x58, 0xe4, 0xa0, 0x79, 0xd2, 0x2d, 0x68, 0xf0, 0xbd, 0xf8, 0x16, 0x1a, 0xfd, 0xde, 0x92, 0xc6,
+	0x3c, 0x0f, 0x2a, 0x34, 0x8c, 0x8d, 0xb5, 0x58, 0xd6, 0x10, 0x2e, 0x3e, 0x83, 0x1d, 0xb1, 0x11,
+	0x86, 0x5e, 0x26, 0x36, 0x61, 0x83, 0x0b, 0xdd, 0x2b, 0x13, 0xe2, 0xbb, 0x87, 0x0e, 0xb2, 0x53,
+	0x77, 0xe2, 0x0b, 0xb1, 0xc6, 0xa8, 0xf0, 0xa9, 0xfd, 0x25, 0x6c, 0x2f, 0xd5, 0x9d, 0x1d, 0xa5,
+	0xd7, 0x7e, 0xc6, 0xaf, 0x03, 0x9d, 0x30, 0x93, 0xed, 0xd9, 0x9f, 0xdd, 0x30, 0xf5, 0xf9, 0xa5,
+	0xa0, 0x13, 0xe1, 0x3c, 0xaa, 0x3c, 0x40, 0xed, 0x87, 0x60, 0x14, 0x4a, 0x5e, 0xa4, 0x6a, 0x6b,
+	0xa8, 0x6a, 0x91, 0xfa, 0x08, 0x1a, 0xc5, 0x72, 0x17, 0xb9, 0xea, 0xa6, 0xb0, 0x39, 0xb7, 0xdf,
+	0x5b, 0xe1, 0x2a, 0x9b, 0xb8, 0x7c, 0xbe, 0x85, 0x1a, 0x17, 0xc9, 0xdb, 0x6f, 0x4d, 0x5e, 0x13,
+	0xb9, 0xba, 0x89, 0xfc, 0x82, 0xaf, 0xf4, 0x7a, 0x32, 0x16, 0xe4, 0x7b, 0x45, 0x72, 0xc9, 0xd1,
+	0x2e, 0x28, 0x12, 0x68, 0xad, 0x94, 0x77, 0x4d, 0xfd, 0xee, 0x16, 0x55, 0xd7, 0x5f, 0x6f, 0x73,
+	0xcd, 0xfd, 0x5f, 0x11, 0xd4, 0x0e, 0x5f, 0xc5, 0xc1, 0xc8, 0xc7, 0xbb, 0x50, 0xe5, 0x3b, 0x8c,
+	0x4b, 0x1d, 0x6f, 0x11, 0xee, 0xe1, 0x26, 0x54, 0x82, 0x31, 0x97, 0x52, 0x8e, 0xb7, 0x48, 0x25,
+	0x18, 0xe3, 0x83, 0xc5, 0x95, 0xa4, 0x94, 0xe6, 0x7d, 0xbc, 0xb5, 0xb8, 0x94, 0x76, 0xa1, 0x1a,
+	0x47, 0x61, 0xc6, 0x5b, 0x88, 0x76, 0x8c, 0x08, 0xf7, 0x06, 0x35, 0xa8, 0xbe, 0x0e, 0xa2, 0xf1,
+	0x40, 0x83, 0x1a, 0x0d, 0xa2, 0xcb, 0xd0, 0xdf, 0xff, 0xbb, 0x0e, 0xfa, 0x8f, 0x7e, 0x18, 0x7e,
+	0x1b, 0xc5, 0x6f, 0x22, 0xfc, 0x09, 0x28, 0x6e, 0x24, 0x26, 0x66, 0x38, 0xbb, 0x1d, 0xd1, 0xeb,
+	0x3a, 0x79, 0xaf, 0xeb, 0x7c, 0x1d, 0x65, 0x84, 0x01, 0xf0, 0x17, 0xa0, 0xe5, 0x6d, 0x55, 0xae,
+	0xe3, 0xad, 0x15, 0xf0, 0x53, 0x09, 0x20, 0x73, 0x28, 0x7e, 0x00, 0xfa, 0xbc, 0x9f, 0xca, 0x79,
+	0xb4, 0x57, 0x78, 0xdf, 0xe7, 0x08, 0xb2, 0x00, 0xe3, 0x03, 0xa8, 0x89, 0x3e, 0xcd, 0x27, 0x64,
+	0x38, 0x37, 0x57, 0x68, 0xe7, 0x7c, 0x98, 0x48, 0x18, 0xfe, 0x2c, 0x2f, 0x88, 0xca, 0xf1, 0xef,
+	0xad, 0xe0, 0x7f, 0x60, 0xa3, 0xb2, 0x2a, 0xb8, 0x03, 0xd5, 0x30, 0xa0, 0x89, 0x59, 0x2b, 0xc9,
+	0xe9, 0x79, 0x40, 0x13, 0x41, 0xe0, 0x38, 0x86, 0x8f, 0xd2, 0x30, 0xe4, 0x8d, 0x76, 0x67, 0x0d,
+	0xfe, 0x34, 0x0d, 0x43, 0x89, 0x67, 0x38, 0xfc, 0x04, 0x1a, 0xe3, 0x38, 0xf5, 0x42, 0x7f, 0x28,
+	0x92, 0xd2, 0x78, 0x9c, 0xbd, 0xd5, 0x35, 0xe3, 0x20, 0xc1, 0x34, 0xc6, 0x0b, 0x07, 0x3f, 0x06,
+	0xe3, 0x22, 0x8c, 0xdd, 0x44, 0xf2, 0x75, 0xce, 0xbf, 0xbd, 0xc2, 0x7f, 0xc6, 0x30, 0x82, 0x0e,
+	0x17, 0x73, 0x9b, 0xb1, 0xf9, 0x99, 0x90, 0x6c, 0x28, 0x61, 0xf3, 0xe3, 0x2e, 0xd9, 0xc1, 0xdc,
+	0x66, 0xc9, 0xa7, 0x45, 0xba, 0x51, 0x92, 0xfc, 0xcb, 0x02, 0xdf, 0x48, 0x0b, 0x02, 0x22, 0x7c,
+	0xd7, 0x91, 0xfc, 0x46, 0x79, 0xf8, 0xae, 0xb3, 0x08, 0xdf, 0x75, 0x96, 0xc2, 0xcf, 0xe9, 0xdb,
+	0xd7, 0x84, 0xef, 0x3a, 0x85, 0xf0, 0xb9, 0xc0, 0x43, 0x00, 0xd6, 0x58, 0x25, 0x7d, 0xa7, 0xa4,
+	0xc4, 0xec, 0x8a, 0x15, 0x64, 0xdd, 0xcb, 0x4d, 0x16, 0x5b, 0xf4, 0x75, 0x49, 0xbe, 0x51, 0x12,
+	0x5b, 0x5c, 0xee, 0x32, 0x36, 0x5d, 0x38, 0x6c, 0xea, 0x5e, 0x96, 0xf8, 0x54, 0xf2, 0x9b, 0x25,
+	0x53, 0x1f, 0x30, 0x8c, 0x9c, 0xba, 0x37, 0xb7, 0xf1, 0x57, 0xd0, 0x28, 0x2c, 0x3c, 0x35, 0x5b,
+	0x96, 0xb2, 0x96, 0x5e, 0x5c, 0xf8, 0xc5, 0xba, 0xd3, 0xfd, 0x7f, 0xab, 0x50, 0x3f, 0x9b, 0x8a,
+	0x97, 0xd2, 0xe7, 0xf9, 0x03, 0x0c, 0x95, 0xdc, 0x50, 0x03, 0xed, 0xf7, 0xbf, 0xfe, 0xf9, 0xad,
+	0x52, 0xd1, 0x50, 0xfe, 0x14, 0xeb, 0xce, 0x9f, 0x4c, 0x15, 0x4b, 0xd9, 0xc4, 0x91, 0x50, 0x7c,
+	0x0c, 0x3a, 0xb7, 0x86, 0x13, 0x77, 0xca, 0x9f, 0x5a, 0x86, 0xf3, 0x41, 0x91, 0x27, 0xf3, 0x11,
+	0xfc, 0xef, 0xdc, 0x29, 0xbf, 0x51, 0x0b, 0x3a, 0xda, 0x48, 0x0e, 0xe0, 0x3b, 0x2c, 0xe3, 0x34,
+	0x12, 0x47, 0x5e, 0xc9, 0x21, 0x4d, 0x9e, 0x5e, 0x1a, 0x25, 0xd8, 0x62, 0xe9, 0xa5, 0xec, 0xf5,
+	0xc7, 0x1e, 0x5d, 0xd5, 0x02, 0x40, 0x7e, 0x17, 0xb9, 0xa4, 0x51, 0xc2, 0x73, 0xa9, 0x5d, 0x97,
+	0x4b, 0x1a, 0x25, 0x57, 0x73, 0x69, 0xf2, 0x5c, 0xc4, 0x00, 0xb6, 0x40, 0xa5, 0x13, 0x57, 0x9e,
+	0x78, 0x75, 0x00, 0x1c, 0x52, 0xd5, 0x10, 0xcb, 0x86, 0x0f, 0xe0, 0x27, 0x50, 0x17, 0xbf, 0x02,
+	0x63, 0x79, 0xba, 0xaf, 0x2b, 0x53, 0x21, 0x46, 0xce, 0xc2, 0x7b, 0x50, 0x8d, 0xe2, 0x44, 0x9c,
+	0x6d, 0x3d, 0x07, 0x98, 0x88, 0xf0, 0xaf, 0xac, 0xe7, 0x4d, 0x43, 0x37, 0x88, 0xf8, 0xe1, 0xad,
+	0x11, 0xe1, 0xb4, 0x4f, 0x61, 0x7b, 0x69, 0x1d, 0xff, 0x67, 0x67, 0x62, 0xcd, 0x77, 0x69, 0x2d,
+	0x36, 0xbd, 0x54, 0x9a, 0xc5, 0xb6, 0xf6, 0x0b, 0x02, 0xf5, 0x2c, 0x4d, 0xfc, 0x19, 0xbe, 0x0f,
+	0x6a, 0x10, 0x45, 0xfe, 0x4c, 0x36, 0x92, 0x9b, 0x4b, 0x6b, 0xce, 0x10, 0x9d, 0x13, 0x36, 0x4c,
+	0x04, 0x0a, 0xdf, 0x07, 0x6d, 0xf4, 0x2a, 0x08, 0xc7, 0x33, 0x3f, 0xe2, 0x3b, 0xcd, 0x70, 0x5a,
+	0x2b, 0x0c, 0x32, 0x87, 0xb4, 0x6f, 0x83, 0xca, 0xe9, 0xec, 0xf1, 0xbf, 0x68, 0x9e, 0xa2, 0x75,
+	0x7e, 0xea, 0x80, 0xca, 0x67, 0x85, 0xdf, 0x85, 0xd6, 0xe1, 0xd9, 0xf3, 0x33, 0x32, 0x7c, 0x79,
+	0x7a, 0xfe, 0xe2, 0xe8, 0xf0, 0xe4, 0xd9, 0xc9, 0xd1, 0xd3, 0xe6, 0x16, 0xae, 0x83, 0x42, 0x8e,
+	0x9e, 0x36, 0x11, 0xd6, 0x41, 0xfd, 0x86, 0x1c, 0x1d, 0x9d, 0x36, 0x2b, 0x5e, 0x8d, 0x57, 0xa8,
+	0xfb, 0xdf, 0x00, 0xc0, 0xb3, 0x79, 0x93, 0x6f, 0x0e, 0x00, 0x00,
+}
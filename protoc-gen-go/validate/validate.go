@@ -0,0 +1,500 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package validate outputs Validate methods for messages whose fields
+// carry the network.api.rules option.
+// It runs as a plugin for the Go protocol buffer compiler plugin.
+// It is linked in to protoc-gen-go.
+package validate
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+)
+
+// Paths for packages used by code generated in this file,
+// relative to the import_prefix of the generator.Generator.
+const (
+	regexpPkgPath     = "regexp"
+	utf8PkgPath       = "unicode/utf8"
+	networkApiPkgPath = "github.com/golang/protobuf/ptypes/network/api"
+)
+
+func init() {
+	generator.RegisterPlugin(new(validate))
+}
+
+// validate is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates a Validate method for every message.
+type validate struct {
+	gen *generator.Generator
+
+	// The packages used by the code generated for the current file.
+	usedPkgs map[string]bool
+	// The package-level regular expression variables of the current file.
+	patterns []string
+}
+
+// Name returns the name of this plugin, "validate".
+func (g *validate) Name() string {
+	return "validate"
+}
+
+// The names for packages imported in the generated code.
+// They may vary from the final path component of the import path
+// if the name is used by other packages.
+var (
+	regexpPkg     string
+	utf8Pkg       string
+	networkApiPkg string
+)
+
+// Init initializes the plugin.
+func (g *validate) Init(gen *generator.Generator) {
+	g.gen = gen
+	regexpPkg = generator.RegisterUniquePackageName("regexp", nil)
+	utf8Pkg = generator.RegisterUniquePackageName("utf8", nil)
+	networkApiPkg = generator.RegisterUniquePackageName("network_api", nil)
+}
+
+// Given a type name defined in a .proto, return its object.
+// Also record that we're using it, to guarantee the associated import.
+func (g *validate) objectNamed(name string) generator.Object {
+	g.gen.RecordTypeUse(name)
+	return g.gen.ObjectNamed(name)
+}
+
+// Given a type name defined in a .proto, return its name as we will print it.
+func (g *validate) typeName(str string) string {
+	return g.gen.TypeName(g.objectNamed(str))
+}
+
+// P forwards to g.gen.P.
+func (g *validate) P(args ...interface{}) { g.gen.P(args...) }
+
+// use records that the generated code refers to the package named pkg
+// and returns pkg.
+func (g *validate) use(pkg string) string {
+	g.usedPkgs[pkg] = true
+	return pkg
+}
+
+// Generate generates Validate methods for the messages in the given file.
+func (g *validate) Generate(file *generator.FileDescriptor) {
+	g.usedPkgs = make(map[string]bool)
+	g.patterns = nil
+	if file.GetPackage() == "network.api" || len(file.MessageType) == 0 {
+		return
+	}
+
+	prefix := ""
+	if file.GetPackage() != "" {
+		prefix = "." + file.GetPackage()
+	}
+	for _, msg := range file.MessageType {
+		g.generateMessage(file, prefix, msg)
+	}
+
+	if len(g.patterns) > 0 {
+		g.P("var (")
+		for _, p := range g.patterns {
+			g.P(p)
+		}
+		g.P(")")
+		g.P()
+	}
+}
+
+// GenerateImports generates the import declaration for this file.
+func (g *validate) GenerateImports(file *generator.FileDescriptor) {
+	if len(g.usedPkgs) == 0 {
+		return
+	}
+	g.P("import (")
+	for _, imp := range []struct{ name, path string }{
+		{regexpPkg, regexpPkgPath},
+		{utf8Pkg, utf8PkgPath},
+		{networkApiPkg, networkApiPkgPath},
+	} {
+		if g.usedPkgs[imp.name] {
+			g.P(imp.name, " ", generator.GoImportPath(path.Join(string(g.gen.ImportPrefix), imp.path)))
+		}
+	}
+	g.P(")")
+	g.P()
+}
+
+// isMapEntry reports whether msg is the entry type of a map field.
+func isMapEntry(msg *pb.DescriptorProto) bool {
+	return msg.GetOptions().GetMapEntry()
+}
+
+// isMessage reports whether field holds a message or a group.
+func isMessage(field *pb.FieldDescriptorProto) bool {
+	t := field.GetType()
+	return t == pb.FieldDescriptorProto_TYPE_MESSAGE || t == pb.FieldDescriptorProto_TYPE_GROUP
+}
+
+// isNumber reports whether field holds an integer or a floating-point number.
+func isNumber(field *pb.FieldDescriptorProto) bool {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_DOUBLE, pb.FieldDescriptorProto_TYPE_FLOAT,
+		pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_UINT64,
+		pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_FIXED64,
+		pb.FieldDescriptorProto_TYPE_FIXED32, pb.FieldDescriptorProto_TYPE_UINT32,
+		pb.FieldDescriptorProto_TYPE_SFIXED32, pb.FieldDescriptorProto_TYPE_SFIXED64,
+		pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SINT64:
+		return true
+	}
+	return false
+}
+
+// hasValueChecks reports whether any check applies to a single value of field.
+func hasValueChecks(field *pb.FieldDescriptorProto, rules *network_api.FieldRules) bool {
+	if isMessage(field) {
+		return !rules.GetSkip()
+	}
+	return rules != nil && (rules.Min != nil || rules.Max != nil || rules.MinLen != nil ||
+		rules.MaxLen != nil || rules.Pattern != nil || rules.GetDefinedOnly())
+}
+
+// fieldRules returns the validation rules of field, or nil if it has none.
+func (g *validate) fieldRules(field *pb.FieldDescriptorProto) *network_api.FieldRules {
+	if field.Options == nil || !proto.HasExtension(field.Options, network_api.E_Rules) {
+		return nil
+	}
+	ext, err := proto.GetExtension(field.Options, network_api.E_Rules)
+	if err != nil {
+		g.gen.Error(err, "reading rules of field "+field.GetName())
+	}
+	return ext.(*network_api.FieldRules)
+}
+
+// messageRules returns the validation rules of msg, or nil if it has none.
+func (g *validate) messageRules(msg *pb.DescriptorProto) *network_api.MessageRules {
+	if msg.Options == nil || !proto.HasExtension(msg.Options, network_api.E_MessageRules) {
+		return nil
+	}
+	ext, err := proto.GetExtension(msg.Options, network_api.E_MessageRules)
+	if err != nil {
+		g.gen.Error(err, "reading rules of message "+msg.GetName())
+	}
+	return ext.(*network_api.MessageRules)
+}
+
+// generateMessage generates the Validate method of msg, whose enclosing
+// scope has the fully-qualified name prefix, and of its nested messages.
+func (g *validate) generateMessage(file *generator.FileDescriptor, prefix string, msg *pb.DescriptorProto) {
+	if isMapEntry(msg) {
+		return
+	}
+	fullName := prefix + "." + msg.GetName()
+	g.generateValidate(file, g.typeName(fullName), msg)
+	for _, nested := range msg.NestedType {
+		g.generateMessage(file, fullName, nested)
+	}
+}
+
+// generateValidate generates the Validate method of msg, whose Go type is typeName.
+func (g *validate) generateValidate(file *generator.FileDescriptor, typeName string, msg *pb.DescriptorProto) {
+	g.P("// Validate checks the field rules of ", typeName, " and of the messages")
+	g.P("// it holds. It returns a *", networkApiPkg, ".ValidationError listing every")
+	g.P("// violation, or nil if there are none.")
+	g.P("func (m *", typeName, ") Validate() error {")
+	if g.messageRules(msg).GetDisabled() {
+		g.P("return nil")
+		g.P("}")
+		g.P()
+		return
+	}
+	g.P("if m == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("var v ", g.use(networkApiPkg), ".Violations")
+	proto3 := file.GetSyntax() == "proto3"
+	for _, field := range msg.Field {
+		g.generateField(msg, typeName, proto3, field)
+	}
+	g.P("return v.Err()")
+	g.P("}")
+	g.P()
+}
+
+// mapEntry returns the entry type of the map field, or nil if field is not a map.
+func (g *validate) mapEntry(msg *pb.DescriptorProto, field *pb.FieldDescriptorProto) *pb.DescriptorProto {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE || field.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+	name := field.GetTypeName()
+	for _, nested := range msg.NestedType {
+		if isMapEntry(nested) && strings.HasSuffix(name, "."+nested.GetName()) {
+			return nested
+		}
+	}
+	return nil
+}
+
+// generateField generates the checks of one field of msg.
+func (g *validate) generateField(msg *pb.DescriptorProto, typeName string, proto3 bool, field *pb.FieldDescriptorProto) {
+	rules := g.fieldRules(field)
+	if rules == nil && !isMessage(field) {
+		return
+	}
+	name := field.GetName()
+	goName := generator.CamelCase(name)
+	quoted := strconv.Quote(name)
+
+	if entry := g.mapEntry(msg, field); entry != nil {
+		g.checkItems(field, rules, "m."+goName, quoted)
+		value := entry.Field[1]
+		if !hasValueChecks(value, rules) {
+			return
+		}
+		keyVerb := "%v"
+		if entry.Field[0].GetType() == pb.FieldDescriptorProto_TYPE_STRING {
+			keyVerb = "%q"
+		}
+		g.P("for k, x := range m.", goName, " {")
+		g.checkValue(typeName+"_"+goName, value, rules, "x", g.gen.Pkg["fmt"]+".Sprintf("+strconv.Quote(name+"["+keyVerb+"]")+", k)")
+		g.P("}")
+		return
+	}
+
+	if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED {
+		g.checkItems(field, rules, "m."+goName, quoted)
+		if !hasValueChecks(field, rules) {
+			return
+		}
+		g.P("for i, x := range m.", goName, " {")
+		g.checkValue(typeName+"_"+goName, field, rules, "x", g.gen.Pkg["fmt"]+".Sprintf("+strconv.Quote(name+"[%d]")+", i)")
+		g.P("}")
+		return
+	}
+
+	if field.OneofIndex != nil {
+		if rules.GetRequired() {
+			g.gen.Fail("field", name, "of", msg.GetName(), "is in a oneof and cannot be required")
+		}
+		oneofName := generator.CamelCase(msg.OneofDecl[field.GetOneofIndex()].GetName())
+		g.P("if x, ok := m.", oneofName, ".(*", typeName, "_", goName, "); ok {")
+		g.checkValue(typeName+"_"+goName, field, rules, "x."+goName, quoted)
+		g.P("}")
+		return
+	}
+
+	val := "m." + goName
+	switch {
+	case isMessage(field):
+		if rules.GetRequired() {
+			g.P("if ", val, " == nil {")
+			g.P("v.Add(", quoted, `, "is required")`)
+			g.P("}")
+		}
+		g.checkValue(typeName+"_"+goName, field, rules, val, quoted)
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+		if rules.GetRequired() {
+			if proto3 {
+				g.P("if len(", val, ") == 0 {")
+			} else {
+				g.P("if ", val, " == nil {")
+			}
+			g.P("v.Add(", quoted, `, "is required")`)
+			g.P("}")
+		}
+		if proto3 {
+			g.checkValue(typeName+"_"+goName, field, rules, val, quoted)
+		} else if hasValueChecks(field, rules) {
+			g.P("if ", val, " != nil {")
+			g.checkValue(typeName+"_"+goName, field, rules, val, quoted)
+			g.P("}")
+		}
+	case proto3:
+		if rules.GetRequired() {
+			switch field.GetType() {
+			case pb.FieldDescriptorProto_TYPE_BOOL:
+				g.P("if !", val, " {")
+			case pb.FieldDescriptorProto_TYPE_STRING:
+				g.P("if ", val, ` == "" {`)
+			default:
+				g.P("if ", val, " == 0 {")
+			}
+			g.P("v.Add(", quoted, `, "is required")`)
+			g.P("}")
+		}
+		g.checkValue(typeName+"_"+goName, field, rules, val, quoted)
+	default:
+		// Scalar fields of proto2 messages are pointers, nil when unset.
+		if rules.GetRequired() {
+			g.P("if ", val, " == nil {")
+			g.P("v.Add(", quoted, `, "is required")`)
+			g.P("}")
+		}
+		if hasValueChecks(field, rules) {
+			g.P("if ", val, " != nil {")
+			g.checkValue(typeName+"_"+goName, field, rules, "*"+val, quoted)
+			g.P("}")
+		}
+	}
+}
+
+// checkItems generates the checks of the min_items, max_items and required
+// rules of the repeated or map field held in val.
+func (g *validate) checkItems(field *pb.FieldDescriptorProto, rules *network_api.FieldRules, val, path string) {
+	if rules == nil {
+		return
+	}
+	if rules.GetRequired() {
+		g.P("if len(", val, ") == 0 {")
+		g.P("v.Add(", path, `, "is required")`)
+		g.P("}")
+	}
+	if rules.MinItems != nil {
+		g.P("if len(", val, ") < ", strconv.FormatUint(rules.GetMinItems(), 10), " {")
+		g.P("v.Add(", path, ", ", strconv.Quote(fmt.Sprintf("must have at least %d items", rules.GetMinItems())), ")")
+		g.P("}")
+	}
+	if rules.MaxItems != nil {
+		g.P("if len(", val, ") > ", strconv.FormatUint(rules.GetMaxItems(), 10), " {")
+		g.P("v.Add(", path, ", ", strconv.Quote(fmt.Sprintf("must have at most %d items", rules.GetMaxItems())), ")")
+		g.P("}")
+	}
+}
+
+// checkValue generates the checks of the rules of field that apply to
+// a single value, held in val. path is a Go expression for the field path
+// used in violations, and owner names the Go type and field the checks
+// are for, as in Msg_Field.
+func (g *validate) checkValue(owner string, field *pb.FieldDescriptorProto, rules *network_api.FieldRules, val, path string) {
+	name := field.GetName()
+	fail := func(rule string) {
+		g.gen.Fail("rule", rule, "does not apply to field", name, "of type", field.GetType().String())
+	}
+	if isMessage(field) {
+		if !rules.GetSkip() {
+			g.P("v.Nested(", path, ", ", strings.TrimPrefix(val, "*"), ")")
+		}
+		if rules != nil && (rules.Min != nil || rules.Max != nil || rules.MinLen != nil || rules.MaxLen != nil || rules.Pattern != nil || rules.DefinedOnly != nil) {
+			fail("for scalars")
+		}
+		return
+	}
+	if rules == nil {
+		return
+	}
+	if rules.Skip != nil {
+		fail("skip")
+	}
+
+	if rules.Min != nil || rules.Max != nil {
+		if !isNumber(field) {
+			fail("min/max")
+		}
+		if rules.Min != nil {
+			min := strconv.FormatFloat(rules.GetMin(), 'g', -1, 64)
+			g.P("if float64(", val, ") < ", min, " {")
+			g.P("v.Add(", path, ", ", strconv.Quote("must be at least "+min), ")")
+			g.P("}")
+		}
+		if rules.Max != nil {
+			max := strconv.FormatFloat(rules.GetMax(), 'g', -1, 64)
+			g.P("if float64(", val, ") > ", max, " {")
+			g.P("v.Add(", path, ", ", strconv.Quote("must be at most "+max), ")")
+			g.P("}")
+		}
+	}
+
+	if rules.MinLen != nil || rules.MaxLen != nil || rules.Pattern != nil {
+		var length, unit string
+		switch field.GetType() {
+		case pb.FieldDescriptorProto_TYPE_STRING:
+			if rules.MinLen != nil || rules.MaxLen != nil {
+				length = g.use(utf8Pkg) + ".RuneCountInString(" + val + ")"
+			}
+			unit = "characters"
+		case pb.FieldDescriptorProto_TYPE_BYTES:
+			length = "len(" + val + ")"
+			unit = "bytes"
+		default:
+			fail("min_len/max_len/pattern")
+		}
+		if rules.MinLen != nil {
+			g.P("if ", length, " < ", strconv.FormatUint(rules.GetMinLen(), 10), " {")
+			g.P("v.Add(", path, ", ", strconv.Quote(fmt.Sprintf("must have at least %d %s", rules.GetMinLen(), unit)), ")")
+			g.P("}")
+		}
+		if rules.MaxLen != nil {
+			g.P("if ", length, " > ", strconv.FormatUint(rules.GetMaxLen(), 10), " {")
+			g.P("v.Add(", path, ", ", strconv.Quote(fmt.Sprintf("must have at most %d %s", rules.GetMaxLen(), unit)), ")")
+			g.P("}")
+		}
+		if rules.Pattern != nil {
+			pattern := rules.GetPattern()
+			if _, err := regexp.Compile(pattern); err != nil {
+				g.gen.Fail("bad pattern for field", name+":", err.Error())
+			}
+			re := g.patternVar(owner, pattern)
+			if field.GetType() == pb.FieldDescriptorProto_TYPE_STRING {
+				g.P("if !", re, ".MatchString(", val, ") {")
+			} else {
+				g.P("if !", re, ".Match(", val, ") {")
+			}
+			g.P("v.Add(", path, ", ", strconv.Quote(fmt.Sprintf("must match pattern %q", pattern)), ")")
+			g.P("}")
+		}
+	}
+
+	if rules.GetDefinedOnly() {
+		if field.GetType() != pb.FieldDescriptorProto_TYPE_ENUM {
+			fail("defined_only")
+		}
+		g.P("if _, ok := ", g.typeName(field.GetTypeName()), "_name[int32(", val, ")]; !ok {")
+		g.P("v.Add(", path, `, "must be a defined enum value")`)
+		g.P("}")
+	}
+}
+
+// patternVar declares the package-level variable holding the compiled
+// regular expression pattern of the field named by owner, and returns
+// its name. Type names are unique within a Go package, so the names of
+// the variables are too, even across files.
+func (g *validate) patternVar(owner, pattern string) string {
+	name := "_validate_" + owner + "_pattern"
+	g.patterns = append(g.patterns, name+" = "+g.use(regexpPkg)+".MustCompile("+strconv.Quote(pattern)+")")
+	return name
+}
@@ -0,0 +1,241 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	pb "github.com/golang/protobuf/protoc-gen-go/validate/validate_test_proto"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+)
+
+// validRules returns a Rules message that breaks no rule.
+func validRules() *pb.Rules {
+	return &pb.Rules{
+		Score: 0.5,
+		Name:  "ab",
+		Code:  "ABC",
+		Data:  []byte("abc"),
+		Color: pb.Color_RED,
+		Tags:  []string{"t"},
+		Item:  &pb.Rules_Item{Id: "i"},
+	}
+}
+
+// validRules2 returns a Rules2 message that breaks no rule.
+func validRules2() *pb.Rules2 {
+	return &pb.Rules2{
+		N:      proto.Int32(10),
+		Data:   []byte{},
+		Values: []int32{0},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		desc string
+		m    network_api.Validator
+		want []string
+	}{
+		{"valid", validRules(), nil},
+		{"nil message", (*pb.Rules)(nil), nil},
+		{"unset proto3 message", &pb.Rules{}, []string{
+			"code: must match pattern \"^[A-Z]{3}$\"",
+			"data: must match pattern \"^ab\"",
+			"item: is required",
+			"name: is required",
+			"name: must have at least 2 characters",
+			"tags: must have at least 1 items",
+		}},
+		{"min and max", func() *pb.Rules { m := validRules(); m.Score, m.Count = 1.5, -11; return m }(), []string{
+			"count: must be at least -10",
+			"score: must be at most 1",
+		}},
+		{"lengths", func() *pb.Rules { m := validRules(); m.Name, m.Data = "abcdéf", []byte("abcde"); return m }(), []string{
+			"data: must have at most 4 bytes",
+			"name: must have at most 5 characters",
+		}},
+		{"length in characters", func() *pb.Rules { m := validRules(); m.Name = "ééééé"; return m }(), nil},
+		{"patterns", func() *pb.Rules {
+			m := validRules()
+			m.Code, m.Data, m.Contact = "abc", []byte("ba"), &pb.Rules_Email{Email: "x"}
+			return m
+		}(), []string{
+			"code: must match pattern \"^[A-Z]{3}$\"",
+			"data: must match pattern \"^ab\"",
+			"email: must match pattern \"@\"",
+		}},
+		{"defined enum values", func() *pb.Rules { m := validRules(); m.Color = 3; return m }(), []string{
+			"color: must be a defined enum value",
+		}},
+		{"items", func() *pb.Rules {
+			m := validRules()
+			m.Tags = []string{"a", "", "c", "d"}
+			m.Limits = map[string]int32{"a": 1, "b": 101, "c": 3}
+			return m
+		}(), []string{
+			"limits: must have at most 2 items",
+			"limits[\"b\"]: must be at most 100",
+			"tags: must have at most 3 items",
+			"tags[1]: must have at least 1 characters",
+		}},
+		{"nested messages", func() *pb.Rules {
+			m := validRules()
+			m.Item.Id = ""
+			m.Items = []*pb.Rules_Item{{Id: "a"}, {}}
+			m.ByName = map[string]*pb.Rules_Item{"k": {}}
+			m.Contact = &pb.Rules_Other{Other: &pb.Rules_Item{}}
+			return m
+		}(), []string{
+			"by_name[\"k\"].id: is required",
+			"item.id: is required",
+			"items[1].id: is required",
+			"other.id: is required",
+		}},
+		{"skipped message", func() *pb.Rules { m := validRules(); m.Unchecked = &pb.Rules_Item{}; return m }(), nil},
+		{"disabled message", &pb.Disabled{}, nil},
+		{"valid proto2", validRules2(), nil},
+		{"unset proto2 message", &pb.Rules2{}, []string{
+			"data: is required",
+			"n: is required",
+			"values: is required",
+		}},
+		{"proto2 values", func() *pb.Rules2 {
+			m := validRules2()
+			m.N, m.Code = proto.Int32(11), proto.String("A")
+			m.Sub = &pb.Rules2{}
+			m.Rules = &pb.Rules{}
+			return m
+		}(), []string{
+			"code: must match pattern \"^[a-z]+$\"",
+			"n: must be at most 10",
+			"rules.code: must match pattern \"^[A-Z]{3}$\"",
+			"rules.data: must match pattern \"^ab\"",
+			"rules.item: is required",
+			"rules.name: is required",
+			"rules.name: must have at least 2 characters",
+			"rules.tags: must have at least 1 items",
+			"sub.data: is required",
+			"sub.n: is required",
+			"sub.values: is required",
+		}},
+	}
+	for _, tt := range tests {
+		var got []string
+		switch err := tt.m.Validate().(type) {
+		case nil:
+		case *network_api.ValidationError:
+			for _, v := range err.Violations {
+				got = append(got, v.Field+": "+v.Description)
+			}
+		default:
+			t.Errorf("%s: Validate returned %T: %v", tt.desc, err, err)
+			continue
+		}
+		// Violations of map entries come in no particular order.
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got violations\n%q\nwant\n%q", tt.desc, got, tt.want)
+		}
+	}
+}
+
+// fileDescriptor returns the registered descriptor of the named file.
+func fileDescriptor(t *testing.T, name string) *descpb.FileDescriptorProto {
+	gz := proto.FileDescriptor(name)
+	if gz == nil {
+		t.Fatalf("file %q is not registered", name)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd := new(descpb.FileDescriptorProto)
+	if err := proto.Unmarshal(b, fd); err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+var fdescRE = regexp.MustCompile(`(?ms)^var fileDescriptor.*}`)
+
+// TestGolden checks that the code in validate_test_proto is what the
+// plugin generates for its files now. Run regenerate.sh to update it.
+func TestGolden(t *testing.T) {
+	req := &plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"rules.proto", "rules2.proto"},
+		Parameter:      proto.String("plugins=validate,paths=source_relative"),
+	}
+	for _, name := range []string{"google/protobuf/descriptor.proto", "validate.proto", "rules.proto", "rules2.proto"} {
+		req.ProtoFile = append(req.ProtoFile, fileDescriptor(t, name))
+	}
+	g := generator.New()
+	g.Request = req
+	g.CommandLineParameters(req.GetParameter())
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	if g.Response.Error != nil {
+		t.Fatalf("generator error: %s", g.Response.GetError())
+	}
+	if len(g.Response.File) != len(req.FileToGenerate) {
+		t.Fatalf("generated %d files, want %d", len(g.Response.File), len(req.FileToGenerate))
+	}
+	for _, f := range g.Response.File {
+		want, err := ioutil.ReadFile(filepath.Join("validate_test_proto", f.GetName()))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		got := fdescRE.ReplaceAll([]byte(f.GetContent()), nil)
+		want = fdescRE.ReplaceAll(want, nil)
+		if !bytes.Equal(got, want) {
+			t.Errorf("generated %s differs from validate_test_proto:\n%s", f.GetName(), got)
+		}
+	}
+}
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rules.proto
+
+package validate
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/golang/protobuf/ptypes/network/api"
+
+import (
+	regexp "regexp"
+	utf8 "unicode/utf8"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_RED               Color = 1
+	Color_GREEN             Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "COLOR_UNSPECIFIED",
+	1: "RED",
+	2: "GREEN",
+}
+var Color_value = map[string]int32{
+	"COLOR_UNSPECIFIED": 0,
+	"RED":               1,
+	"GREEN":             2,
+}
+
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rules_ec0bedc4fcceb93c, []int{0}
+}
+
+type Rules struct {
+	Score     float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Count     int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Code      string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Data      []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Color     Color                  `protobuf:"varint,6,opt,name=color,proto3,enum=validate.Color" json:"color,omitempty"`
+	Tags      []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limits    map[string]int32       `protobuf:"bytes,8,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Item      *Rules_Item            `protobuf:"bytes,9,opt,name=item,proto3" json:"item,omitempty"`
+	Items     []*Rules_Item          `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+	ByName    map[string]*Rules_Item `protobuf:"bytes,11,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Unchecked *Rules_Item            `protobuf:"bytes,12,opt,name=unchecked,proto3" json:"unchecked,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//	*Rules_Email
+	//	*Rules_Other
+	Contact              isRules_Contact `protobuf_oneof:"contact"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Rules) Reset()         { *m = Rules{} }
+func (m *Rules) String() string { return proto.CompactTextString(m) }
+func (*Rules) ProtoMessage()    {}
+func (*Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules_ec0bedc4fcceb93c, []int{0}
+}
+func (m *Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rules.Unmarshal(m, b)
+}
+func (m *Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rules.Marshal(b, m, deterministic)
+}
+func (dst *Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rules.Merge(dst, src)
+}
+func (m *Rules) XXX_Size() int {
+	return xxx_messageInfo_Rules.Size(m)
+}
+func (m *Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rules proto.InternalMessageInfo
+
+type isRules_Contact interface {
+	isRules_Contact()
+}
+
+type Rules_Email struct {
+	Email string `protobuf:"bytes,13,opt,name=email,proto3,oneof"`
+}
+type Rules_Other struct {
+	Other *Rules_Item `protobuf:"bytes,14,opt,name=other,proto3,oneof"`
+}
+
+func (*Rules_Email) isRules_Contact() {}
+func (*Rules_Other) isRules_Contact() {}
+
+func (m *Rules) GetContact() isRules_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *Rules) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Rules) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Rules) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Rules) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Rules) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Rules) GetColor() Color {
+	if m != nil {
+		return m.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (m *Rules) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Rules) GetLimits() map[string]int32 {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *Rules) GetItem() *Rules_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *Rules) GetItems() []*Rules_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Rules) GetByName() map[string]*Rules_Item {
+	if m != nil {
+		return m.ByName
+	}
+	return nil
+}
+
+func (m *Rules) GetUnchecked() *Rules_Item {
+	if m != nil {
+		return m.Unchecked
+	}
+	return nil
+}
+
+func (m *Rules) GetEmail() string {
+	if x, ok := m.GetContact().(*Rules_Email); ok {
+		return x.Email
+	}
+	return ""
+}
+
+func (m *Rules) GetOther() *Rules_Item {
+	if x, ok := m.GetContact().(*Rules_Other); ok {
+		return x.Other
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Rules) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Rules_OneofMarshaler, _Rules_OneofUnmarshaler, _Rules_OneofSizer, []interface{}{
+		(*Rules_Email)(nil),
+		(*Rules_Other)(nil),
+	}
+}
+
+func _Rules_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Rules)
+	// contact
+	switch x := m.Contact.(type) {
+	case *Rules_Email:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Email)
+	case *Rules_Other:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Other); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Rules.Contact has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Rules_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Rules)
+	switch tag {
+	case 13: // contact.email
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &Rules_Email{x}
+		return true, err
+	case 14: // contact.other
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Rules_Item)
+		err := b.DecodeMessage(msg)
+		m.Contact = &Rules_Other{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Rules_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Rules)
+	// contact
+	switch x := m.Contact.(type) {
+	case *Rules_Email:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Email)))
+		n += len(x.Email)
+	case *Rules_Other:
+		s := proto.Size(x.Other)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Rules_Item struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rules_Item) Reset()         { *m = Rules_Item{} }
+func (m *Rules_Item) String() string { return proto.CompactTextString(m) }
+func (*Rules_Item) ProtoMessage()    {}
+func (*Rules_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules_ec0bedc4fcceb93c, []int{0, 2}
+}
+func (m *Rules_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rules_Item.Unmarshal(m, b)
+}
+func (m *Rules_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rules_Item.Marshal(b, m, deterministic)
+}
+func (dst *Rules_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rules_Item.Merge(dst, src)
+}
+func (m *Rules_Item) XXX_Size() int {
+	return xxx_messageInfo_Rules_Item.Size(m)
+}
+func (m *Rules_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rules_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rules_Item proto.InternalMessageInfo
+
+func (m *Rules_Item) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Disabled struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Disabled) Reset()         { *m = Disabled{} }
+func (m *Disabled) String() string { return proto.CompactTextString(m) }
+func (*Disabled) ProtoMessage()    {}
+func (*Disabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules_ec0bedc4fcceb93c, []int{1}
+}
+func (m *Disabled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Disabled.Unmarshal(m, b)
+}
+func (m *Disabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Disabled.Marshal(b, m, deterministic)
+}
+func (dst *Disabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Disabled.Merge(dst, src)
+}
+func (m *Disabled) XXX_Size() int {
+	return xxx_messageInfo_Disabled.Size(m)
+}
+func (m *Disabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_Disabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Disabled proto.InternalMessageInfo
+
+func (m *Disabled) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Rules)(nil), "validate.Rules")
+	proto.RegisterMapType((map[string]*Rules_Item)(nil), "validate.Rules.ByNameEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "validate.Rules.LimitsEntry")
+	proto.RegisterType((*Rules_Item)(nil), "validate.Rules.Item")
+	proto.RegisterType((*Disabled)(nil), "validate.Disabled")
+	proto.RegisterEnum("validate.Color", Color_name, Color_value)
+}
+
+// Validate checks the field rules of Rules and of the messages
+// it holds. It returns a *network_api.ValidationError listing every
+// violation, or nil if there are none.
+func (m *Rules) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var v network_api.Violations
+	if float64(m.Score) < 0 {
+		v.Add("score", "must be at least 0")
+	}
+	if float64(m.Score) > 1 {
+		v.Add("score", "must be at most 1")
+	}
+	if float64(m.Count) < -10 {
+		v.Add("count", "must be at least -10")
+	}
+	if m.Name == "" {
+		v.Add("name", "is required")
+	}
+	if utf8.RuneCountInString(m.Name) < 2 {
+		v.Add("name", "must have at least 2 characters")
+	}
+	if utf8.RuneCountInString(m.Name) > 5 {
+		v.Add("name", "must have at most 5 characters")
+	}
+	if !_validate_Rules_Code_pattern.MatchString(m.Code) {
+		v.Add("code", "must match pattern \"^[A-Z]{3}$\"")
+	}
+	if len(m.Data) > 4 {
+		v.Add("data", "must have at most 4 bytes")
+	}
+	if !_validate_Rules_Data_pattern.Match(m.Data) {
+		v.Add("data", "must match pattern \"^ab\"")
+	}
+	if _, ok := Color_name[int32(m.Color)]; !ok {
+		v.Add("color", "must be a defined enum value")
+	}
+	if len(m.Tags) < 1 {
+		v.Add("tags", "must have at least 1 items")
+	}
+	if len(m.Tags) > 3 {
+		v.Add("tags", "must have at most 3 items")
+	}
+	for i, x := range m.Tags {
+		if utf8.RuneCountInString(x) < 1 {
+			v.Add(fmt.Sprintf("tags[%d]", i), "must have at least 1 characters")
+		}
+	}
+	if len(m.Limits) > 2 {
+		v.Add("limits", "must have at most 2 items")
+	}
+	for k, x := range m.Limits {
+		if float64(x) > 100 {
+			v.Add(fmt.Sprintf("limits[%q]", k), "must be at most 100")
+		}
+	}
+	if m.Item == nil {
+		v.Add("item", "is required")
+	}
+	v.Nested("item", m.Item)
+	for i, x := range m.Items {
+		v.Nested(fmt.Sprintf("items[%d]", i), x)
+	}
+	for k, x := range m.ByName {
+		v.Nested(fmt.Sprintf("by_name[%q]", k), x)
+	}
+	if x, ok := m.Contact.(*Rules_Email); ok {
+		if !_validate_Rules_Email_pattern.MatchString(x.Email) {
+			v.Add("email", "must match pattern \"@\"")
+		}
+	}
+	if x, ok := m.Contact.(*Rules_Other); ok {
+		v.Nested("other", x.Other)
+	}
+	return v.Err()
+}
+
+// Validate checks the field rules of Rules_Item and of the messages
+// it holds. It returns a *network_api.ValidationError listing every
+// violation, or nil if there are none.
+func (m *Rules_Item) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var v network_api.Violations
+	if m.Id == "" {
+		v.Add("id", "is required")
+	}
+	return v.Err()
+}
+
+// Validate checks the field rules of Disabled and of the messages
+// it holds. It returns a *network_api.ValidationError listing every
+// violation, or nil if there are none.
+func (m *Disabled) Validate() error {
+	return nil
+}
+
+var (
+	_validate_Rules_Code_pattern  = regexp.MustCompile("^[A-Z]{3}$")
+	_validate_Rules_Data_pattern  = regexp.MustCompile("^ab")
+	_validate_Rules_Email_pattern = regexp.MustCompile("@")
+)
+
+func init() { proto.RegisterFile("rules.proto", fileDescriptor_rules_ec0bedc4fcceb93c) }
+
+var fileDescriptor_rules_ec0bedc4fcceb93c = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xd1, 0x4e, 0xd4, 0x4e,
+	0x14, 0xc6, 0x99, 0xb6, 0xb3, 0xbb, 0x3d, 0x5d, 0x60, 0x99, 0xf0, 0x4f, 0x26, 0xfc, 0xbd, 0x18,
+	0x08, 0x9a, 0x66, 0xa3, 0xab, 0x59, 0xbc, 0x40, 0x62, 0x22, 0x16, 0x8a, 0x90, 0x10, 0x20, 0x63,
+	0xbc, 0x50, 0x23, 0x64, 0xb6, 0x9d, 0x48, 0x43, 0xbb, 0x35, 0xed, 0x2c, 0xc9, 0xc6, 0xf8, 0x02,
+	0xde, 0xfa, 0x42, 0x5e, 0xf8, 0x06, 0xbe, 0x82, 0x0f, 0xe0, 0x23, 0x98, 0x9e, 0x16, 0xd9, 0x10,
+	0x88, 0x7b, 0xd3, 0x99, 0xf9, 0x7e, 0xdf, 0xc9, 0x37, 0x67, 0xce, 0x82, 0x57, 0x4c, 0x52, 0x5d,
+	0x0e, 0x3e, 0x15, 0xb9, 0xc9, 0x59, 0xe7, 0x52, 0xa5, 0x49, 0xac, 0x8c, 0x5e, 0x59, 0xb8, 0x5a,
+	0xd5, 0xca, 0xda, 0x8f, 0x16, 0x50, 0x59, 0x91, 0x6c, 0x00, 0xb4, 0x8c, 0xf2, 0x42, 0x73, 0x22,
+	0x88, 0x4f, 0x02, 0xfe, 0xf5, 0xe7, 0xaf, 0x6f, 0x16, 0x73, 0xe7, 0x9a, 0xdf, 0x52, 0xfd, 0xf9,
+	0xfd, 0x42, 0xd6, 0x18, 0xbb, 0x0f, 0x34, 0xca, 0x27, 0x63, 0xc3, 0x2d, 0x41, 0x7c, 0x3b, 0x58,
+	0x44, 0xde, 0x6d, 0xf8, 0xf5, 0xef, 0xb2, 0x56, 0x99, 0x00, 0x67, 0xac, 0x32, 0xcd, 0x6d, 0x41,
+	0x7c, 0x37, 0xe8, 0x22, 0xd5, 0xe2, 0x96, 0xa0, 0xdb, 0x44, 0xa2, 0xc2, 0x1e, 0x80, 0x13, 0xe5,
+	0xb1, 0xe6, 0x0e, 0x12, 0x0c, 0x89, 0x6e, 0x1f, 0x4e, 0xdf, 0xbf, 0x7c, 0xf4, 0xee, 0xc3, 0xe7,
+	0x8d, 0x2f, 0xeb, 0x12, 0x75, 0xb6, 0x0a, 0x4e, 0xac, 0x8c, 0xe2, 0x54, 0x10, 0xbf, 0x1b, 0xcc,
+	0x23, 0xd7, 0x16, 0x4e, 0xdf, 0x3e, 0x55, 0x23, 0x89, 0x12, 0x7b, 0x5c, 0x65, 0x4a, 0xf3, 0x82,
+	0xb7, 0x04, 0xf1, 0x17, 0x86, 0x8b, 0x83, 0xbf, 0xb7, 0xdd, 0xa9, 0x8e, 0x83, 0x0e, 0x9a, 0xac,
+	0x7d, 0x22, 0x6b, 0xae, 0x4a, 0x67, 0xd4, 0xc7, 0x92, 0xb7, 0x85, 0x3d, 0x93, 0x8e, 0x3c, 0x21,
+	0x9b, 0xb6, 0x44, 0x85, 0xed, 0x41, 0x2b, 0x4d, 0xb2, 0xc4, 0x94, 0xbc, 0x23, 0x6c, 0xdf, 0x1b,
+	0xfe, 0x7f, 0x5d, 0x13, 0xfb, 0x36, 0x38, 0x44, 0x35, 0x1c, 0x9b, 0x62, 0x1a, 0x2c, 0x61, 0x01,
+	0xaf, 0xe9, 0xd5, 0xdb, 0xed, 0x4d, 0x4b, 0x36, 0x6e, 0x36, 0x04, 0x27, 0x31, 0x3a, 0xe3, 0xae,
+	0x20, 0xbe, 0x37, 0x5c, 0xbe, 0x59, 0xe5, 0xc0, 0xe8, 0xec, 0x2a, 0x5e, 0xd5, 0x99, 0x8a, 0x65,
+	0x7d, 0xa0, 0xd5, 0xb7, 0xe4, 0x20, 0xec, 0xbb, 0x4c, 0xb2, 0x46, 0xd8, 0x53, 0x68, 0x8f, 0xa6,
+	0x67, 0xd8, 0x6a, 0xef, 0xf6, 0xa0, 0xc1, 0xf4, 0x48, 0x65, 0x1a, 0x83, 0xca, 0xd6, 0x08, 0x37,
+	0xec, 0x39, 0xb8, 0x93, 0x71, 0x74, 0xae, 0xa3, 0x0b, 0x1d, 0xf3, 0xee, 0xbf, 0xa3, 0x9d, 0x10,
+	0x79, 0x6d, 0x60, 0xab, 0x40, 0x75, 0xa6, 0x92, 0x94, 0xcf, 0xe3, 0xd3, 0xb9, 0xc8, 0xd8, 0x7d,
+	0xb2, 0xbd, 0x3f, 0x27, 0x6b, 0x85, 0x3d, 0x04, 0x9a, 0x9b, 0x73, 0x5d, 0xf0, 0x85, 0xbb, 0x8b,
+	0x57, 0x34, 0x42, 0x2b, 0xcf, 0xc0, 0x9b, 0x69, 0x27, 0xeb, 0x81, 0x7d, 0xa1, 0xa7, 0x38, 0x90,
+	0xae, 0xac, 0x96, 0x6c, 0x19, 0xe8, 0xa5, 0x4a, 0x27, 0x1a, 0x87, 0x8e, 0xca, 0x7a, 0xb3, 0x65,
+	0x6d, 0x92, 0x95, 0x63, 0xf0, 0x66, 0x2e, 0x78, 0x8b, 0xb5, 0x3f, 0x6b, 0xbd, 0xb3, 0x99, 0xd7,
+	0x05, 0x05, 0x38, 0xd5, 0x11, 0xe3, 0x60, 0x25, 0x71, 0x5d, 0x68, 0xe6, 0x81, 0xac, 0x24, 0x0e,
+	0x5c, 0x68, 0x47, 0xf9, 0xd8, 0xa8, 0xc8, 0xac, 0x0d, 0xa1, 0xb3, 0x9b, 0x94, 0x6a, 0x94, 0xea,
+	0x98, 0xdd, 0x6b, 0x26, 0xfe, 0xa6, 0x05, 0x4f, 0xb7, 0x9a, 0x7d, 0x87, 0xf4, 0x87, 0x40, 0x71,
+	0x2a, 0xd9, 0x7f, 0xb0, 0xb4, 0x73, 0x7c, 0x78, 0x2c, 0xcf, 0xde, 0x1c, 0xbd, 0x3e, 0x09, 0x77,
+	0x0e, 0xf6, 0x0e, 0xc2, 0xdd, 0xde, 0x1c, 0x6b, 0x83, 0x2d, 0xc3, 0xdd, 0x1e, 0x61, 0x2e, 0xd0,
+	0x57, 0x32, 0x0c, 0x8f, 0x7a, 0xd6, 0xa8, 0x85, 0xff, 0xda, 0x8d, 0x3f, 0x03, 0x00, 0x91, 0xff,
+	0x2b, 0x3d, 0xde, 0x03, 0x00, 0x00,
+}
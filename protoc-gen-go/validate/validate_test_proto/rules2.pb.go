@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rules2.proto
+
+package validate
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/golang/protobuf/ptypes/network/api"
+
+import (
+	regexp "regexp"
+	network_api "github.com/golang/protobuf/ptypes/network/api"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Rules2 struct {
+	N                    *int32   `protobuf:"varint,1,opt,name=n" json:"n,omitempty"`
+	Code                 *string  `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	Values               []int32  `protobuf:"varint,4,rep,name=values" json:"values,omitempty"`
+	Sub                  *Rules2  `protobuf:"bytes,5,opt,name=sub" json:"sub,omitempty"`
+	Rules                *Rules   `protobuf:"bytes,6,opt,name=rules" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rules2) Reset()         { *m = Rules2{} }
+func (m *Rules2) String() string { return proto.CompactTextString(m) }
+func (*Rules2) ProtoMessage()    {}
+func (*Rules2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules2_a3d398eb5e48c07c, []int{0}
+}
+func (m *Rules2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rules2.Unmarshal(m, b)
+}
+func (m *Rules2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rules2.Marshal(b, m, deterministic)
+}
+func (dst *Rules2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rules2.Merge(dst, src)
+}
+func (m *Rules2) XXX_Size() int {
+	return xxx_messageInfo_Rules2.Size(m)
+}
+func (m *Rules2) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rules2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rules2 proto.InternalMessageInfo
+
+func (m *Rules2) GetN() int32 {
+	if m != nil && m.N != nil {
+		return *m.N
+	}
+	return 0
+}
+
+func (m *Rules2) GetCode() string {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return ""
+}
+
+func (m *Rules2) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Rules2) GetValues() []int32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *Rules2) GetSub() *Rules2 {
+	if m != nil {
+		return m.Sub
+	}
+	return nil
+}
+
+func (m *Rules2) GetRules() *Rules {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Rules2)(nil), "validate.Rules2")
+}
+
+// Validate checks the field rules of Rules2 and of the messages
+// it holds. It returns a *network_api.ValidationError listing every
+// violation, or nil if there are none.
+func (m *Rules2) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var v network_api.Violations
+	if m.N == nil {
+		v.Add("n", "is required")
+	}
+	if m.N != nil {
+		if float64(*m.N) > 10 {
+			v.Add("n", "must be at most 10")
+		}
+	}
+	if m.Code != nil {
+		if !_validate_Rules2_Code_pattern.MatchString(*m.Code) {
+			v.Add("code", "must match pattern \"^[a-z]+$\"")
+		}
+	}
+	if m.Data == nil {
+		v.Add("data", "is required")
+	}
+	if len(m.Values) == 0 {
+		v.Add("values", "is required")
+	}
+	v.Nested("sub", m.Sub)
+	v.Nested("rules", m.Rules)
+	return v.Err()
+}
+
+var (
+	_validate_Rules2_Code_pattern = regexp.MustCompile("^[a-z]+$")
+)
+
+func init() { proto.RegisterFile("rules2.proto", fileDescriptor_rules2_a3d398eb5e48c07c) }
+
+var fileDescriptor_rules2_a3d398eb5e48c07c = []byte{
+	// 210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x2a, 0xcd, 0x49,
+	0x2d, 0x36, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x28, 0x4b, 0xcc, 0xc9, 0x4c, 0x49,
+	0x2c, 0x49, 0x95, 0xe2, 0x06, 0x8b, 0x43, 0x84, 0xa5, 0xf8, 0x60, 0xc2, 0x10, 0xbe, 0xd2, 0x2d,
+	0x46, 0x2e, 0xb6, 0x20, 0xb0, 0x3e, 0x21, 0x79, 0x2e, 0xc6, 0x3c, 0x09, 0x46, 0x05, 0x46, 0x0d,
+	0x56, 0x27, 0xc1, 0xae, 0xcb, 0x4f, 0x26, 0x33, 0x71, 0x0b, 0x32, 0x80, 0x81, 0x8a, 0x83, 0x03,
+	0x63, 0x10, 0x63, 0x9e, 0x90, 0x0a, 0x17, 0x4b, 0x72, 0x7e, 0x4a, 0xaa, 0x04, 0x93, 0x02, 0xa3,
+	0x06, 0xa7, 0x93, 0x00, 0x58, 0x0d, 0x97, 0x16, 0x47, 0x5c, 0x74, 0xa2, 0x6e, 0x55, 0xac, 0xb6,
+	0x4a, 0x10, 0x58, 0x56, 0x48, 0x86, 0x8b, 0x25, 0x25, 0xb1, 0x24, 0x51, 0x82, 0x59, 0x81, 0x51,
+	0x83, 0xc7, 0x89, 0x03, 0xac, 0x8a, 0xc9, 0x81, 0x31, 0x08, 0x2c, 0x2a, 0xa4, 0xc0, 0xc5, 0x56,
+	0x96, 0x98, 0x53, 0x9a, 0x5a, 0x2c, 0xc1, 0xa2, 0xc0, 0xac, 0xc1, 0x8a, 0x24, 0x0f, 0x15, 0x17,
+	0x52, 0xe2, 0x62, 0x2e, 0x2e, 0x4d, 0x92, 0x60, 0x55, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd0, 0x83,
+	0xbb, 0x17, 0xe2, 0xca, 0x20, 0x90, 0xa4, 0x90, 0x2a, 0x17, 0x2b, 0xd8, 0x53, 0x12, 0x6c, 0x60,
+	0x55, 0xfc, 0x68, 0xaa, 0x82, 0x20, 0xb2, 0x80, 0x01, 0x00, 0x14, 0xd8, 0x5e, 0x42, 0x12, 0x01,
+	0x00, 0x00,
+}
@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: json.proto
+
+package network_api // import "github.com/golang/protobuf/ptypes/network/api"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// How jsonpb writes a field, in place of the options of jsonpb.Marshaler.
+type JsonFieldOptions struct {
+	// Write the values of an enum field, or of a map with enum values,
+	// as numbers, as if Marshaler.EnumsAsInts were set.
+	EnumAsInt *bool `protobuf:"varint,1,opt,name=enum_as_int,json=enumAsInt" json:"enum_as_int,omitempty"`
+	// Write the values of a 64-bit integer field, or of a map with 64-bit
+	// integer values, as numbers, as if Marshaler.Int64sAsNumbers were set.
+	Int64AsNumber *bool `protobuf:"varint,2,opt,name=int64_as_number,json=int64AsNumber" json:"int64_as_number,omitempty"`
+	// Don't write the field when it holds its zero value, even if
+	// Marshaler.EmitDefaults is set.
+	OmitDefault          *bool    `protobuf:"varint,3,opt,name=omit_default,json=omitDefault" json:"omit_default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsonFieldOptions) Reset()         { *m = JsonFieldOptions{} }
+func (m *JsonFieldOptions) String() string { return proto.CompactTextString(m) }
+func (*JsonFieldOptions) ProtoMessage()    {}
+func (*JsonFieldOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_json_fae6d1251c97fd56, []int{0}
+}
+func (m *JsonFieldOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsonFieldOptions.Unmarshal(m, b)
+}
+func (m *JsonFieldOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsonFieldOptions.Marshal(b, m, deterministic)
+}
+func (dst *JsonFieldOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsonFieldOptions.Merge(dst, src)
+}
+func (m *JsonFieldOptions) XXX_Size() int {
+	return xxx_messageInfo_JsonFieldOptions.Size(m)
+}
+func (m *JsonFieldOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsonFieldOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsonFieldOptions proto.InternalMessageInfo
+
+func (m *JsonFieldOptions) GetEnumAsInt() bool {
+	if m != nil && m.EnumAsInt != nil {
+		return *m.EnumAsInt
+	}
+	return false
+}
+
+func (m *JsonFieldOptions) GetInt64AsNumber() bool {
+	if m != nil && m.Int64AsNumber != nil {
+		return *m.Int64AsNumber
+	}
+	return false
+}
+
+func (m *JsonFieldOptions) GetOmitDefault() bool {
+	if m != nil && m.OmitDefault != nil {
+		return *m.OmitDefault
+	}
+	return false
+}
+
+var E_Json = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*JsonFieldOptions)(nil),
+	Field:         72295731,
+	Name:          "network.api.json",
+	Tag:           "bytes,72295731,opt,name=json",
+	Filename:      "json.proto",
+}
+
+func init() {
+	proto.RegisterType((*JsonFieldOptions)(nil), "network.api.JsonFieldOptions")
+	proto.RegisterExtension(E_Json)
+}
+
+func init() { proto.RegisterFile("json.proto", fileDescriptor_json_fae6d1251c97fd56) }
+
+var fileDescriptor_json_fae6d1251c97fd56 = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc4, 0x30,
+	0x14, 0xc7, 0xa9, 0x3a, 0x68, 0xaa, 0x28, 0x9d, 0x8a, 0x70, 0x52, 0x6f, 0x90, 0x9b, 0x52, 0x10,
+	0x11, 0xf4, 0xa6, 0x13, 0x11, 0x74, 0x50, 0x38, 0x37, 0x97, 0x92, 0x5e, 0x73, 0xf1, 0x69, 0xfb,
+	0x5e, 0x48, 0x5e, 0x10, 0x07, 0x3f, 0x95, 0xab, 0x9f, 0xc0, 0x4f, 0x25, 0x4d, 0x4f, 0x3c, 0xdc,
+	0xc2, 0x2f, 0x3f, 0xfe, 0xfc, 0x12, 0x21, 0x5e, 0x3c, 0xa1, 0xb4, 0x8e, 0x98, 0xb2, 0x14, 0x35,
+	0xbf, 0x91, 0x7b, 0x95, 0xca, 0xc2, 0x61, 0x61, 0x88, 0x4c, 0xab, 0xcb, 0x78, 0x55, 0x87, 0x65,
+	0xd9, 0x68, 0xbf, 0x70, 0x60, 0x99, 0xdc, 0xa0, 0x8f, 0x3f, 0xc4, 0xc1, 0x9d, 0x27, 0xbc, 0x01,
+	0xdd, 0x36, 0x0f, 0x96, 0x81, 0xd0, 0x67, 0x47, 0x22, 0xd5, 0x18, 0xba, 0x4a, 0xf9, 0x0a, 0x90,
+	0xf3, 0xa4, 0x48, 0x26, 0xdb, 0xf3, 0x9d, 0x1e, 0xcd, 0xfc, 0x2d, 0x72, 0x76, 0x22, 0xf6, 0x01,
+	0xf9, 0xfc, 0xac, 0x17, 0x30, 0x74, 0xb5, 0x76, 0xf9, 0x46, 0x74, 0xf6, 0x22, 0x9e, 0xf9, 0xfb,
+	0x08, 0xb3, 0x63, 0xb1, 0x4b, 0x1d, 0x70, 0xd5, 0xe8, 0xa5, 0x0a, 0x2d, 0xe7, 0x9b, 0x51, 0x4a,
+	0x7b, 0x76, 0x3d, 0xa0, 0xcb, 0x47, 0xb1, 0xd5, 0xb7, 0x67, 0x23, 0x39, 0x94, 0xca, 0xdf, 0x52,
+	0xb9, 0x5e, 0x94, 0x7f, 0x7e, 0x7f, 0x8d, 0x8b, 0x64, 0x92, 0x9e, 0x8e, 0xe4, 0xda, 0xf3, 0xe4,
+	0xff, 0xf2, 0x79, 0x1c, 0xbb, 0x9a, 0x3e, 0x5d, 0x18, 0xe0, 0xe7, 0x50, 0xcb, 0x05, 0x75, 0xa5,
+	0xa1, 0x56, 0xa1, 0xf9, 0xfb, 0x02, 0xcb, 0xef, 0x56, 0xfb, 0x72, 0xb5, 0x53, 0x2a, 0x0b, 0xd3,
+	0xd5, 0xb9, 0x52, 0x16, 0x7e, 0x06, 0x00, 0xc0, 0xa2, 0xca, 0xd4, 0x4c, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,49 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sensitive.proto
+
+package network_api // import "github.com/golang/protobuf/ptypes/network/api"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+var E_Sensitive = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         72295730,
+	Name:          "network.api.sensitive",
+	Tag:           "varint,72295730,opt,name=sensitive",
+	Filename:      "sensitive.proto",
+}
+
+func init() {
+	proto.RegisterExtension(E_Sensitive)
+}
+
+func init() { proto.RegisterFile("sensitive.proto", fileDescriptor_sensitive_b80f4dd65f59fb18) }
+
+var fileDescriptor_sensitive_b80f4dd65f59fb18 = []byte{
+	// 160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0x4e, 0xcd, 0x2b,
+	0xce, 0x2c, 0xc9, 0x2c, 0x4b, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xce, 0x4b, 0x2d,
+	0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x4b, 0x2c, 0xc8, 0x94, 0x52, 0x48, 0xcf, 0xcf, 0x4f, 0xcf, 0x49,
+	0xd5, 0x07, 0x4b, 0x25, 0x95, 0xa6, 0xe9, 0xa7, 0xa4, 0x16, 0x27, 0x17, 0x65, 0x16, 0x94, 0xe4,
+	0x17, 0x41, 0x94, 0x5b, 0xd9, 0x71, 0x71, 0xc2, 0x4d, 0x10, 0x92, 0xd5, 0x83, 0xa8, 0xd7, 0x83,
+	0xa9, 0xd7, 0x73, 0xcb, 0x4c, 0xcd, 0x49, 0xf1, 0x2f, 0x28, 0xc9, 0xcc, 0xcf, 0x2b, 0x96, 0xd8,
+	0x74, 0x6a, 0x8f, 0x92, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x42, 0x8b, 0x93, 0x75, 0x94, 0x65, 0x7a,
+	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0x3a,
+	0xc2, 0xba, 0x82, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x7d, 0xa8, 0x93, 0xf4, 0x13, 0x0b, 0x32, 0xad,
+	0xa1, 0xec, 0xf8, 0xc4, 0x82, 0x4c, 0xc0, 0x00, 0x89, 0xab, 0xc0, 0xa0, 0xbd, 0x00, 0x00, 0x00,
+}
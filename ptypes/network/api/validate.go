@@ -0,0 +1,101 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package network_api
+
+import (
+	"strings"
+)
+
+// Validator is implemented by messages with a Validate method
+// generated by the validate plugin of protoc-gen-go.
+type Validator interface {
+	Validate() error
+}
+
+// FieldViolation describes a field that breaks one of its rules.
+type FieldViolation struct {
+	// Field is the path of the field within the validated message,
+	// such as "name", "items[2].name" or `labels["key"]`.
+	Field string
+	// Description says which rule is broken.
+	Description string
+}
+
+// ValidationError is the error returned by generated Validate methods.
+// It lists every violation found, not just the first.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	s := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		s[i] = v.Field + ": " + v.Description
+	}
+	return "invalid message: " + strings.Join(s, "; ")
+}
+
+// Violations collects the violations found by a generated Validate method.
+// The zero value is empty and ready to use.
+type Violations []FieldViolation
+
+// Add records that field breaks a rule.
+func (v *Violations) Add(field, description string) {
+	*v = append(*v, FieldViolation{Field: field, Description: description})
+}
+
+// Nested validates m, the message held in field, if it is a Validator,
+// and records its violations with paths relative to the enclosing message.
+func (v *Violations) Nested(field string, m interface{}) {
+	vm, ok := m.(Validator)
+	if !ok {
+		return
+	}
+	switch err := vm.Validate().(type) {
+	case nil:
+	case *ValidationError:
+		for _, fv := range err.Violations {
+			v.Add(field+"."+fv.Field, fv.Description)
+		}
+	default:
+		v.Add(field, err.Error())
+	}
+}
+
+// Err returns a *ValidationError holding the violations,
+// or nil if there are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v}
+}
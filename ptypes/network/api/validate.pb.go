@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: validate.proto
+
+package network_api // import "github.com/golang/protobuf/ptypes/network/api"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// FieldRules constrains the values of a field. The rules are checked by
+// the Validate methods generated by the validate plugin of protoc-gen-go,
+// which rejects rules that don't apply to the field's type.
+type FieldRules struct {
+	// The number must be at least min.
+	Min *float64 `protobuf:"fixed64,1,opt,name=min" json:"min,omitempty"`
+	// The number must be at most max.
+	Max *float64 `protobuf:"fixed64,2,opt,name=max" json:"max,omitempty"`
+	// The string must have at least min_len characters,
+	// or the bytes at least min_len bytes.
+	MinLen *uint64 `protobuf:"varint,3,opt,name=min_len,json=minLen" json:"min_len,omitempty"`
+	// The string must have at most max_len characters,
+	// or the bytes at most max_len bytes.
+	MaxLen *uint64 `protobuf:"varint,4,opt,name=max_len,json=maxLen" json:"max_len,omitempty"`
+	// The string must match this regular expression, in RE2 syntax.
+	Pattern *string `protobuf:"bytes,5,opt,name=pattern" json:"pattern,omitempty"`
+	// The repeated field or map must have at least min_items elements.
+	MinItems *uint64 `protobuf:"varint,6,opt,name=min_items,json=minItems" json:"min_items,omitempty"`
+	// The repeated field or map must have at most max_items elements.
+	MaxItems *uint64 `protobuf:"varint,7,opt,name=max_items,json=maxItems" json:"max_items,omitempty"`
+	// The field must be set. Fields without presence, such as proto3
+	// scalars, must not be the zero value, and repeated fields and maps
+	// must not be empty.
+	Required *bool `protobuf:"varint,8,opt,name=required" json:"required,omitempty"`
+	// The enum value must be one of the values defined by the enum.
+	DefinedOnly *bool `protobuf:"varint,9,opt,name=defined_only,json=definedOnly" json:"defined_only,omitempty"`
+	// Don't validate the message held in the field.
+	Skip                 *bool    `protobuf:"varint,10,opt,name=skip" json:"skip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldRules) Reset()         { *m = FieldRules{} }
+func (m *FieldRules) String() string { return proto.CompactTextString(m) }
+func (*FieldRules) ProtoMessage()    {}
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_validate_b1c04dc35970926c, []int{0}
+}
+func (m *FieldRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldRules.Unmarshal(m, b)
+}
+func (m *FieldRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldRules.Marshal(b, m, deterministic)
+}
+func (dst *FieldRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldRules.Merge(dst, src)
+}
+func (m *FieldRules) XXX_Size() int {
+	return xxx_messageInfo_FieldRules.Size(m)
+}
+func (m *FieldRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldRules proto.InternalMessageInfo
+
+func (m *FieldRules) GetMin() float64 {
+	if m != nil && m.Min != nil {
+		return *m.Min
+	}
+	return 0
+}
+
+func (m *FieldRules) GetMax() float64 {
+	if m != nil && m.Max != nil {
+		return *m.Max
+	}
+	return 0
+}
+
+func (m *FieldRules) GetMinLen() uint64 {
+	if m != nil && m.MinLen != nil {
+		return *m.MinLen
+	}
+	return 0
+}
+
+func (m *FieldRules) GetMaxLen() uint64 {
+	if m != nil && m.MaxLen != nil {
+		return *m.MaxLen
+	}
+	return 0
+}
+
+func (m *FieldRules) GetPattern() string {
+	if m != nil && m.Pattern != nil {
+		return *m.Pattern
+	}
+	return ""
+}
+
+func (m *FieldRules) GetMinItems() uint64 {
+	if m != nil && m.MinItems != nil {
+		return *m.MinItems
+	}
+	return 0
+}
+
+func (m *FieldRules) GetMaxItems() uint64 {
+	if m != nil && m.MaxItems != nil {
+		return *m.MaxItems
+	}
+	return 0
+}
+
+func (m *FieldRules) GetRequired() bool {
+	if m != nil && m.Required != nil {
+		return *m.Required
+	}
+	return false
+}
+
+func (m *FieldRules) GetDefinedOnly() bool {
+	if m != nil && m.DefinedOnly != nil {
+		return *m.DefinedOnly
+	}
+	return false
+}
+
+func (m *FieldRules) GetSkip() bool {
+	if m != nil && m.Skip != nil {
+		return *m.Skip
+	}
+	return false
+}
+
+// MessageRules constrains a message as a whole.
+type MessageRules struct {
+	// Don't check any rules of the message's fields.
+	Disabled             *bool    `protobuf:"varint,1,opt,name=disabled" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageRules) Reset()         { *m = MessageRules{} }
+func (m *MessageRules) String() string { return proto.CompactTextString(m) }
+func (*MessageRules) ProtoMessage()    {}
+func (*MessageRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_validate_b1c04dc35970926c, []int{1}
+}
+func (m *MessageRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageRules.Unmarshal(m, b)
+}
+func (m *MessageRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageRules.Marshal(b, m, deterministic)
+}
+func (dst *MessageRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRules.Merge(dst, src)
+}
+func (m *MessageRules) XXX_Size() int {
+	return xxx_messageInfo_MessageRules.Size(m)
+}
+func (m *MessageRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRules proto.InternalMessageInfo
+
+func (m *MessageRules) GetDisabled() bool {
+	if m != nil && m.Disabled != nil {
+		return *m.Disabled
+	}
+	return false
+}
+
+var E_Rules = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*FieldRules)(nil),
+	Field:         72295729,
+	Name:          "network.api.rules",
+	Tag:           "bytes,72295729,opt,name=rules",
+	Filename:      "validate.proto",
+}
+
+var E_MessageRules = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*MessageRules)(nil),
+	Field:         72295729,
+	Name:          "network.api.message_rules",
+	Tag:           "bytes,72295729,opt,name=message_rules,json=messageRules",
+	Filename:      "validate.proto",
+}
+
+func init() {
+	proto.RegisterType((*FieldRules)(nil), "network.api.FieldRules")
+	proto.RegisterType((*MessageRules)(nil), "network.api.MessageRules")
+	proto.RegisterExtension(E_Rules)
+	proto.RegisterExtension(E_MessageRules)
+}
+
+func init() { proto.RegisterFile("validate.proto", fileDescriptor_validate_b1c04dc35970926c) }
+
+var fileDescriptor_validate_b1c04dc35970926c = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb5, 0x6d, 0xda, 0x6c, 0x9c, 0x80, 0xd0, 0x5e, 0x6a, 0x82, 0x10, 0x4b, 0x4e, 0x2b,
+	0x0e, 0x5e, 0x89, 0x1b, 0xed, 0x8d, 0x03, 0x12, 0x12, 0x28, 0x92, 0x8f, 0x5c, 0x22, 0xa7, 0x9e,
+	0x2e, 0xa3, 0xfa, 0x1f, 0xb6, 0x17, 0x36, 0x8f, 0xc0, 0xe3, 0x70, 0xe7, 0x09, 0x78, 0x2a, 0xb4,
+	0x5e, 0xb7, 0x4d, 0xd5, 0xdb, 0x7c, 0xf3, 0x1b, 0x7f, 0xb6, 0x3f, 0x9b, 0x3c, 0xff, 0x29, 0x14,
+	0x4a, 0x11, 0x81, 0x39, 0x6f, 0xa3, 0xad, 0x96, 0x06, 0xe2, 0x2f, 0xeb, 0x6f, 0x99, 0x70, 0xb8,
+	0xae, 0x3b, 0x6b, 0x3b, 0x05, 0x6d, 0x42, 0xfb, 0xfe, 0xa6, 0x95, 0x10, 0xae, 0x3d, 0xba, 0x68,
+	0xfd, 0x34, 0xbe, 0xf9, 0x7d, 0x42, 0xc8, 0x27, 0x04, 0x25, 0x79, 0xaf, 0x20, 0x54, 0x2f, 0xc8,
+	0xa9, 0x46, 0x43, 0x8b, 0xba, 0x68, 0x0a, 0x3e, 0x96, 0xa9, 0x23, 0x06, 0x7a, 0x92, 0x3b, 0x62,
+	0xa8, 0x2e, 0xc8, 0x5c, 0xa3, 0xd9, 0x29, 0x30, 0xf4, 0xb4, 0x2e, 0x9a, 0x19, 0x3f, 0xd7, 0x68,
+	0xbe, 0x80, 0x49, 0x40, 0x0c, 0x09, 0xcc, 0x32, 0x10, 0xc3, 0x08, 0x28, 0x99, 0x3b, 0x11, 0x23,
+	0x78, 0x43, 0xcf, 0xea, 0xa2, 0x59, 0xf0, 0x3b, 0x59, 0xbd, 0x22, 0x8b, 0xd1, 0x0b, 0x23, 0xe8,
+	0x40, 0xcf, 0xd3, 0xa2, 0x52, 0xa3, 0xf9, 0x3c, 0xea, 0x04, 0xc5, 0x90, 0xe1, 0x3c, 0x43, 0x31,
+	0x4c, 0x70, 0x4d, 0x4a, 0x0f, 0x3f, 0x7a, 0xf4, 0x20, 0x69, 0x59, 0x17, 0x4d, 0xc9, 0xef, 0x75,
+	0xf5, 0x96, 0xac, 0x24, 0xdc, 0xa0, 0x01, 0xb9, 0xb3, 0x46, 0x1d, 0xe8, 0x22, 0xf1, 0x65, 0xee,
+	0x6d, 0x8d, 0x3a, 0x54, 0x15, 0x99, 0x85, 0x5b, 0x74, 0x94, 0x24, 0x94, 0xea, 0xcd, 0x3b, 0xb2,
+	0xfa, 0x0a, 0x21, 0x88, 0x0e, 0xa6, 0x30, 0xd6, 0xa4, 0x94, 0x18, 0xc4, 0x5e, 0x81, 0x4c, 0x89,
+	0x94, 0xfc, 0x5e, 0x5f, 0x6e, 0xc9, 0x99, 0x4f, 0x43, 0xaf, 0xd9, 0x94, 0x31, 0xbb, 0xcb, 0x98,
+	0xa5, 0x38, 0xb7, 0x2e, 0xa2, 0x35, 0x81, 0xfe, 0xf9, 0xf7, 0x77, 0x53, 0x17, 0xcd, 0xf2, 0xfd,
+	0x05, 0x3b, 0x7a, 0x18, 0xf6, 0x10, 0x39, 0x9f, 0x7c, 0x2e, 0xf7, 0xe4, 0x99, 0x9e, 0x36, 0xdf,
+	0x4d, 0xc6, 0x6f, 0x9e, 0x18, 0xe7, 0xc3, 0x3d, 0xb1, 0x7e, 0xf9, 0xc8, 0xfa, 0xf8, 0x0a, 0x7c,
+	0xa5, 0x8f, 0xd4, 0xc7, 0xab, 0x6f, 0x1f, 0x3a, 0x8c, 0xdf, 0xfb, 0x3d, 0xbb, 0xb6, 0xba, 0xed,
+	0xac, 0x12, 0xa6, 0x7b, 0xf8, 0x1b, 0x2e, 0x1e, 0x1c, 0x84, 0x36, 0x7b, 0xb5, 0xc2, 0xe1, 0x55,
+	0xae, 0x77, 0xc2, 0xe1, 0xff, 0x01, 0x00, 0x04, 0x54, 0xc0, 0x78, 0x69, 0x02, 0x00, 0x00,
+}
@@ -29,10 +29,37 @@ for dir in ${PROTO_DIRS[@]}; do
       continue;
     fi
     echo "# $p"
-    protoc -I$dir --go_out=plugins=grpc,paths=source_relative:$dir $p
+    protoc -I$dir -Iptypes/network/api --go_out=plugins=grpc,paths=source_relative:$dir $p
   done
 done
 
+# A copy of the jsonpb test protos, in the jsonpb_generated package,
+# with generated JSON methods. The copies live under jsonpb_generated/
+# so that they register under file names of their own.
+dir=jsonpb/jsonpb_generated_test_proto
+mkdir -p $tmpdir/jsonpb_generated
+for p in `find jsonpb/jsonpb_test_proto -name "*.proto"`; do
+  echo "# $p (jsonpb_generated)"
+  sed 's/^package jsonpb;$/package jsonpb_generated;/' $p > $tmpdir/jsonpb_generated/$(basename $p)
+  protoc -I$tmpdir -Iptypes/network/api --go_out=plugins=jsonpb,paths=source_relative:$tmpdir jsonpb_generated/$(basename $p)
+  cp $tmpdir/jsonpb_generated/$(basename $p .proto).pb.go $dir
+done
+
+# The validate plugin's test protos, with generated Validate methods.
+dir=protoc-gen-go/validate/validate_test_proto
+for p in `find $dir -name "*.proto"`; do
+  echo "# $p"
+  protoc -I$dir -Iptypes/network/api --go_out=plugins=validate,paths=source_relative:$dir $p
+done
+
+# The jsonschema plugin's test protos, with their schemas.
+dir=protoc-gen-go/jsonschema/jsonschema_test_proto
+rm -f $dir/*.schema.json
+for p in `find $dir -name "*.proto"`; do
+  echo "# $p"
+  protoc -I$dir -Iptypes/network/api --go_out=plugins=jsonschema,paths=source_relative:$dir $p
+done
+
 # Deriving the location of the source protos from the path to the
 # protoc binary may be a bit odd, but this is what protoc itself does.
 PROTO_INCLUDE=$(dirname $(dirname $(which protoc)))/include